@@ -0,0 +1,182 @@
+package batch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"modulus/kyc/common"
+
+	"github.com/google/uuid"
+)
+
+// DefaultConcurrency is the number of the customers verified at once if the options don't specify it.
+const DefaultConcurrency = 4
+
+// MaxRate is the maximum rate of the verifications per second the batches can be started with.
+const MaxRate = 1000
+
+// Check verifies the customer of the batch. The provider errors are reported by the response.
+type Check func(ctx context.Context, customer *common.UserData) common.KYCResponse
+
+// Options control the processing of the batch.
+type Options struct {
+	// Concurrency is the number of the customers verified at once.
+	Concurrency int
+	// Rate limits the number of the verifications started per second, zero means no limit.
+	Rate float64
+}
+
+// ValidRate tells whether the rate is a positive number of the verifications per second not exceeding MaxRate.
+func ValidRate(rate float64) bool {
+	return rate > 0 && rate <= MaxRate
+}
+
+// Batch tracks the verification of the customers. It's safe for concurrent use.
+type Batch struct {
+	done chan struct{}
+
+	mu   sync.Mutex
+	info common.Batch
+	rows []common.BatchRow
+}
+
+// Start starts verifying the customers in the background.
+// The batch id, status, counters and creation time of the info are assigned by the batch.
+// Cancelling the context stops starting new verifications, the batch is cancelled then.
+func Start(ctx context.Context, info common.Batch, customers []*common.UserData, check Check, opts Options) *Batch {
+	info.ID = uuid.New().String()
+	info.Status = common.BatchRunning
+	info.Total = len(customers)
+	info.Processed, info.Succeeded, info.Failed = 0, 0, 0
+	info.CreatedAt = time.Now().UTC()
+	info.CompletedAt = nil
+
+	b := &Batch{
+		done: make(chan struct{}),
+		info: info,
+		rows: make([]common.BatchRow, len(customers)),
+	}
+	for i := range b.rows {
+		b.rows[i] = common.BatchRow{Row: i + 1, Status: common.JobQueued}
+	}
+
+	go b.run(ctx, customers, check, opts)
+
+	return b
+}
+
+// Info returns the current state of the batch.
+func (b *Batch) Info() common.Batch {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.info
+}
+
+// Rows returns the current states of the rows of the batch.
+func (b *Batch) Rows() []common.BatchRow {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]common.BatchRow(nil), b.rows...)
+}
+
+// Done returns the channel closed when the batch has completed or has been cancelled.
+func (b *Batch) Done() <-chan struct{} {
+	return b.done
+}
+
+// run verifies the customers by the pool of workers started at the limited rate.
+func (b *Batch) run(ctx context.Context, customers []*common.UserData, check Check, opts Options) {
+	defer close(b.done)
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	// The rate exceeding the limit is capped, so the interval stays positive.
+	var tick <-chan time.Time
+	if opts.Rate > 0 {
+		rate := opts.Rate
+		if rate > MaxRate {
+			rate = MaxRate
+		}
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / rate))
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	rows := make(chan int)
+	wg := sync.WaitGroup{}
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for i := range rows {
+				b.setRunning(i)
+				b.setResponse(i, check(ctx, customers[i]))
+			}
+		}()
+	}
+
+	cancelled := false
+feed:
+	for i := range customers {
+		if tick != nil && i > 0 {
+			select {
+			case <-tick:
+			case <-ctx.Done():
+				cancelled = true
+				break feed
+			}
+		}
+
+		select {
+		case rows <- i:
+		case <-ctx.Done():
+			cancelled = true
+			break feed
+		}
+	}
+	close(rows)
+	wg.Wait()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now().UTC()
+	b.info.Status = common.BatchCompleted
+	if cancelled {
+		b.info.Status = common.BatchCancelled
+	}
+	b.info.CompletedAt = &now
+}
+
+// setRunning marks the row as being verified.
+func (b *Batch) setRunning(i int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.rows[i].Status = common.JobRunning
+}
+
+// setResponse records the verification response of the row.
+func (b *Batch) setResponse(i int, response common.KYCResponse) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.rows[i].Response = &response
+	b.rows[i].Status = common.JobSucceeded
+	if len(response.Error) > 0 {
+		b.rows[i].Status = common.JobFailed
+	}
+
+	b.info.Processed++
+	if b.rows[i].Status == common.JobSucceeded {
+		b.info.Succeeded++
+	} else {
+		b.info.Failed++
+	}
+}
@@ -0,0 +1,213 @@
+package batch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"modulus/kyc/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// wait waits for the batch to complete.
+func wait(t *testing.T, b *Batch) {
+	select {
+	case <-b.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("batch %s hasn't completed in time", b.Info().ID)
+	}
+}
+
+// check approves the customers named Abby and fails the rest.
+func check(ctx context.Context, customer *common.UserData) common.KYCResponse {
+	if customer.FirstName == "Abby" {
+		return common.NewKYCResponse(common.KYCResult{Status: common.Approved}, nil)
+	}
+	return common.NewKYCResponse(common.KYCResult{}, errors.New("denied"))
+}
+
+func TestBatch(t *testing.T) {
+	assert := assert.New(t)
+
+	customers := []*common.UserData{{FirstName: "Abby"}, {FirstName: "Delilah"}, {FirstName: "Abby"}}
+
+	running, maxRunning := int32(0), int32(0)
+	b := Start(context.Background(), common.Batch{Provider: common.Example}, customers,
+		func(ctx context.Context, customer *common.UserData) common.KYCResponse {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				max := atomic.LoadInt32(&maxRunning)
+				if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			return check(ctx, customer)
+		}, Options{Concurrency: 2})
+
+	info := b.Info()
+	assert.NotEmpty(info.ID)
+	assert.Equal(common.BatchRunning, info.Status)
+	assert.Equal(common.Example, info.Provider)
+	assert.Equal(3, info.Total)
+
+	wait(t, b)
+
+	info = b.Info()
+	assert.Equal(common.BatchCompleted, info.Status)
+	assert.Equal(3, info.Processed)
+	assert.Equal(2, info.Succeeded)
+	assert.Equal(1, info.Failed)
+	assert.NotNil(info.CompletedAt)
+	assert.True(atomic.LoadInt32(&maxRunning) <= 2)
+
+	rows := b.Rows()
+	require.Len(t, rows, 3)
+	for i, row := range rows {
+		assert.Equal(i+1, row.Row)
+		assert.NotNil(row.Response)
+	}
+	assert.Equal(common.JobSucceeded, rows[0].Status)
+	assert.Equal(common.JobFailed, rows[1].Status)
+	assert.Equal("denied", rows[1].Response.Error)
+}
+
+func TestBatchRate(t *testing.T) {
+	customers := []*common.UserData{{FirstName: "Abby"}, {FirstName: "Abby"}, {FirstName: "Abby"}}
+
+	start := time.Now()
+	b := Start(context.Background(), common.Batch{}, customers, check, Options{Rate: 20})
+	wait(t, b)
+
+	// The verifications are started every 50ms.
+	assert.True(t, time.Since(start) >= 100*time.Millisecond)
+	assert.Equal(t, 3, b.Info().Succeeded)
+}
+
+func TestValidRate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(ValidRate(0.5))
+	assert.True(ValidRate(MaxRate))
+	for _, rate := range []float64{0, -1, MaxRate + 1, math.Inf(1), math.NaN()} {
+		assert.False(ValidRate(rate), "%v", rate)
+	}
+
+	// The rate over the limit is capped rather than breaking the ticker.
+	b := Start(context.Background(), common.Batch{}, []*common.UserData{{FirstName: "Abby"}, {FirstName: "Abby"}}, check, Options{Rate: math.Inf(1)})
+	wait(t, b)
+
+	assert.Equal(2, b.Info().Succeeded)
+}
+
+func TestBatchCancel(t *testing.T) {
+	assert := assert.New(t)
+
+	customers := []*common.UserData{{FirstName: "Abby"}, {FirstName: "Abby"}, {FirstName: "Abby"}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b := Start(ctx, common.Batch{}, customers, func(ctx context.Context, customer *common.UserData) common.KYCResponse {
+		cancel()
+		return check(ctx, customer)
+	}, Options{Concurrency: 1, Rate: 1})
+	wait(t, b)
+
+	info := b.Info()
+	assert.Equal(common.BatchCancelled, info.Status)
+	assert.Equal(1, info.Processed)
+
+	rows := b.Rows()
+	assert.Equal(common.JobSucceeded, rows[0].Status)
+	assert.Equal(common.JobQueued, rows[2].Status)
+}
+
+func TestStore(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewStore(50 * time.Millisecond)
+
+	b := Start(context.Background(), common.Batch{}, []*common.UserData{{FirstName: "Abby"}}, check, Options{})
+	s.Add(b)
+
+	found, err := s.Get(b.Info().ID)
+	assert.NoError(err)
+	assert.Equal(b, found)
+
+	wait(t, b)
+	assert.Equal(0, s.Running())
+
+	time.Sleep(100 * time.Millisecond)
+
+	_, err = s.Get(b.Info().ID)
+	assert.Equal(ErrNotFound, err)
+
+	_, err = s.Get("foo")
+	assert.Equal(ErrNotFound, err)
+}
+
+func TestStoreWait(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewStore(DefaultTTL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	b := Start(ctx, common.Batch{}, []*common.UserData{{FirstName: "Abby"}, {FirstName: "Abby"}},
+		func(ctx context.Context, customer *common.UserData) common.KYCResponse {
+			<-release
+			return check(ctx, customer)
+		}, Options{Concurrency: 1, Rate: 1})
+	s.Add(b)
+
+	timeout, cancelTimeout := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelTimeout()
+
+	assert.Equal(context.DeadlineExceeded, s.Wait(timeout))
+
+	cancel()
+	close(release)
+
+	assert.NoError(s.Wait(context.Background()))
+	assert.Equal(common.BatchCancelled, b.Info().Status)
+	assert.Equal(1, b.Info().Processed)
+}
+
+func TestWriteResults(t *testing.T) {
+	assert := assert.New(t)
+
+	rows := []common.BatchRow{
+		{
+			Row:      1,
+			Status:   common.JobSucceeded,
+			Response: &common.KYCResponse{Result: &common.Result{Status: "Approved"}},
+		},
+		{
+			Row:      2,
+			Status:   common.JobFailed,
+			Response: &common.KYCResponse{Error: "denied, really"},
+		},
+		{Row: 3, Status: common.JobQueued},
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, WriteResults(buf, rows, CSV))
+
+	assert.Equal("Row,Status,KYCStatus,RiskLevel,ErrorCode,ReferenceID,ErrorCategory,Retryable,Error\n"+
+		"1,Succeeded,Approved,,,,,,\n"+
+		"2,Failed,,,,,,false,\"denied, really\"\n"+
+		"3,Queued,,,,,,,\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, WriteResults(buf, rows[2:], NDJSON))
+
+	assert.Equal(`{"Row":3,"Status":"Queued"}`+"\n", buf.String())
+
+	assert.EqualError(WriteResults(buf, rows, Format("xml")), "unsupported batch format: xml")
+}
@@ -0,0 +1,211 @@
+package batch
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"modulus/kyc/common"
+)
+
+// Format defines the format of the batch input and results files.
+type Format string
+
+// Supported formats.
+const (
+	CSV    Format = "csv"
+	NDJSON Format = "ndjson"
+)
+
+// contentTypes holds the media types of the formats.
+var contentTypes = map[Format]string{
+	CSV:    "text/csv",
+	NDJSON: "application/x-ndjson",
+}
+
+// ContentType returns the media type of the format.
+func (f Format) ContentType() string {
+	return contentTypes[f]
+}
+
+// ParseFormat returns the format by its name, the media type or the file name extension.
+func ParseFormat(s string) (format Format, ok bool) {
+	if mediaType, _, err := mime.ParseMediaType(s); err == nil {
+		s = mediaType
+	}
+
+	switch strings.ToLower(strings.TrimPrefix(s, ".")) {
+	case "csv", "text/csv":
+		return CSV, true
+	case "ndjson", "jsonl", "application/x-ndjson", "application/jsonl":
+		return NDJSON, true
+	}
+
+	return
+}
+
+// FormatFromFilename returns the format by the file name extension.
+func FormatFromFilename(filename string) (Format, bool) {
+	return ParseFormat(filepath.Ext(filename))
+}
+
+// dateLayout is the layout of the dates accepted by the CSV input besides RFC 3339.
+const dateLayout = "2006-01-02"
+
+var timeType = reflect.TypeOf(common.Time{})
+
+// ReadCustomers reads all the customers from the input in the format specified.
+// Every NDJSON line is the JSON of the common.UserData. The CSV header names the common.UserData fields,
+// the fields of the nested structures are named by the dotted paths, e.g. "CurrentAddress.Town".
+// Empty CSV values leave the fields intact. The errors refer to the rows numbered from 1 like the results do.
+func ReadCustomers(r io.Reader, format Format) (customers []*common.UserData, err error) {
+	switch format {
+	case CSV:
+		return readCSV(r)
+	case NDJSON:
+		return readNDJSON(r)
+	default:
+		err = fmt.Errorf("unsupported batch format: %s", format)
+		return
+	}
+}
+
+// readNDJSON reads the customers from the NDJSON input skipping blank lines.
+func readNDJSON(r io.Reader) (customers []*common.UserData, err error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 64<<20)
+
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		customer := &common.UserData{}
+		if err = json.Unmarshal(scanner.Bytes(), customer); err != nil {
+			err = fmt.Errorf("row %d: %s", len(customers)+1, err)
+			return
+		}
+		customers = append(customers, customer)
+	}
+	err = scanner.Err()
+
+	return
+}
+
+// readCSV reads the customers from the CSV input with the header.
+func readCSV(r io.Reader) (customers []*common.UserData, err error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		err = nil
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	paths := make([][]string, len(header))
+	for i, column := range header {
+		paths[i] = strings.Split(strings.TrimSpace(column), ".")
+		if _, ok := fieldType(reflect.TypeOf(common.UserData{}), paths[i]); !ok {
+			err = fmt.Errorf("header: unsupported column '%s'", column)
+			return
+		}
+	}
+
+	for {
+		record, err1 := reader.Read()
+		if err1 == io.EOF {
+			break
+		}
+		if err1 != nil {
+			err = err1
+			return
+		}
+
+		customer := &common.UserData{}
+		for i, value := range record {
+			value = strings.TrimSpace(value)
+			if len(value) == 0 {
+				continue
+			}
+			if err = setField(reflect.ValueOf(customer).Elem(), paths[i], value); err != nil {
+				err = fmt.Errorf("row %d: column '%s': %s", len(customers)+1, header[i], err)
+				return
+			}
+		}
+		customers = append(customers, customer)
+	}
+
+	return
+}
+
+// fieldType finds the type of the field by its path. Only the fields of structs and pointers to structs
+// might be traversed, the leaf field must be a scalar.
+func fieldType(t reflect.Type, path []string) (reflect.Type, bool) {
+	for _, name := range path {
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct || t == timeType {
+			return nil, false
+		}
+		field, ok := t.FieldByName(name)
+		if !ok || len(field.PkgPath) > 0 {
+			return nil, false
+		}
+		t = field.Type
+	}
+
+	switch t.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array, reflect.Interface, reflect.Chan, reflect.Func:
+		return nil, false
+	case reflect.Struct, reflect.Ptr:
+		return t, t == timeType
+	}
+
+	return t, true
+}
+
+// setField sets the field found by the path allocating the nil structs on the way.
+func setField(v reflect.Value, path []string, value string) error {
+	for _, name := range path {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.FieldByName(name)
+	}
+
+	switch {
+	case v.Type() == timeType:
+		t, err := time.Parse(dateLayout, value)
+		if err != nil {
+			if t, err = time.Parse(time.RFC3339, value); err != nil {
+				return fmt.Errorf("invalid date '%s': YYYY-MM-DD or RFC 3339 expected", value)
+			}
+		}
+		v.Set(reflect.ValueOf(common.Time(t)))
+	case v.Kind() == reflect.String:
+		v.SetString(value)
+	default:
+		// The scalars other than strings are decoded as JSON, e.g. numbers and booleans.
+		if err := json.Unmarshal([]byte(value), v.Addr().Interface()); err != nil {
+			return fmt.Errorf("invalid value '%s': %s expected", value, v.Type().Kind())
+		}
+	}
+
+	return nil
+}
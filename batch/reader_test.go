@@ -0,0 +1,95 @@
+package batch
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"modulus/kyc/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormat(t *testing.T) {
+	assert := assert.New(t)
+
+	for s, expected := range map[string]Format{
+		"csv":                     CSV,
+		"text/csv; charset=utf-8": CSV,
+		".ndjson":                 NDJSON,
+		".jsonl":                  NDJSON,
+		"application/x-ndjson":    NDJSON,
+	} {
+		format, ok := ParseFormat(s)
+
+		assert.True(ok, s)
+		assert.Equal(expected, format, s)
+	}
+
+	_, ok := ParseFormat("application/json")
+
+	assert.False(ok)
+
+	format, ok := FormatFromFilename("/tmp/customers.CSV")
+
+	assert.True(ok)
+	assert.Equal(CSV, format)
+	assert.Equal("application/x-ndjson", NDJSON.ContentType())
+}
+
+func TestReadCustomersCSV(t *testing.T) {
+	assert := assert.New(t)
+
+	input := "FirstName,LastName,DateOfBirth,CurrentAddress.Town,Passport.Number\n" +
+		"Abby,Smith,1990-05-17,London,\n" +
+		"Delilah,,1985-01-02T00:00:00Z,,AB123\n"
+
+	customers, err := ReadCustomers(strings.NewReader(input), CSV)
+	require.NoError(t, err)
+	require.Len(t, customers, 2)
+
+	assert.Equal("Abby", customers[0].FirstName)
+	assert.Equal("Smith", customers[0].LastName)
+	assert.Equal(common.Time(time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC)), customers[0].DateOfBirth)
+	assert.Equal("London", customers[0].CurrentAddress.Town)
+	assert.Nil(customers[0].Passport)
+
+	assert.Equal("Delilah", customers[1].FirstName)
+	assert.Equal(common.Time(time.Date(1985, 1, 2, 0, 0, 0, 0, time.UTC)), customers[1].DateOfBirth)
+	if assert.NotNil(customers[1].Passport) {
+		assert.Equal("AB123", customers[1].Passport.Number)
+	}
+
+	_, err = ReadCustomers(strings.NewReader("FirstName,SupplementalAddresses\nAbby,\n"), CSV)
+
+	assert.EqualError(err, "header: unsupported column 'SupplementalAddresses'")
+
+	_, err = ReadCustomers(strings.NewReader("FirstName,DateOfBirth\nAbby,1990-05-17\nDelilah,17.05.1990\n"), CSV)
+
+	assert.EqualError(err, "row 2: column 'DateOfBirth': invalid date '17.05.1990': YYYY-MM-DD or RFC 3339 expected")
+}
+
+func TestReadCustomersNDJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	input := `{"FirstName":"Abby","CurrentAddress":{"Town":"London"}}` + "\n\n" + `{"FirstName":"Urbi"}` + "\n"
+
+	customers, err := ReadCustomers(strings.NewReader(input), NDJSON)
+	require.NoError(t, err)
+	require.Len(t, customers, 2)
+
+	assert.Equal("Abby", customers[0].FirstName)
+	assert.Equal("London", customers[0].CurrentAddress.Town)
+	assert.Equal("Urbi", customers[1].FirstName)
+
+	_, err = ReadCustomers(strings.NewReader(`{"FirstName":"Abby"}`+"\n{"), NDJSON)
+
+	if assert.Error(err) {
+		assert.Contains(err.Error(), "row 2: ")
+	}
+
+	_, err = ReadCustomers(strings.NewReader(""), Format("xml"))
+
+	assert.EqualError(err, "unsupported batch format: xml")
+}
@@ -0,0 +1,72 @@
+package batch
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"modulus/kyc/common"
+)
+
+// csvHeader holds the columns of the CSV results.
+var csvHeader = []string{"Row", "Status", "KYCStatus", "RiskLevel", "ErrorCode", "ReferenceID", "ErrorCategory", "Retryable", "Error"}
+
+// WriteResults writes the rows of the batch in the format specified.
+// Every NDJSON line is the JSON of the common.BatchRow. The CSV results flatten the verification responses.
+func WriteResults(w io.Writer, rows []common.BatchRow, format Format) error {
+	switch format {
+	case CSV:
+		return writeCSV(w, rows)
+	case NDJSON:
+		return writeNDJSON(w, rows)
+	default:
+		return fmt.Errorf("unsupported batch format: %s", format)
+	}
+}
+
+// writeNDJSON writes the rows as NDJSON.
+func writeNDJSON(w io.Writer, rows []common.BatchRow) error {
+	encoder := json.NewEncoder(w)
+	for _, row := range rows {
+		if err := encoder.Encode(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeCSV writes the rows as CSV with the header.
+func writeCSV(w io.Writer, rows []common.BatchRow) error {
+	writer := csv.NewWriter(w)
+	writer.Write(csvHeader)
+
+	for _, row := range rows {
+		record := make([]string, len(csvHeader))
+		record[0] = strconv.Itoa(row.Row)
+		record[1] = string(row.Status)
+
+		if resp := row.Response; resp != nil {
+			if result := resp.Result; result != nil {
+				record[2] = result.Status
+				record[3] = result.RiskLevel
+				record[4] = result.ErrorCode
+				if result.StatusCheck != nil {
+					record[5] = result.StatusCheck.ReferenceID
+				}
+			}
+			record[6] = string(resp.ErrorCategory)
+			if len(resp.Error) > 0 {
+				record[7] = strconv.FormatBool(resp.Retryable)
+			}
+			record[8] = resp.Error
+		}
+
+		writer.Write(record)
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,102 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// DefaultTTL is the time the completed batches are kept in the store by default.
+const DefaultTTL = 24 * time.Hour
+
+// ErrNotFound means there is no batch with the specified id or it has expired.
+var ErrNotFound = errors.New("batch not found")
+
+// Store holds the batches until they expire. The batches are kept in memory, so they are lost on restart.
+// It's safe for concurrent use.
+type Store struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	batches map[string]*Batch
+}
+
+// NewStore constructs a new batches store keeping the completed batches for the specified time.
+func NewStore(ttl time.Duration) *Store {
+	return &Store{
+		ttl:     ttl,
+		batches: map[string]*Batch{},
+	}
+}
+
+// Add keeps the batch in the store.
+func (s *Store) Add(b *Batch) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeExpired(time.Now())
+	s.batches[b.Info().ID] = b
+}
+
+// Get retrieves the batch with the specified id.
+func (s *Store) Get(id string) (b *Batch, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.batches[id]
+	if !ok || s.expired(b, time.Now()) {
+		b, err = nil, ErrNotFound
+	}
+
+	return
+}
+
+// Running returns the number of the batches still running.
+func (s *Store) Running() (running int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, b := range s.batches {
+		if b.Info().CompletedAt == nil {
+			running++
+		}
+	}
+
+	return
+}
+
+// Wait waits for the running batches to complete until the context is done.
+// It returns the context error if the context is done before.
+func (s *Store) Wait(ctx context.Context) error {
+	s.mu.Lock()
+	var running []*Batch
+	for _, b := range s.batches {
+		running = append(running, b)
+	}
+	s.mu.Unlock()
+
+	for _, b := range running {
+		select {
+		case <-b.Done():
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// removeExpired drops the expired batches. The caller must hold the lock.
+func (s *Store) removeExpired(now time.Time) {
+	for id, b := range s.batches {
+		if s.expired(b, now) {
+			delete(s.batches, id)
+		}
+	}
+}
+
+// expired tells whether the batch has completed longer than the TTL ago.
+func (s *Store) expired(b *Batch, now time.Time) bool {
+	completedAt := b.Info().CompletedAt
+	return completedAt != nil && now.Sub(*completedAt) > s.ttl
+}
@@ -0,0 +1,153 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"modulus/kyc/common"
+)
+
+// Entry represents the cached verification result.
+type Entry struct {
+	Result    common.KYCResult
+	CachedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// Cache holds the verification results until they expire. The results are kept in memory, so they are lost on restart.
+// It's safe for concurrent use.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[string]Entry
+}
+
+// New constructs a new empty cache.
+func New() *Cache {
+	return &Cache{
+		entries: map[string]Entry{},
+	}
+}
+
+// Get returns the unexpired result cached by the key.
+func (c *Cache) Get(key string) (entry Entry, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok = c.entries[key]
+	if !ok || !time.Now().Before(entry.ExpiresAt) {
+		return Entry{}, false
+	}
+	entry.Result = clone(entry.Result)
+
+	return
+}
+
+// Put caches the result by the key for the specified time.
+func (c *Cache) Put(key string, result common.KYCResult, ttl time.Duration) Entry {
+	now := time.Now()
+	entry := Entry{
+		Result:    clone(result),
+		CachedAt:  now.UTC(),
+		ExpiresAt: now.Add(ttl).UTC(),
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.removeExpired(now)
+	c.entries[key] = entry
+
+	return entry
+}
+
+// removeExpired drops the expired results. The caller must hold the lock.
+func (c *Cache) removeExpired(now time.Time) {
+	for key, entry := range c.entries {
+		if !now.Before(entry.ExpiresAt) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// clone copies the result so the cached one isn't changed by the callers.
+func clone(result common.KYCResult) common.KYCResult {
+	if result.Details != nil {
+		details := *result.Details
+		details.Reasons = append([]string(nil), details.Reasons...)
+		result.Details = &details
+	}
+	if result.StatusCheck != nil {
+		statusCheck := *result.StatusCheck
+		result.StatusCheck = &statusCheck
+	}
+
+	return result
+}
+
+// fingerprint holds the customer data the screening results depend on.
+type fingerprint struct {
+	Names       []string
+	DateOfBirth common.Time
+	Countries   []string
+	Documents   []string
+	Business    []string `json:",omitempty"`
+}
+
+// Fingerprint returns the hash of the provider-relevant subset of the customer data:
+// the names, the date of birth, the countries and the document numbers.
+// The customers differing in the other fields, e.g. the contacts or the document images, have the same fingerprint.
+func Fingerprint(customer *common.UserData) string {
+	if customer == nil {
+		customer = &common.UserData{}
+	}
+
+	f := fingerprint{
+		Names: normalize(customer.FirstName, customer.MiddleName, customer.LastName, customer.MaternalLastName,
+			customer.FullName, customer.LegalName, customer.LatinISO1Name),
+		DateOfBirth: customer.DateOfBirth,
+		Countries: normalize(customer.CountryAlpha2, customer.Nationality, customer.CountryOfBirthAlpha2,
+			customer.CurrentAddress.CountryAlpha2),
+	}
+
+	if doc := customer.Passport; doc != nil {
+		f.Documents = append(f.Documents, normalize("Passport", doc.CountryAlpha2, doc.Number)...)
+	}
+	if doc := customer.IDCard; doc != nil {
+		f.Documents = append(f.Documents, normalize("IDCard", doc.CountryAlpha2, doc.Number)...)
+	}
+	if doc := customer.DriverLicense; doc != nil {
+		f.Documents = append(f.Documents, normalize("DriverLicense", doc.CountryAlpha2, doc.Number)...)
+	}
+	if doc := customer.TaxID; doc != nil {
+		f.Documents = append(f.Documents, normalize("TaxID", doc.Number)...)
+	}
+	if doc := customer.SNILS; doc != nil {
+		f.Documents = append(f.Documents, normalize("SNILS", doc.Number)...)
+	}
+	if doc := customer.HealthID; doc != nil {
+		f.Documents = append(f.Documents, normalize("HealthID", doc.Number)...)
+	}
+	if doc := customer.SocialServiceID; doc != nil {
+		f.Documents = append(f.Documents, normalize("SocialServiceID", doc.Number)...)
+	}
+	if business := customer.Business; business != nil {
+		f.Business = normalize(business.Name, business.RegistrationNumber, business.IncorporationJurisdiction)
+	}
+
+	data, _ := json.Marshal(f)
+	hash := sha256.Sum256(data)
+
+	return hex.EncodeToString(hash[:])
+}
+
+// normalize trims and lowercases the values so the insignificant differences don't change the fingerprint.
+func normalize(values ...string) []string {
+	for i, value := range values {
+		values[i] = strings.ToLower(strings.TrimSpace(value))
+	}
+	return values
+}
@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"modulus/kyc/common"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache(t *testing.T) {
+	assert := assert.New(t)
+
+	c := New()
+
+	_, ok := c.Get("key")
+
+	assert.False(ok)
+
+	result := common.KYCResult{
+		Status:      common.Approved,
+		Details:     &common.KYCDetails{Reasons: []string{"clear"}},
+		StatusCheck: &common.KYCStatusCheck{Provider: common.Example, ReferenceID: "ref"},
+	}
+	put := c.Put("key", result, time.Hour)
+
+	assert.Equal(time.Hour, put.ExpiresAt.Sub(put.CachedAt))
+
+	// The cached result isn't changed by the callers.
+	result.Details.Reasons[0] = "changed"
+	result.StatusCheck.Instance = "eu"
+
+	entry, ok := c.Get("key")
+
+	assert.True(ok)
+	assert.Equal(put, entry)
+	assert.Equal(common.Approved, entry.Result.Status)
+	assert.Equal([]string{"clear"}, entry.Result.Details.Reasons)
+	assert.Empty(entry.Result.StatusCheck.Instance)
+
+	entry.Result.StatusCheck.Instance = "eu"
+	entry, _ = c.Get("key")
+
+	assert.Empty(entry.Result.StatusCheck.Instance)
+
+	c.Put("expiring", result, 10*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+
+	_, ok = c.Get("expiring")
+
+	assert.False(ok)
+}
+
+func TestFingerprint(t *testing.T) {
+	assert := assert.New(t)
+
+	customer := func() *common.UserData {
+		return &common.UserData{
+			FirstName:     "John",
+			LastName:      "Doe",
+			DateOfBirth:   common.Time(time.Date(1980, 2, 3, 0, 0, 0, 0, time.UTC)),
+			CountryAlpha2: "GB",
+			Email:         "john@example.com",
+			Passport:      &common.Passport{Number: "123456", CountryAlpha2: "GB"},
+		}
+	}
+	fingerprint := Fingerprint(customer())
+
+	assert.Len(fingerprint, 64)
+
+	// The irrelevant and the insignificant differences don't change the fingerprint.
+	same := customer()
+	same.FirstName = " john "
+	same.Email = "doe@example.com"
+	same.Phone = "+441234567890"
+	same.Passport.Image = &common.DocumentFile{Filename: "passport.jpg"}
+
+	assert.Equal(fingerprint, Fingerprint(same))
+
+	for name, change := range map[string]func(*common.UserData){
+		"name":     func(c *common.UserData) { c.LastName = "Roe" },
+		"birth":    func(c *common.UserData) { c.DateOfBirth = common.Time(time.Date(1980, 2, 4, 0, 0, 0, 0, time.UTC)) },
+		"country":  func(c *common.UserData) { c.CountryAlpha2 = "IE" },
+		"passport": func(c *common.UserData) { c.Passport.Number = "654321" },
+		"document": func(c *common.UserData) { c.IDCard = &common.IDCard{Number: "123456"} },
+		"business": func(c *common.UserData) { c.Business = &common.Business{Name: "Doe Ltd"} },
+	} {
+		changed := customer()
+		change(changed)
+
+		assert.NotEqual(fingerprint, Fingerprint(changed), name)
+	}
+
+	assert.Equal(Fingerprint(&common.UserData{}), Fingerprint(nil))
+}
@@ -0,0 +1,25 @@
+package common
+
+import "github.com/shopspring/decimal"
+
+// blockchainDecimals holds the number of decimal places of the smallest unit of the blockchain native asset.
+var blockchainDecimals = map[Blockchain]int32{
+	Bitcoin:  8,  // satoshi
+	Ethereum: 18, // wei
+}
+
+// Decimals returns the number of decimal places of the smallest unit of the blockchain native asset.
+func (b Blockchain) Decimals() int32 {
+	return blockchainDecimals[b]
+}
+
+// FromBaseUnits converts the amount in the smallest units (satoshi, wei) into the native asset amount.
+func (b Blockchain) FromBaseUnits(amount decimal.Decimal) decimal.Decimal {
+	return amount.Shift(-b.Decimals())
+}
+
+// ToBaseUnits converts the native asset amount into the smallest units (satoshi, wei).
+// Fractions of the smallest unit are truncated.
+func (b Blockchain) ToBaseUnits(amount decimal.Decimal) decimal.Decimal {
+	return amount.Shift(b.Decimals()).Truncate(0)
+}
@@ -8,3 +8,9 @@ type KYCPlatform interface {
 	CheckCustomer(customer *UserData) (KYCResult, error)
 	CheckStatus(referenceID string) (KYCResult, error)
 }
+
+// CredentialsProber describes KYC provider platforms able to check their credentials with a cheap API request.
+// ProbeCredentials returns the HTTP status code if the API has responded with an error.
+type CredentialsProber interface {
+	ProbeCredentials() (code *int, err error)
+}
@@ -0,0 +1,521 @@
+package common
+
+import "time"
+
+// CustomerData defines the customer data of the v2 API.
+// It mirrors the UserData with the camelCase member names, use ToUserData to pass it to the KYC providers.
+type CustomerData struct {
+	FirstName                string                            `json:"firstName,omitempty"`
+	LastName                 string                            `json:"lastName,omitempty"`
+	MaternalLastName         string                            `json:"maternalLastName,omitempty"`
+	MiddleName               string                            `json:"middleName,omitempty"`
+	FullName                 string                            `json:"fullName,omitempty"`
+	LegalName                string                            `json:"legalName,omitempty"`
+	LatinISO1Name            string                            `json:"latinISO1Name,omitempty"`
+	AccountName              string                            `json:"accountName,omitempty"`
+	Email                    string                            `json:"email,omitempty"`
+	IPaddress                string                            `json:"ipAddress,omitempty"`
+	Gender                   Gender                            `json:"gender,omitempty"`
+	DateOfBirth              Time                              `json:"dateOfBirth"`
+	PlaceOfBirth             string                            `json:"placeOfBirth,omitempty"`
+	CountryOfBirthAlpha2     string                            `json:"countryOfBirthAlpha2,omitempty"`
+	StateOfBirth             string                            `json:"stateOfBirth,omitempty"`
+	CountryAlpha2            string                            `json:"countryAlpha2,omitempty"`
+	Nationality              string                            `json:"nationality,omitempty"`
+	Phone                    string                            `json:"phone,omitempty"`
+	MobilePhone              string                            `json:"mobilePhone,omitempty"`
+	BankAccountNumber        string                            `json:"bankAccountNumber,omitempty"`
+	VehicleRegistrationPlate string                            `json:"vehicleRegistrationPlate,omitempty"`
+	CurrentAddress           CustomerAddress                   `json:"currentAddress"`
+	SupplementalAddresses    []CustomerAddress                 `json:"supplementalAddresses,omitempty"`
+	Location                 *CustomerLocation                 `json:"location,omitempty"`
+	Business                 *CustomerBusiness                 `json:"business,omitempty"`
+	Passport                 *CustomerPassport                 `json:"passport,omitempty"`
+	IDCard                   *CustomerIDCard                   `json:"idCard,omitempty"`
+	SNILS                    *CustomerSNILS                    `json:"snils,omitempty"`
+	HealthID                 *CustomerNumberedDocument         `json:"healthId,omitempty"`
+	SocialServiceID          *CustomerSocialServiceID          `json:"socialServiceId,omitempty"`
+	TaxID                    *CustomerNumberedDocument         `json:"taxId,omitempty"`
+	DriverLicense            *CustomerDriverLicense            `json:"driverLicense,omitempty"`
+	DriverLicenseTranslation *CustomerDriverLicenseTranslation `json:"driverLicenseTranslation,omitempty"`
+	CreditCard               *CustomerCard                     `json:"creditCard,omitempty"`
+	DebitCard                *CustomerCard                     `json:"debitCard,omitempty"`
+	UtilityBill              *CustomerUtilityBill              `json:"utilityBill,omitempty"`
+	ResidencePermit          *CustomerResidencePermit          `json:"residencePermit,omitempty"`
+	Agreement                *CustomerImage                    `json:"agreement,omitempty"`
+	EmploymentCertificate    *CustomerEmploymentCertificate    `json:"employmentCertificate,omitempty"`
+	Contract                 *CustomerImage                    `json:"contract,omitempty"`
+	DocumentPhoto            *CustomerImage                    `json:"documentPhoto,omitempty"`
+	Selfie                   *CustomerImage                    `json:"selfie,omitempty"`
+	Avatar                   *CustomerImage                    `json:"avatar,omitempty"`
+	Other                    *CustomerOther                    `json:"other,omitempty"`
+	VideoAuth                *CustomerDocumentFile             `json:"videoAuth,omitempty"`
+	Document                 *CustomerDocument                 `json:"document,omitempty"`
+	// Financial profile fields.
+	PoliticallyExposed bool                      `json:"politicallyExposed,omitempty"`
+	CryptoAddresses    []CustomerCryptoAddress   `json:"cryptoAddresses,omitempty"`
+	SourceOfWealth     *CustomerFundsDeclaration `json:"sourceOfWealth,omitempty"`
+	SourceOfFunds      *CustomerFundsDeclaration `json:"sourceOfFunds,omitempty"`
+	BeneficialOwners   []CustomerBeneficialOwner `json:"beneficialOwners,omitempty"`
+	// Company type fields.
+	CompanyName         string                `json:"companyName,omitempty"`
+	Website             string                `json:"website,omitempty"`
+	CompanyBoard        *CustomerDocumentFile `json:"companyBoard,omitempty"`
+	CompanyRegistration *CustomerDocumentFile `json:"companyRegistration,omitempty"`
+}
+
+// CustomerAddress defines the address of the v2 customer data.
+type CustomerAddress struct {
+	CountryAlpha2     string `json:"countryAlpha2,omitempty"`
+	County            string `json:"county,omitempty"`
+	State             string `json:"state,omitempty"`
+	Town              string `json:"town,omitempty"`
+	Suburb            string `json:"suburb,omitempty"`
+	Street            string `json:"street,omitempty"`
+	StreetType        string `json:"streetType,omitempty"`
+	SubStreet         string `json:"subStreet,omitempty"`
+	BuildingName      string `json:"buildingName,omitempty"`
+	BuildingNumber    string `json:"buildingNumber,omitempty"`
+	FlatNumber        string `json:"flatNumber,omitempty"`
+	PostOfficeBox     string `json:"postOfficeBox,omitempty"`
+	PostCode          string `json:"postCode,omitempty"`
+	StateProvinceCode string `json:"stateProvinceCode,omitempty"`
+	StartDate         Time   `json:"startDate"`
+	EndDate           Time   `json:"endDate"`
+}
+
+// CustomerLocation defines the geopositional data of the v2 customer data.
+type CustomerLocation struct {
+	Latitude  string `json:"latitude"`
+	Longitude string `json:"longitude"`
+}
+
+// CustomerBusiness defines the business of the v2 customer data.
+type CustomerBusiness struct {
+	Name                      string `json:"name,omitempty"`
+	RegistrationNumber        string `json:"registrationNumber,omitempty"`
+	IncorporationDate         Time   `json:"incorporationDate"`
+	IncorporationJurisdiction string `json:"incorporationJurisdiction,omitempty"`
+}
+
+// CustomerCryptoAddress defines the crypto wallet address of the v2 customer data.
+type CustomerCryptoAddress struct {
+	Chain          Blockchain              `json:"chain"`
+	Address        string                  `json:"address"`
+	OwnershipProof *CustomerOwnershipProof `json:"ownershipProof,omitempty"`
+}
+
+// CustomerOwnershipProof defines the proof of the control over the crypto wallet address of the v2 customer data.
+type CustomerOwnershipProof struct {
+	Chain       Blockchain `json:"chain"`
+	Address     string     `json:"address"`
+	Scheme      string     `json:"scheme"`
+	Message     string     `json:"message"`
+	Signature   string     `json:"signature"`
+	ChallengeID string     `json:"challengeId,omitempty"`
+	VerifiedAt  time.Time  `json:"verifiedAt"`
+}
+
+// CustomerFundsDeclaration defines the declaration of the source of wealth or funds of the v2 customer data.
+type CustomerFundsDeclaration struct {
+	Sources     []FundsSource `json:"sources,omitempty"`
+	Description string        `json:"description,omitempty"`
+}
+
+// CustomerBeneficialOwner defines the ultimate beneficial owner of the v2 customer data.
+type CustomerBeneficialOwner struct {
+	FullName           string                    `json:"fullName,omitempty"`
+	DateOfBirth        Time                      `json:"dateOfBirth"`
+	Nationality        string                    `json:"nationality,omitempty"`
+	Address            CustomerAddress           `json:"address"`
+	OwnershipPercent   int                       `json:"ownershipPercent,omitempty"`
+	PoliticallyExposed bool                      `json:"politicallyExposed,omitempty"`
+	SourceOfWealth     *CustomerFundsDeclaration `json:"sourceOfWealth,omitempty"`
+}
+
+// CustomerDocumentFile defines the document file of the v2 customer data.
+// DocumentID references the file stored in the document vault instead of sending its data again.
+type CustomerDocumentFile struct {
+	Filename    string `json:"filename,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+	Data        []byte `json:"data,omitempty"`
+	DocumentID  string `json:"documentId,omitempty"`
+}
+
+// CustomerDocument defines the document of the type specified of the v2 customer data.
+type CustomerDocument struct {
+	Type          DocumentType          `json:"type"`
+	Number        string                `json:"number,omitempty"`
+	CountryAlpha2 string                `json:"countryAlpha2,omitempty"`
+	IssuedDate    Time                  `json:"issuedDate"`
+	ValidUntil    Time                  `json:"validUntil"`
+	Image         *CustomerDocumentFile `json:"image,omitempty"`
+}
+
+// CustomerPassport defines the passport of the v2 customer data.
+type CustomerPassport struct {
+	Number        string                `json:"number,omitempty"`
+	Mrz1          string                `json:"mrz1,omitempty"`
+	Mrz2          string                `json:"mrz2,omitempty"`
+	CountryAlpha2 string                `json:"countryAlpha2,omitempty"`
+	State         string                `json:"state,omitempty"`
+	IssuedDate    Time                  `json:"issuedDate"`
+	ValidUntil    Time                  `json:"validUntil"`
+	Image         *CustomerDocumentFile `json:"image,omitempty"`
+}
+
+// CustomerIDCard defines the id card of the v2 customer data.
+type CustomerIDCard struct {
+	Number        string                `json:"number,omitempty"`
+	CountryAlpha2 string                `json:"countryAlpha2,omitempty"`
+	IssuedDate    Time                  `json:"issuedDate"`
+	ValidUntil    Time                  `json:"validUntil"`
+	Image         *CustomerDocumentFile `json:"image,omitempty"`
+}
+
+// CustomerSNILS defines the Russian individual insurance account number of the v2 customer data.
+type CustomerSNILS struct {
+	Number     string                `json:"number,omitempty"`
+	IssuedDate Time                  `json:"issuedDate"`
+	Image      *CustomerDocumentFile `json:"image,omitempty"`
+}
+
+// CustomerNumberedDocument defines the national identification information of the v2 customer data
+// having only the number, e.g. the health or taxpayer id.
+type CustomerNumberedDocument struct {
+	Number string                `json:"number,omitempty"`
+	Image  *CustomerDocumentFile `json:"image,omitempty"`
+}
+
+// CustomerSocialServiceID defines the national social service identification information of the v2 customer data.
+type CustomerSocialServiceID struct {
+	Number     string                `json:"number,omitempty"`
+	IssuedDate Time                  `json:"issuedDate"`
+	Image      *CustomerDocumentFile `json:"image,omitempty"`
+}
+
+// CustomerDriverLicense defines the driver license of the v2 customer data.
+type CustomerDriverLicense struct {
+	Number        string                `json:"number,omitempty"`
+	Version       string                `json:"version,omitempty"`
+	CountryAlpha2 string                `json:"countryAlpha2,omitempty"`
+	State         string                `json:"state,omitempty"`
+	IssuedDate    Time                  `json:"issuedDate"`
+	ValidUntil    Time                  `json:"validUntil"`
+	FrontImage    *CustomerDocumentFile `json:"frontImage,omitempty"`
+	BackImage     *CustomerDocumentFile `json:"backImage,omitempty"`
+}
+
+// CustomerDriverLicenseTranslation defines the translated driver license of the v2 customer data.
+type CustomerDriverLicenseTranslation struct {
+	Number        string                `json:"number,omitempty"`
+	CountryAlpha2 string                `json:"countryAlpha2,omitempty"`
+	State         string                `json:"state,omitempty"`
+	IssuedDate    Time                  `json:"issuedDate"`
+	ValidUntil    Time                  `json:"validUntil"`
+	FrontImage    *CustomerDocumentFile `json:"frontImage,omitempty"`
+	BackImage     *CustomerDocumentFile `json:"backImage,omitempty"`
+}
+
+// CustomerCard defines the banking card of the v2 customer data.
+type CustomerCard struct {
+	Number     string                `json:"number,omitempty"`
+	ValidUntil Time                  `json:"validUntil"`
+	Image      *CustomerDocumentFile `json:"image,omitempty"`
+}
+
+// CustomerUtilityBill defines the utility bill of the v2 customer data.
+type CustomerUtilityBill struct {
+	CountryAlpha2 string                `json:"countryAlpha2,omitempty"`
+	Image         *CustomerDocumentFile `json:"image,omitempty"`
+}
+
+// CustomerResidencePermit defines the residence permit of the v2 customer data.
+type CustomerResidencePermit struct {
+	CountryAlpha2 string                `json:"countryAlpha2,omitempty"`
+	IssuedDate    Time                  `json:"issuedDate"`
+	ValidUntil    Time                  `json:"validUntil"`
+	Image         *CustomerDocumentFile `json:"image,omitempty"`
+}
+
+// CustomerEmploymentCertificate defines the document from the employer of the v2 customer data.
+type CustomerEmploymentCertificate struct {
+	IssuedDate Time                  `json:"issuedDate"`
+	Image      *CustomerDocumentFile `json:"image,omitempty"`
+}
+
+// CustomerImage defines the document of the v2 customer data having only the image, e.g. the selfie or the agreement.
+type CustomerImage struct {
+	Image *CustomerDocumentFile `json:"image,omitempty"`
+}
+
+// CustomerOther defines the other document of the v2 customer data.
+type CustomerOther struct {
+	Number        string                `json:"number,omitempty"`
+	CountryAlpha2 string                `json:"countryAlpha2,omitempty"`
+	State         string                `json:"state,omitempty"`
+	IssuedDate    Time                  `json:"issuedDate"`
+	ValidUntil    Time                  `json:"validUntil"`
+	Image         *CustomerDocumentFile `json:"image,omitempty"`
+}
+
+// ToUserData converts the v2 customer data into the UserData taken by the KYC providers.
+func (c *CustomerData) ToUserData() *UserData {
+	if c == nil {
+		return nil
+	}
+
+	userData := &UserData{
+		FirstName:                c.FirstName,
+		LastName:                 c.LastName,
+		MaternalLastName:         c.MaternalLastName,
+		MiddleName:               c.MiddleName,
+		FullName:                 c.FullName,
+		LegalName:                c.LegalName,
+		LatinISO1Name:            c.LatinISO1Name,
+		AccountName:              c.AccountName,
+		Email:                    c.Email,
+		IPaddress:                c.IPaddress,
+		Gender:                   c.Gender,
+		DateOfBirth:              c.DateOfBirth,
+		PlaceOfBirth:             c.PlaceOfBirth,
+		CountryOfBirthAlpha2:     c.CountryOfBirthAlpha2,
+		StateOfBirth:             c.StateOfBirth,
+		CountryAlpha2:            c.CountryAlpha2,
+		Nationality:              c.Nationality,
+		Phone:                    c.Phone,
+		MobilePhone:              c.MobilePhone,
+		BankAccountNumber:        c.BankAccountNumber,
+		VehicleRegistrationPlate: c.VehicleRegistrationPlate,
+		CurrentAddress:           c.CurrentAddress.toAddress(),
+		PoliticallyExposed:       c.PoliticallyExposed,
+		SourceOfWealth:           c.SourceOfWealth.toFundsDeclaration(),
+		SourceOfFunds:            c.SourceOfFunds.toFundsDeclaration(),
+		CompanyName:              c.CompanyName,
+		Website:                  c.Website,
+	}
+
+	for _, address := range c.SupplementalAddresses {
+		userData.SupplementalAddresses = append(userData.SupplementalAddresses, address.toAddress())
+	}
+	for _, address := range c.CryptoAddresses {
+		userData.CryptoAddresses = append(userData.CryptoAddresses, CryptoAddress{
+			Chain:          address.Chain,
+			Address:        address.Address,
+			OwnershipProof: address.OwnershipProof.toWalletOwnershipProof(),
+		})
+	}
+	for _, owner := range c.BeneficialOwners {
+		userData.BeneficialOwners = append(userData.BeneficialOwners, BeneficialOwner{
+			FullName:           owner.FullName,
+			DateOfBirth:        owner.DateOfBirth,
+			Nationality:        owner.Nationality,
+			Address:            owner.Address.toAddress(),
+			OwnershipPercent:   owner.OwnershipPercent,
+			PoliticallyExposed: owner.PoliticallyExposed,
+			SourceOfWealth:     owner.SourceOfWealth.toFundsDeclaration(),
+		})
+	}
+
+	if c.Location != nil {
+		userData.Location = &Location{Latitude: c.Location.Latitude, Longitude: c.Location.Longitude}
+	}
+	if c.Business != nil {
+		userData.Business = &Business{
+			Name:                      c.Business.Name,
+			RegistrationNumber:        c.Business.RegistrationNumber,
+			IncorporationDate:         c.Business.IncorporationDate,
+			IncorporationJurisdiction: c.Business.IncorporationJurisdiction,
+		}
+	}
+
+	c.addDocuments(userData)
+
+	return userData
+}
+
+// addDocuments sets the documents of the UserData from the ones of the v2 customer data.
+func (c *CustomerData) addDocuments(userData *UserData) {
+	if d := c.Passport; d != nil {
+		userData.Passport = &Passport{
+			Number:        d.Number,
+			Mrz1:          d.Mrz1,
+			Mrz2:          d.Mrz2,
+			CountryAlpha2: d.CountryAlpha2,
+			State:         d.State,
+			IssuedDate:    d.IssuedDate,
+			ValidUntil:    d.ValidUntil,
+			Image:         d.Image.toDocumentFile(),
+		}
+	}
+	if d := c.IDCard; d != nil {
+		userData.IDCard = &IDCard{
+			Number:        d.Number,
+			CountryAlpha2: d.CountryAlpha2,
+			IssuedDate:    d.IssuedDate,
+			ValidUntil:    d.ValidUntil,
+			Image:         d.Image.toDocumentFile(),
+		}
+	}
+	if d := c.SNILS; d != nil {
+		userData.SNILS = &SNILS{Number: d.Number, IssuedDate: d.IssuedDate, Image: d.Image.toDocumentFile()}
+	}
+	if d := c.HealthID; d != nil {
+		userData.HealthID = &HealthID{Number: d.Number, Image: d.Image.toDocumentFile()}
+	}
+	if d := c.SocialServiceID; d != nil {
+		userData.SocialServiceID = &SocialServiceID{Number: d.Number, IssuedDate: d.IssuedDate, Image: d.Image.toDocumentFile()}
+	}
+	if d := c.TaxID; d != nil {
+		userData.TaxID = &TaxID{Number: d.Number, Image: d.Image.toDocumentFile()}
+	}
+	if d := c.DriverLicense; d != nil {
+		userData.DriverLicense = &DriverLicense{
+			Number:        d.Number,
+			Version:       d.Version,
+			CountryAlpha2: d.CountryAlpha2,
+			State:         d.State,
+			IssuedDate:    d.IssuedDate,
+			ValidUntil:    d.ValidUntil,
+			FrontImage:    d.FrontImage.toDocumentFile(),
+			BackImage:     d.BackImage.toDocumentFile(),
+		}
+	}
+	if d := c.DriverLicenseTranslation; d != nil {
+		userData.DriverLicenseTranslation = &DriverLicenseTranslation{
+			Number:        d.Number,
+			CountryAlpha2: d.CountryAlpha2,
+			State:         d.State,
+			IssuedDate:    d.IssuedDate,
+			ValidUntil:    d.ValidUntil,
+			FrontImage:    d.FrontImage.toDocumentFile(),
+			BackImage:     d.BackImage.toDocumentFile(),
+		}
+	}
+	if d := c.CreditCard; d != nil {
+		userData.CreditCard = &CreditCard{Number: d.Number, ValidUntil: d.ValidUntil, Image: d.Image.toDocumentFile()}
+	}
+	if d := c.DebitCard; d != nil {
+		userData.DebitCard = &DebitCard{Number: d.Number, ValidUntil: d.ValidUntil, Image: d.Image.toDocumentFile()}
+	}
+	if d := c.UtilityBill; d != nil {
+		userData.UtilityBill = &UtilityBill{CountryAlpha2: d.CountryAlpha2, Image: d.Image.toDocumentFile()}
+	}
+	if d := c.ResidencePermit; d != nil {
+		userData.ResidencePermit = &ResidencePermit{
+			CountryAlpha2: d.CountryAlpha2,
+			IssuedDate:    d.IssuedDate,
+			ValidUntil:    d.ValidUntil,
+			Image:         d.Image.toDocumentFile(),
+		}
+	}
+	if d := c.Agreement; d != nil {
+		userData.Agreement = &Agreement{Image: d.Image.toDocumentFile()}
+	}
+	if d := c.EmploymentCertificate; d != nil {
+		userData.EmploymentCertificate = &EmploymentCertificate{IssuedDate: d.IssuedDate, Image: d.Image.toDocumentFile()}
+	}
+	if d := c.Contract; d != nil {
+		userData.Contract = &Contract{Image: d.Image.toDocumentFile()}
+	}
+	if d := c.DocumentPhoto; d != nil {
+		userData.DocumentPhoto = &DocumentPhoto{Image: d.Image.toDocumentFile()}
+	}
+	if d := c.Selfie; d != nil {
+		userData.Selfie = &Selfie{Image: d.Image.toDocumentFile()}
+	}
+	if d := c.Avatar; d != nil {
+		userData.Avatar = &Avatar{Image: d.Image.toDocumentFile()}
+	}
+	if d := c.Other; d != nil {
+		userData.Other = &Other{
+			Number:        d.Number,
+			CountryAlpha2: d.CountryAlpha2,
+			State:         d.State,
+			IssuedDate:    d.IssuedDate,
+			ValidUntil:    d.ValidUntil,
+			Image:         d.Image.toDocumentFile(),
+		}
+	}
+	if d := c.Document; d != nil {
+		userData.Document = &Document{
+			Type:          d.Type,
+			Number:        d.Number,
+			CountryAlpha2: d.CountryAlpha2,
+			IssuedDate:    d.IssuedDate,
+			ValidUntil:    d.ValidUntil,
+			Image:         d.Image.toDocumentFile(),
+		}
+	}
+	if f := c.VideoAuth.toDocumentFile(); f != nil {
+		userData.VideoAuth = (*VideoAuth)(f)
+	}
+	if f := c.CompanyBoard.toDocumentFile(); f != nil {
+		userData.CompanyBoard = (*CompanyBoard)(f)
+	}
+	if f := c.CompanyRegistration.toDocumentFile(); f != nil {
+		userData.CompanyRegistration = (*CompanyRegistration)(f)
+	}
+}
+
+// toAddress converts the v2 address into the Address.
+func (a CustomerAddress) toAddress() Address {
+	return Address{
+		CountryAlpha2:     a.CountryAlpha2,
+		County:            a.County,
+		State:             a.State,
+		Town:              a.Town,
+		Suburb:            a.Suburb,
+		Street:            a.Street,
+		StreetType:        a.StreetType,
+		SubStreet:         a.SubStreet,
+		BuildingName:      a.BuildingName,
+		BuildingNumber:    a.BuildingNumber,
+		FlatNumber:        a.FlatNumber,
+		PostOfficeBox:     a.PostOfficeBox,
+		PostCode:          a.PostCode,
+		StateProvinceCode: a.StateProvinceCode,
+		StartDate:         a.StartDate,
+		EndDate:           a.EndDate,
+	}
+}
+
+// toWalletOwnershipProof converts the v2 ownership proof into the WalletOwnershipProof.
+func (p *CustomerOwnershipProof) toWalletOwnershipProof() *WalletOwnershipProof {
+	if p == nil {
+		return nil
+	}
+
+	return &WalletOwnershipProof{
+		Chain:       p.Chain,
+		Address:     p.Address,
+		Scheme:      p.Scheme,
+		Message:     p.Message,
+		Signature:   p.Signature,
+		ChallengeID: p.ChallengeID,
+		VerifiedAt:  p.VerifiedAt,
+	}
+}
+
+// toFundsDeclaration converts the v2 funds declaration into the FundsDeclaration.
+func (d *CustomerFundsDeclaration) toFundsDeclaration() *FundsDeclaration {
+	if d == nil {
+		return nil
+	}
+
+	return &FundsDeclaration{Sources: d.Sources, Description: d.Description}
+}
+
+// toDocumentFile converts the v2 document file into the DocumentFile.
+func (f *CustomerDocumentFile) toDocumentFile() *DocumentFile {
+	if f == nil {
+		return nil
+	}
+
+	return &DocumentFile{
+		Filename:    f.Filename,
+		ContentType: f.ContentType,
+		Data:        f.Data,
+		DocumentID:  f.DocumentID,
+	}
+}
@@ -21,6 +21,18 @@ const (
 	NonFinal
 )
 
+// RiskLevel defines the risk level associated to the customer by the KYC provider.
+type RiskLevel int
+
+// Possible RiskLevel values.
+const (
+	UnknownRisk RiskLevel = iota
+	LowRisk
+	MediumRisk
+	HighRisk
+	UnacceptableRisk
+)
+
 // Gender defines user's gender.
 type Gender int
 
@@ -49,6 +61,120 @@ const (
 	CipherTrace     KYCProvider = "CipherTrace"
 )
 
+// Blockchain represents a blockchain network a crypto address belongs to.
+type Blockchain string
+
+// Possible values of Blockchain.
+const (
+	Bitcoin  Blockchain = "BTC"
+	Ethereum Blockchain = "ETH"
+)
+
+// FundsSource defines a source of the customer wealth or funds.
+type FundsSource string
+
+// Possible values of FundsSource.
+const (
+	BusinessActivities FundsSource = "BusinessActivities"
+	StockSales         FundsSource = "StockSales"
+	RealEstateSale     FundsSource = "RealEstateSale"
+	Donation           FundsSource = "Donation"
+	Inheritance        FundsSource = "Inheritance"
+	CryptoTrading      FundsSource = "CryptoTrading"
+	ICOContribution    FundsSource = "ICOContribution"
+	OtherFundsSource   FundsSource = "Other"
+)
+
+// HealthStatus defines the result of the provider credentials probe.
+type HealthStatus string
+
+// Possible values of HealthStatus.
+const (
+	Healthy     HealthStatus = "OK"
+	AuthFailed  HealthStatus = "AuthFailed"
+	Unreachable HealthStatus = "Unreachable"
+	Unhealthy   HealthStatus = "Failed"
+)
+
+// JobStatus defines the state of the asynchronous job.
+type JobStatus string
+
+// Possible values of JobStatus.
+const (
+	JobQueued  JobStatus = "Queued"
+	JobRunning JobStatus = "Running"
+	// JobSucceeded means the provider has returned the result, the result might be of any KYC status.
+	JobSucceeded JobStatus = "Succeeded"
+	// JobFailed means the provider has returned the error reported by the job response.
+	JobFailed JobStatus = "Failed"
+)
+
+// CustomerStatus defines the overall KYC status of the customer by the latest verifications of all providers.
+type CustomerStatus string
+
+// Possible values of CustomerStatus.
+const (
+	CustomerNotVerified CustomerStatus = "NotVerified"
+	// CustomerPending means some of the providers haven't come to the decision yet or have failed.
+	CustomerPending  CustomerStatus = "Pending"
+	CustomerApproved CustomerStatus = "Approved"
+	// CustomerDenied means at least one of the providers has denied the customer.
+	CustomerDenied CustomerStatus = "Denied"
+)
+
+// BatchStatus defines the state of the batch verification.
+type BatchStatus string
+
+// Possible values of BatchStatus.
+const (
+	BatchRunning   BatchStatus = "Running"
+	BatchCompleted BatchStatus = "Completed"
+	// BatchCancelled means the processing has stopped before all the rows are verified.
+	BatchCancelled BatchStatus = "Cancelled"
+)
+
+// ErrorCategory defines the kind of the KYC verification failure.
+type ErrorCategory string
+
+// Possible values of ErrorCategory.
+const (
+	// ValidationError means the customer data doesn't fit the provider requirements.
+	ValidationError ErrorCategory = "Validation"
+	// AuthenticationError means the provider hasn't accepted the credentials from the config.
+	AuthenticationError ErrorCategory = "Authentication"
+	// RateLimitedError means the provider has throttled the requests.
+	RateLimitedError ErrorCategory = "RateLimited"
+	// ProviderUnavailableError means the provider hasn't responded properly because of a failure on its side or on the way to it.
+	ProviderUnavailableError ErrorCategory = "ProviderUnavailable"
+	// ProviderRejectedError means the provider has refused to handle the request.
+	ProviderRejectedError ErrorCategory = "ProviderRejected"
+	// TimeoutError means the provider hasn't responded in time.
+	TimeoutError ErrorCategory = "Timeout"
+)
+
+// ProblemCode defines the machine-readable code of the v2 API error.
+type ProblemCode string
+
+// Possible values of ProblemCode.
+const (
+	ProblemInvalidRequest       ProblemCode = "invalid_request"
+	ProblemNotFound             ProblemCode = "not_found"
+	ProblemMethodNotAllowed     ProblemCode = "method_not_allowed"
+	ProblemUnauthorized         ProblemCode = "unauthorized"
+	ProblemUnknownProvider      ProblemCode = "unknown_provider"
+	ProblemUnknownInstance      ProblemCode = "unknown_instance"
+	ProblemUnsupportedOperation ProblemCode = "unsupported_operation"
+	ProblemConfigError          ProblemCode = "config_error"
+	ProblemProviderError        ProblemCode = "provider_error"
+	ProblemValidationFailed     ProblemCode = "validation_failed"
+	ProblemProviderAuthFailed   ProblemCode = "provider_authentication_failed"
+	ProblemRateLimited          ProblemCode = "rate_limited"
+	ProblemProviderUnavailable  ProblemCode = "provider_unavailable"
+	ProblemProviderRejected     ProblemCode = "provider_rejected"
+	ProblemProviderTimeout      ProblemCode = "provider_timeout"
+	ProblemInternalError        ProblemCode = "internal_error"
+)
+
 // KYCProviders enumerates the implemented KYC providers.
 var KYCProviders = map[KYCProvider]bool{
 	Coinfirm:        true,
@@ -0,0 +1,157 @@
+package common
+
+import (
+	"io"
+	"net"
+	"net/http"
+)
+
+// KYCError represents the classified failure of the KYC verification.
+// Its message is the message of the underlying error, so wrapping it doesn't change the error text.
+type KYCError struct {
+	Category ErrorCategory
+	Provider KYCProvider
+	// StatusCode is the HTTP status code of the provider response or zero if no response has been received.
+	StatusCode int
+	Err        error
+}
+
+// Error implements the error interface for the KYCError.
+func (e *KYCError) Error() string {
+	return e.Err.Error()
+}
+
+// Cause returns the underlying error. It makes the KYCError compatible with github.com/pkg/errors.
+func (e *KYCError) Cause() error {
+	return e.Err
+}
+
+// Unwrap returns the underlying error.
+func (e *KYCError) Unwrap() error {
+	return e.Err
+}
+
+// Retryable tells whether the same request might succeed if repeated later.
+func (e *KYCError) Retryable() bool {
+	switch e.Category {
+	case RateLimitedError, ProviderUnavailableError, TimeoutError:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewError constructs the KYCError of the category specified.
+func NewError(provider KYCProvider, category ErrorCategory, err error) *KYCError {
+	return &KYCError{
+		Category: category,
+		Provider: provider,
+		Err:      err,
+	}
+}
+
+// NewValidationError constructs the KYCError of the customer data not fitting the provider requirements.
+func NewValidationError(provider KYCProvider, err error) *KYCError {
+	return NewError(provider, ValidationError, err)
+}
+
+// NewProviderError constructs the KYCError of the failed request to the provider.
+// The code is the HTTP status code of the provider response, the error is classified by it.
+// If the code is nil the error is classified by its cause: the network failures are the timeouts
+// or the provider unavailability, the rest, e.g. the failures to decode the provider response,
+// are the provider rejections as repeating the request won't help.
+// The error already classified by the integration keeps its category.
+func NewProviderError(provider KYCProvider, code *int, err error) *KYCError {
+	e := &KYCError{
+		Provider: provider,
+		Err:      err,
+	}
+
+	if classified, ok := AsKYCError(err); ok {
+		e.Category = classified.Category
+		e.StatusCode = classified.StatusCode
+		return e
+	}
+
+	if code == nil {
+		switch {
+		case isTimeout(err):
+			e.Category = TimeoutError
+		case isNetworkError(err):
+			e.Category = ProviderUnavailableError
+		default:
+			e.Category = ProviderRejectedError
+		}
+		return e
+	}
+
+	e.StatusCode = *code
+	e.Category = CategoryOfStatus(*code)
+
+	return e
+}
+
+// CategoryOfStatus returns the error category corresponding to the HTTP status code of the failed provider response.
+func CategoryOfStatus(code int) ErrorCategory {
+	switch {
+	case code == http.StatusUnauthorized || code == http.StatusForbidden:
+		return AuthenticationError
+	case code == http.StatusTooManyRequests:
+		return RateLimitedError
+	case code == http.StatusRequestTimeout || code == http.StatusGatewayTimeout:
+		return TimeoutError
+	case code >= http.StatusInternalServerError:
+		return ProviderUnavailableError
+	default:
+		return ProviderRejectedError
+	}
+}
+
+// AsKYCError finds the KYCError in the chain of the error causes.
+func AsKYCError(err error) (kycErr *KYCError, ok bool) {
+	for err != nil {
+		if kycErr, ok = err.(*KYCError); ok {
+			return
+		}
+		err = cause(err)
+	}
+
+	return
+}
+
+// isTimeout tells whether any error in the chain of the error causes is a network timeout.
+func isTimeout(err error) bool {
+	for err != nil {
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			return true
+		}
+		err = cause(err)
+	}
+
+	return false
+}
+
+// isNetworkError tells whether any error in the chain of the error causes is a network failure
+// or the response has been cut off.
+func isNetworkError(err error) bool {
+	for err != nil {
+		if _, ok := err.(net.Error); ok || err == io.ErrUnexpectedEOF || err == io.EOF {
+			return true
+		}
+		err = cause(err)
+	}
+
+	return false
+}
+
+// cause returns the underlying error of the wrapped one or nil.
+func cause(err error) error {
+	switch e := err.(type) {
+	case interface{ Cause() error }:
+		return e.Cause()
+	case interface{ Unwrap() error }:
+		return e.Unwrap()
+	default:
+		return nil
+	}
+}
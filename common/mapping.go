@@ -15,6 +15,15 @@ var KYCFinality2Finality = map[KYCFinality]string{
 	NonFinal: "NonFinal",
 }
 
+// RiskLevel2Level maps RiskLevel value to it's API representation.
+var RiskLevel2Level = map[RiskLevel]string{
+	UnknownRisk:      "Unknown",
+	LowRisk:          "Low",
+	MediumRisk:       "Medium",
+	HighRisk:         "High",
+	UnacceptableRisk: "Unacceptable",
+}
+
 // CountryAlpha2ToAlpha3 maps country Alpha-2 ISO codes to their Alpha-3 ISO code counterparts.
 var CountryAlpha2ToAlpha3 = map[string]string{
 	"AF": "AFG",
@@ -53,6 +53,12 @@ type UserData struct {
 	Other                    *Other
 	VideoAuth                *VideoAuth
 	Document                 *Document
+	// Financial profile fields.
+	PoliticallyExposed bool
+	CryptoAddresses    []CryptoAddress
+	SourceOfWealth     *FundsDeclaration
+	SourceOfFunds      *FundsDeclaration
+	BeneficialOwners   []BeneficialOwner
 	// Company type fields.
 	CompanyName         string
 	Website             string
@@ -74,11 +80,38 @@ type Business struct {
 	IncorporationJurisdiction string
 }
 
+// CryptoAddress defines a crypto wallet address of the customer.
+// The ownership proof is present if the customer has proven the control over the address.
+type CryptoAddress struct {
+	Chain          Blockchain
+	Address        string
+	OwnershipProof *WalletOwnershipProof
+}
+
+// FundsDeclaration defines the customer declaration of the source of wealth or funds.
+type FundsDeclaration struct {
+	Sources     []FundsSource
+	Description string
+}
+
+// BeneficialOwner defines the model for an ultimate beneficial owner.
+type BeneficialOwner struct {
+	FullName           string
+	DateOfBirth        Time
+	Nationality        string
+	Address            Address
+	OwnershipPercent   int
+	PoliticallyExposed bool
+	SourceOfWealth     *FundsDeclaration
+}
+
 // DocumentFile defines document's file containing its original or an image.
+// DocumentID references the file stored in the document vault instead of sending its data again.
 type DocumentFile struct {
 	Filename    string
 	ContentType string
 	Data        []byte
+	DocumentID  string `json:",omitempty"`
 }
 
 // KYCDetails defines additional details about the verification result.
@@ -90,18 +123,32 @@ type KYCDetails struct {
 // KYCResult represents the verification result.
 type KYCResult struct {
 	Status      KYCStatus
+	RiskLevel   RiskLevel
 	Details     *KYCDetails
 	ErrorCode   string
 	StatusCheck *KYCStatusCheck
 }
 
 // KYCStatusCheck contains data required to do status check requests if needed.
+// Instance is the named config instance of the provider the verification was created with.
 type KYCStatusCheck struct {
 	Provider    KYCProvider
+	Instance    string
 	ReferenceID string
 	LastCheck   time.Time
 }
 
+// WalletOwnershipProof represents the proof that the customer controls a crypto wallet address.
+type WalletOwnershipProof struct {
+	Chain       Blockchain
+	Address     string
+	Scheme      string
+	Message     string
+	Signature   string
+	ChallengeID string
+	VerifiedAt  time.Time
+}
+
 /*******************************************************************/
 /* Below are the models representing different types of documents. */
 /* Please, add new models for documents after this note.           */
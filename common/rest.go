@@ -1,17 +1,25 @@
 package common
 
+import "time"
+
 // TooManyRequests defines the error code returned when KYC status check requests send too frequently.
 const TooManyRequests = "429"
 
 // CheckCustomerRequest represents the request for the CheckCustomer handler.
+// Instance selects the named config instance of the provider, e.g. "eu" for the "Jumio:eu" section.
+// The default provider config is used if it's empty.
 type CheckCustomerRequest struct {
 	Provider KYCProvider
+	Instance string
 	UserData *UserData
 }
 
 // CheckStatusRequest represents the status check request payload of the CheckStatus handler.
+// The ReferenceID of the status check data returned for the named provider instance encodes the instance,
+// so Instance might be omitted. If it's specified it must match the encoded one.
 type CheckStatusRequest struct {
 	Provider    KYCProvider
+	Instance    string
 	ReferenceID string
 }
 
@@ -21,14 +29,26 @@ type ErrorResponse struct {
 }
 
 // KYCResponse represents the response for the CheckCustomer and the CheckStatus handlers.
+// ErrorCategory and Retryable classify the Error if the integration has reported its category.
+// Cache is present if the result has been taken from the cache.
 type KYCResponse struct {
-	Result *Result
-	Error  string
+	Result        *Result
+	Error         string
+	ErrorCategory ErrorCategory
+	Retryable     bool
+	Cache         *CacheInfo `json:",omitempty"`
+}
+
+// CacheInfo describes the cached verification result.
+type CacheInfo struct {
+	CachedAt  time.Time
+	ExpiresAt time.Time
 }
 
 // Result represents the verification result for the KYCResponse.
 type Result struct {
 	Status      string
+	RiskLevel   string
 	Details     *Details
 	ErrorCode   string
 	StatusCheck *KYCStatusCheck
@@ -40,11 +60,250 @@ type Details struct {
 	Reasons  []string
 }
 
+// JobRequest represents the request payload of the asynchronous CheckCustomer job submission.
+// The finished job is posted to the CallbackURL if it's specified.
+type JobRequest struct {
+	CheckCustomerRequest
+	CallbackURL string
+}
+
+// Job represents the asynchronous CheckCustomer job.
+// Response is present once the job has finished, it's the same as the CheckCustomer handler responds with.
+type Job struct {
+	ID          string
+	Status      JobStatus
+	Provider    KYCProvider
+	Instance    string `json:",omitempty"`
+	CallbackURL string `json:",omitempty"`
+	CreatedAt   time.Time
+	StartedAt   *time.Time   `json:",omitempty"`
+	CompletedAt *time.Time   `json:",omitempty"`
+	Response    *KYCResponse `json:",omitempty"`
+}
+
+// Batch represents the bulk verification of customers by a provider.
+// The counters are updated while the rows are processed.
+type Batch struct {
+	ID          string
+	Status      BatchStatus
+	Provider    KYCProvider
+	Instance    string `json:",omitempty"`
+	Total       int
+	Processed   int
+	Succeeded   int
+	Failed      int
+	CreatedAt   time.Time
+	CompletedAt *time.Time `json:",omitempty"`
+}
+
+// Customer represents the customer profile holding the latest customer data and the verifications of all providers.
+// Documents are the ids of the vault documents referenced by the customer data.
+// Status is the overall KYC status of the customer by the latest verification of every provider instance.
+// Wallets are the crypto wallet addresses the customer has proven to control.
+type Customer struct {
+	ID            string
+	Status        CustomerStatus
+	UserData      *UserData
+	Documents     []string `json:",omitempty"`
+	Verifications []CustomerVerification
+	Wallets       []CustomerWallet `json:",omitempty"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// CustomerWallet represents the ownership proof of the customer crypto wallet address
+// and the results of the address screening made when the proof was verified.
+type CustomerWallet struct {
+	Proof      *WalletOwnershipProof
+	Screenings []AddressScreening `json:",omitempty"`
+}
+
+// CustomerVerification represents the verification of the customer by the provider.
+// The result contains the status check reference if the verification might be rechecked later.
+type CustomerVerification struct {
+	Provider  KYCProvider
+	Instance  string `json:",omitempty"`
+	Result    *Result
+	Error     string `json:",omitempty"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CustomerVerificationRequest represents the request payload of the verification of the stored customer.
+type CustomerVerificationRequest struct {
+	Provider KYCProvider
+	Instance string
+}
+
+// StoredDocument represents the document file kept in the document vault.
+// ID is the hex-encoded SHA-256 of the content, so the same file is stored once.
+type StoredDocument struct {
+	ID          string
+	Filename    string `json:",omitempty"`
+	ContentType string `json:",omitempty"`
+	Size        int64
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// BatchRow represents the verification of the customer of the batch.
+// Row is the number of the customer in the batch input starting from 1.
+type BatchRow struct {
+	Row      int
+	Status   JobStatus
+	Response *KYCResponse `json:",omitempty"`
+}
+
+// ProviderImplementedResponse represents the response of the provider handler when the provider name is specified.
+type ProviderImplementedResponse struct {
+	Implemented bool
+}
+
+// CipherTraceRequest represents the request payload of the CipherTrace transaction check handler.
+type CipherTraceRequest struct {
+	Coin   string `json:"coin"`
+	TxHash string `json:"txHash"`
+}
+
+// WalletAddress represents the line of the wallet addresses stream.
+type WalletAddress struct {
+	Address string `json:"address"`
+}
+
+// OwnershipChallengeRequest represents the request payload of the wallet ownership challenge handler.
+// The challenge is issued for the stored customer, the verified proof is recorded in the customer profile.
+type OwnershipChallengeRequest struct {
+	CustomerID string
+	Chain      Blockchain
+	Address    string
+}
+
+// OwnershipProofRequest represents the request payload of the wallet ownership verification handler.
+// Scheme might be omitted, then it will be detected from the Chain of the challenge and the Signature.
+type OwnershipProofRequest struct {
+	ChallengeID string
+	Scheme      string
+	Signature   string
+}
+
+// OwnershipProofResponse represents the response of the wallet ownership verification handler.
+// Screenings hold the results of the address screening by every configured crypto screening provider.
+type OwnershipProofResponse struct {
+	Proof      *WalletOwnershipProof
+	Screenings []AddressScreening
+}
+
+// AddressScreening represents the result of the crypto address screening by a provider.
+type AddressScreening struct {
+	Provider KYCProvider
+	Result   interface{}
+	Error    string
+}
+
+// ProvidersHealthResponse represents the response payload of the providers health handler.
+type ProvidersHealthResponse struct {
+	ConfigVersion string
+	Providers     []ProviderHealth
+}
+
+// ProviderHealth represents the result of the provider credentials probe.
+type ProviderHealth struct {
+	Provider  KYCProvider
+	Instance  string
+	Status    HealthStatus
+	ErrorCode string
+	LatencyMs int64
+	Error     string
+}
+
+// ConfigResponse represents the response payload of the admin config handlers.
+type ConfigResponse struct {
+	Version  string
+	Source   string
+	LoadedAt time.Time
+	Error    string `json:",omitempty"`
+}
+
+// VerificationRequest represents the request payload of the v2 verification creation.
+// Instance selects the named config instance of the provider, the default provider config is used if it's empty.
+type VerificationRequest struct {
+	Provider KYCProvider   `json:"provider"`
+	Instance string        `json:"instance,omitempty"`
+	Customer *CustomerData `json:"customer"`
+}
+
+// Verification represents the verification resource of the v2 API.
+// ID is present only for the verifications which status might be checked later.
+type Verification struct {
+	ID        string               `json:"id,omitempty"`
+	Provider  KYCProvider          `json:"provider"`
+	Instance  string               `json:"instance,omitempty"`
+	Status    string               `json:"status"`
+	RiskLevel string               `json:"riskLevel,omitempty"`
+	Details   *VerificationDetails `json:"details,omitempty"`
+	ErrorCode string               `json:"errorCode,omitempty"`
+	Cache     *VerificationCache   `json:"cache,omitempty"`
+}
+
+// VerificationDetails defines additional details about the v2 verification.
+type VerificationDetails struct {
+	Finality string   `json:"finality,omitempty"`
+	Reasons  []string `json:"reasons,omitempty"`
+}
+
+// VerificationCache describes the cached v2 verification.
+type VerificationCache struct {
+	CachedAt  time.Time `json:"cachedAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// ProviderResource represents the KYC provider resource of the v2 API.
+type ProviderResource struct {
+	Name        KYCProvider `json:"name"`
+	Implemented bool        `json:"implemented"`
+}
+
+// ProviderListResponse represents the response payload of the v2 providers listing.
+type ProviderListResponse struct {
+	Providers []ProviderResource `json:"providers"`
+}
+
+// Problem represents the RFC 7807 problem details returned by the v2 API on errors.
+// The member names are defined by the RFC, Code is the machine-readable extension member.
+type Problem struct {
+	Type     string      `json:"type"`
+	Title    string      `json:"title"`
+	Status   int         `json:"status"`
+	Detail   string      `json:"detail,omitempty"`
+	Instance string      `json:"instance,omitempty"`
+	Code     ProblemCode `json:"code"`
+	// ProviderErrorCode is the error code returned by the KYC provider if any.
+	ProviderErrorCode string `json:"providerErrorCode,omitempty"`
+	// Retryable tells whether the same request might succeed if repeated later.
+	Retryable bool `json:"retryable"`
+}
+
+// NewKYCResponse forms the response of the verification from its result and error.
+func NewKYCResponse(result KYCResult, err error) (response KYCResponse) {
+	if err != nil {
+		response.Error = err.Error()
+		if kycErr, ok := AsKYCError(err); ok {
+			response.ErrorCategory = kycErr.Category
+			response.Retryable = kycErr.Retryable()
+		}
+	}
+
+	response.Result = ResultFromKYCResult(result)
+
+	return
+}
+
 // ResultFromKYCResult converts KYC verification result into the API representation.
 func ResultFromKYCResult(kycResult KYCResult) (result *Result) {
 	result = &Result{}
 
 	result.Status = KYCStatus2Status[kycResult.Status]
+	result.RiskLevel = RiskLevel2Level[kycResult.RiskLevel]
 	if kycResult.Details != nil {
 		result.Details = &Details{
 			Finality: KYCFinality2Finality[kycResult.Details.Finality],
@@ -0,0 +1,40 @@
+package kyc
+
+import (
+	"modulus/kyc/integrations/coinfirm"
+	"modulus/kyc/integrations/complyadvantage"
+	"modulus/kyc/integrations/identitymind"
+	"modulus/kyc/integrations/idology"
+	"modulus/kyc/integrations/jumio"
+	"modulus/kyc/integrations/shuftipro"
+	"modulus/kyc/integrations/sumsub"
+	"modulus/kyc/integrations/synapsefi"
+	"modulus/kyc/integrations/thomsonreuters"
+	"modulus/kyc/integrations/trulioo"
+)
+
+// Config holds the settings of the KYC providers used by the Service.
+// The configs of every provider are keyed by the instance names, the empty name stands for the default instance.
+// The providers without configs aren't available.
+type Config struct {
+	Coinfirm        map[string]coinfirm.Config
+	ComplyAdvantage map[string]complyadvantage.Config
+	IdentityMind    map[string]identitymind.Config
+	IDology         map[string]idology.Config
+	Jumio           map[string]jumio.Config
+	ShuftiPro       map[string]shuftipro.Config
+	SumSub          map[string]sumsub.Config
+	SynapseFI       map[string]synapsefi.Config
+	ThomsonReuters  map[string]thomsonreuters.Config
+	Trulioo         map[string]trulioo.Config
+
+	// CipherTrace enables the crypto screening if it's not nil.
+	CipherTrace *CipherTraceConfig
+}
+
+// CipherTraceConfig holds the settings of the CipherTrace crypto screening.
+type CipherTraceConfig struct {
+	URL      string
+	Key      string
+	Username string
+}
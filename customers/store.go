@@ -0,0 +1,321 @@
+package customers
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"modulus/kyc/common"
+
+	"github.com/google/uuid"
+)
+
+// KeySize is the size of the AES-256 key encrypting the persisted profiles.
+const KeySize = 32
+
+// fileSuffix is the file name suffix of the persisted profiles.
+const fileSuffix = ".customer"
+
+// Errors of the store.
+var (
+	// ErrNotFound means there is no customer with the specified id.
+	ErrNotFound = errors.New("customer not found")
+	// ErrInvalidKey means the encryption key isn't the AES-256 one.
+	ErrInvalidKey = errors.New("customers store key must be 32 bytes long")
+)
+
+// Store holds the customer profiles with their verification history.
+// The profiles are kept in memory and, if the store is persistent, written through to the directory
+// encrypted by AES-256-GCM, so they survive restarts. It's safe for concurrent use.
+type Store struct {
+	dir  string
+	aead cipher.AEAD
+
+	mu        sync.Mutex
+	customers map[string]*common.Customer
+}
+
+// NewStore constructs a new empty customers store keeping the profiles in memory only.
+func NewStore() *Store {
+	return &Store{
+		customers: map[string]*common.Customer{},
+	}
+}
+
+// Open constructs the persistent customers store in the directory specified and loads the profiles stored there.
+// The directory is created if it's missing.
+func Open(dir string, key []byte) (s *Store, err error) {
+	if len(key) != KeySize {
+		err = ErrInvalidKey
+		return
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return
+	}
+
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return
+	}
+
+	names, err := filepath.Glob(filepath.Join(dir, "*"+fileSuffix))
+	if err != nil {
+		return
+	}
+
+	s = NewStore()
+	s.dir, s.aead = dir, aead
+	for _, name := range names {
+		customer := &common.Customer{}
+		if err = s.load(filepath.Base(name), customer); err != nil {
+			return nil, err
+		}
+		s.customers[customer.ID] = customer
+	}
+
+	return
+}
+
+// Check tells whether the directory of the persistent store is writable.
+func (s *Store) Check() error {
+	if len(s.dir) == 0 {
+		return nil
+	}
+
+	tmp, err := ioutil.TempFile(s.dir, ".check-")
+	if err != nil {
+		return err
+	}
+	tmp.Close()
+
+	return os.Remove(tmp.Name())
+}
+
+// Create adds the new customer with the data and the documents specified.
+// The customer data is kept as is, so it mustn't be modified afterwards.
+func (s *Store) Create(userData *common.UserData, documents []string) (customer common.Customer, err error) {
+	now := time.Now().UTC()
+	c := &common.Customer{
+		ID:        uuid.New().String(),
+		UserData:  userData,
+		Documents: documents,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err = s.save(c); err != nil {
+		return
+	}
+	s.customers[c.ID] = c
+
+	return snapshot(c), nil
+}
+
+// Get returns the customer with the id specified.
+func (s *Store) Get(id string) (customer common.Customer, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.customers[id]
+	if !ok {
+		err = ErrNotFound
+		return
+	}
+
+	return snapshot(c), nil
+}
+
+// Update replaces the data and the documents of the customer. The verifications made before are kept.
+func (s *Store) Update(id string, userData *common.UserData, documents []string) (customer common.Customer, err error) {
+	return s.modify(id, func(c *common.Customer) error {
+		c.UserData = userData
+		c.Documents = documents
+		c.UpdatedAt = time.Now().UTC()
+		return nil
+	})
+}
+
+// AddVerification appends the verification to the history of the customer.
+func (s *Store) AddVerification(id string, verification common.CustomerVerification) (customer common.Customer, err error) {
+	return s.modify(id, func(c *common.Customer) error {
+		c.Verifications = append(c.Verifications, verification)
+		c.UpdatedAt = verification.UpdatedAt
+		return nil
+	})
+}
+
+// UpdateVerification replaces the result of the verification of the customer with the index specified,
+// e.g. by the result of its status check.
+func (s *Store) UpdateVerification(id string, i int, result *common.Result, errMessage string) (customer common.Customer, err error) {
+	return s.modify(id, func(c *common.Customer) error {
+		if i < 0 || i >= len(c.Verifications) {
+			return ErrNotFound
+		}
+
+		now := time.Now().UTC()
+		c.Verifications[i].Result = result
+		c.Verifications[i].Error = errMessage
+		c.Verifications[i].UpdatedAt = now
+		c.UpdatedAt = now
+		return nil
+	})
+}
+
+// AddWallet records the proven wallet of the customer. The wallet with the same address proven before is replaced.
+func (s *Store) AddWallet(id string, wallet common.CustomerWallet) (customer common.Customer, err error) {
+	return s.modify(id, func(c *common.Customer) error {
+		for i, w := range c.Wallets {
+			if w.Proof.Chain == wallet.Proof.Chain && w.Proof.Address == wallet.Proof.Address {
+				c.Wallets = append(c.Wallets[:i:i], c.Wallets[i+1:]...)
+				break
+			}
+		}
+		c.Wallets = append(c.Wallets, wallet)
+		c.UpdatedAt = wallet.Proof.VerifiedAt
+		return nil
+	})
+}
+
+// modify applies the change to the copy of the customer, persists it and replaces the customer by it.
+// The customer stays as it was if the change or the persisting fails.
+func (s *Store) modify(id string, change func(c *common.Customer) error) (customer common.Customer, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.customers[id]
+	if !ok {
+		err = ErrNotFound
+		return
+	}
+
+	modified := *c
+	modified.Verifications = append([]common.CustomerVerification(nil), c.Verifications...)
+	modified.Wallets = append([]common.CustomerWallet(nil), c.Wallets...)
+	if err = change(&modified); err != nil {
+		return
+	}
+	if err = s.save(&modified); err != nil {
+		return
+	}
+	s.customers[id] = &modified
+
+	return snapshot(&modified), nil
+}
+
+// save writes the encrypted customer to its file atomically if the store is persistent. The caller must hold the lock.
+func (s *Store) save(c *common.Customer) (err error) {
+	if len(s.dir) == 0 {
+		return
+	}
+
+	content, err := json.Marshal(c)
+	if err != nil {
+		return
+	}
+
+	name := c.ID + fileSuffix
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return
+	}
+	sealed := s.aead.Seal(nonce, nonce, content, []byte(name))
+
+	tmp, err := ioutil.TempFile(s.dir, ".tmp-")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(sealed); err != nil {
+		tmp.Close()
+		return
+	}
+	if err = tmp.Close(); err != nil {
+		return
+	}
+
+	return os.Rename(tmp.Name(), filepath.Join(s.dir, name))
+}
+
+// load reads and decrypts the customer file.
+func (s *Store) load(name string, c *common.Customer) (err error) {
+	sealed, err := ioutil.ReadFile(filepath.Join(s.dir, name))
+	if err != nil {
+		return
+	}
+
+	size := s.aead.NonceSize()
+	if len(sealed) < size {
+		return errors.New("corrupted customer " + name)
+	}
+	content, err := s.aead.Open(nil, sealed[:size], sealed[size:], []byte(name))
+	if err != nil {
+		return
+	}
+
+	return json.Unmarshal(content, c)
+}
+
+// Latest returns the indexes of the latest verifications of every provider instance in the order they were made.
+func Latest(verifications []common.CustomerVerification) (latest []int) {
+	last := map[string]int{}
+	for i, v := range verifications {
+		last[string(v.Provider)+":"+v.Instance] = i
+	}
+	for i, v := range verifications {
+		if last[string(v.Provider)+":"+v.Instance] == i {
+			latest = append(latest, i)
+		}
+	}
+
+	return
+}
+
+// Status returns the overall KYC status by the latest verifications of every provider instance.
+// Any denial denies the customer, the customer is approved when all the providers have approved it.
+func Status(verifications []common.CustomerVerification) (status common.CustomerStatus) {
+	latest := Latest(verifications)
+	if len(latest) == 0 {
+		return common.CustomerNotVerified
+	}
+
+	status = common.CustomerApproved
+	for _, i := range latest {
+		result := verifications[i].Result
+		switch {
+		case result != nil && result.Status == common.KYCStatus2Status[common.Denied]:
+			return common.CustomerDenied
+		case result == nil || result.Status != common.KYCStatus2Status[common.Approved] || len(verifications[i].Error) > 0:
+			status = common.CustomerPending
+		}
+	}
+
+	return
+}
+
+// snapshot returns the copy of the customer with its current status.
+func snapshot(c *common.Customer) (customer common.Customer) {
+	customer = *c
+	customer.Documents = append([]string(nil), c.Documents...)
+	customer.Verifications = append([]common.CustomerVerification{}, c.Verifications...)
+	customer.Wallets = append([]common.CustomerWallet(nil), c.Wallets...)
+	customer.Status = Status(customer.Verifications)
+
+	return
+}
@@ -0,0 +1,197 @@
+package customers
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"modulus/kyc/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// verification returns the verification by the provider with the result of the status specified.
+func verification(provider common.KYCProvider, status common.KYCStatus) common.CustomerVerification {
+	now := time.Now().UTC()
+	return common.CustomerVerification{
+		Provider:  provider,
+		Result:    &common.Result{Status: common.KYCStatus2Status[status]},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func TestStore(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewStore()
+
+	customer, err := s.Create(&common.UserData{FirstName: "John"}, []string{"doc1"})
+
+	assert.NoError(err)
+	assert.NotEmpty(customer.ID)
+	assert.Equal(common.CustomerNotVerified, customer.Status)
+	assert.Empty(customer.Verifications)
+
+	got, err := s.Get(customer.ID)
+
+	assert.NoError(err)
+	assert.Equal(customer, got)
+
+	customer, err = s.AddVerification(customer.ID, verification(common.IDology, common.Unclear))
+
+	if assert.NoError(err) {
+		assert.Equal(common.CustomerPending, customer.Status)
+		assert.Len(customer.Verifications, 1)
+	}
+
+	customer, err = s.UpdateVerification(customer.ID, 0, &common.Result{Status: "Approved"}, "")
+
+	if assert.NoError(err) {
+		assert.Equal(common.CustomerApproved, customer.Status)
+	}
+
+	customer, err = s.Update(customer.ID, &common.UserData{FirstName: "Jane"}, nil)
+
+	if assert.NoError(err) {
+		assert.Equal("Jane", customer.UserData.FirstName)
+		assert.Empty(customer.Documents)
+		assert.Len(customer.Verifications, 1)
+	}
+
+	// The snapshots aren't affected by the later changes.
+	s.AddVerification(customer.ID, verification(common.Trulioo, common.Denied))
+
+	assert.Len(customer.Verifications, 1)
+
+	_, err = s.Get("unknown")
+
+	assert.Equal(ErrNotFound, err)
+
+	_, err = s.Update("unknown", nil, nil)
+
+	assert.Equal(ErrNotFound, err)
+
+	_, err = s.AddVerification("unknown", common.CustomerVerification{})
+
+	assert.Equal(ErrNotFound, err)
+
+	_, err = s.UpdateVerification(customer.ID, 5, nil, "")
+
+	assert.Equal(ErrNotFound, err)
+}
+
+func TestAddWallet(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewStore()
+
+	customer, err := s.Create(&common.UserData{FirstName: "John"}, nil)
+	require.NoError(t, err)
+
+	proof := func(address, challengeID string) common.CustomerWallet {
+		return common.CustomerWallet{
+			Proof: &common.WalletOwnershipProof{
+				Chain:       common.Ethereum,
+				Address:     address,
+				ChallengeID: challengeID,
+				VerifiedAt:  time.Now().UTC(),
+			},
+			Screenings: []common.AddressScreening{{Provider: common.Coinfirm}},
+		}
+	}
+
+	s.AddWallet(customer.ID, proof("0x1", "c1"))
+	s.AddWallet(customer.ID, proof("0x2", "c2"))
+	customer, err = s.AddWallet(customer.ID, proof("0x1", "c3"))
+
+	if assert.NoError(err) && assert.Len(customer.Wallets, 2) {
+		assert.Equal("0x2", customer.Wallets[0].Proof.Address)
+		assert.Equal("c3", customer.Wallets[1].Proof.ChallengeID)
+		assert.Equal(common.Coinfirm, customer.Wallets[1].Screenings[0].Provider)
+	}
+
+	_, err = s.AddWallet("unknown", proof("0x1", "c4"))
+
+	assert.Equal(ErrNotFound, err)
+}
+
+func TestOpen(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "kyc-customers-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	key := []byte(strings.Repeat("k", KeySize))
+
+	_, err = Open(dir, key[1:])
+
+	assert.Equal(ErrInvalidKey, err)
+
+	s, err := Open(dir, key)
+	require.NoError(t, err)
+	assert.NoError(s.Check())
+
+	customer, err := s.Create(&common.UserData{FirstName: "John"}, []string{"doc1"})
+	require.NoError(t, err)
+	customer, err = s.AddVerification(customer.ID, verification(common.IDology, common.Approved))
+	require.NoError(t, err)
+
+	// The profiles are encrypted at rest.
+	data, err := ioutil.ReadFile(filepath.Join(dir, customer.ID+fileSuffix))
+	require.NoError(t, err)
+	assert.NotContains(string(data), "John")
+
+	// The profiles survive reopening.
+	s, err = Open(dir, key)
+	require.NoError(t, err)
+
+	got, err := s.Get(customer.ID)
+
+	if assert.NoError(err) {
+		assert.Equal("John", got.UserData.FirstName)
+		assert.Equal(common.CustomerApproved, got.Status)
+		assert.Len(got.Verifications, 1)
+	}
+
+	_, err = Open(dir, []byte(strings.Repeat("x", KeySize)))
+
+	assert.Error(err)
+}
+
+func TestStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	failed := verification(common.SumSub, common.Error)
+	failed.Result, failed.Error = nil, "provider error"
+	eu := verification(common.Trulioo, common.Denied)
+	eu.Instance = "eu"
+
+	tests := []struct {
+		verifications []common.CustomerVerification
+		status        common.CustomerStatus
+	}{
+		{nil, common.CustomerNotVerified},
+		{[]common.CustomerVerification{verification(common.IDology, common.Approved)}, common.CustomerApproved},
+		{[]common.CustomerVerification{verification(common.IDology, common.Approved), failed}, common.CustomerPending},
+		{[]common.CustomerVerification{verification(common.IDology, common.Unclear), verification(common.Trulioo, common.Denied)}, common.CustomerDenied},
+		// The latest verification of the provider counts.
+		{[]common.CustomerVerification{verification(common.Trulioo, common.Denied), verification(common.Trulioo, common.Approved)}, common.CustomerApproved},
+		{[]common.CustomerVerification{verification(common.Trulioo, common.Approved), eu}, common.CustomerDenied},
+	}
+
+	for i, test := range tests {
+		assert.Equal(test.status, Status(test.verifications), "test %d", i)
+	}
+
+	assert.Equal([]int{1, 2}, Latest([]common.CustomerVerification{
+		verification(common.Trulioo, common.Denied),
+		verification(common.IDology, common.Approved),
+		verification(common.Trulioo, common.Approved),
+	}))
+}
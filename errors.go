@@ -0,0 +1,59 @@
+package kyc
+
+import (
+	"fmt"
+
+	"modulus/kyc/common"
+)
+
+// Error represents the failure of the Service to serve the call before any request is sent to the provider.
+// The errors returned by the providers are reported as they are, see common.KYCError.
+type Error struct {
+	// Code classifies the failure the same way as the problem details of the HTTP API do.
+	Code     common.ProblemCode
+	Provider common.KYCProvider
+	Instance string
+	Message  string
+}
+
+// Error implements the error interface for the Error.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// AsError finds the Error in the chain of the error causes.
+func AsError(err error) (e *Error, ok bool) {
+	for err != nil {
+		if e, ok = err.(*Error); ok {
+			return
+		}
+		switch wrapper := err.(type) {
+		case interface{ Cause() error }:
+			err = wrapper.Cause()
+		case interface{ Unwrap() error }:
+			err = wrapper.Unwrap()
+		default:
+			err = nil
+		}
+	}
+
+	return
+}
+
+// newError constructs the Error of the provider instance.
+func newError(code common.ProblemCode, provider common.KYCProvider, instance string, format string, args ...interface{}) *Error {
+	return &Error{
+		Code:     code,
+		Provider: provider,
+		Instance: instance,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+// instanceName returns the human readable name of the provider instance.
+func instanceName(provider common.KYCProvider, instance string) string {
+	if len(instance) == 0 {
+		return string(provider)
+	}
+	return fmt.Sprintf("%s instance '%s'", provider, instance)
+}
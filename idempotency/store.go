@@ -0,0 +1,122 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultWindow is the time the responses are replayed for the repeated requests by default.
+const DefaultWindow = 24 * time.Hour
+
+// ErrMismatch means the idempotency key has already been used for a different request.
+var ErrMismatch = errors.New("idempotency key is already used for a different request")
+
+// Response represents the stored response replayed for the repeated requests.
+type Response struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// entry holds the response for the key. The done channel is closed when the first request has been handled.
+type entry struct {
+	fingerprint string
+	done        chan struct{}
+	response    Response
+	expiresAt   time.Time
+}
+
+// Store holds the responses by the idempotency keys for the window specified.
+// The responses are kept in memory, so they are lost on restart. It's safe for concurrent use.
+type Store struct {
+	window  time.Duration
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+// NewStore constructs a new store keeping the responses for the specified window.
+func NewStore(window time.Duration) *Store {
+	return &Store{
+		window:  window,
+		entries: map[string]*entry{},
+	}
+}
+
+// Do calls the handle function once for the key and returns its response for the repeats within the window.
+// The fingerprint identifies the request, reusing the key with another fingerprint fails with ErrMismatch.
+// The concurrent duplicates wait for the in-flight call until the context is done.
+// The handle function tells whether the response is stored, the key is released for the next request otherwise.
+func (s *Store) Do(ctx context.Context, key, fingerprint string, handle func() (Response, bool)) (response Response, replayed bool, err error) {
+	for {
+		s.mu.Lock()
+		now := time.Now()
+		s.removeExpired(now)
+
+		e, ok := s.entries[key]
+		if !ok {
+			e = &entry{fingerprint: fingerprint, done: make(chan struct{})}
+			s.entries[key] = e
+			s.mu.Unlock()
+
+			response = s.handle(key, e, handle)
+			return
+		}
+		s.mu.Unlock()
+
+		if e.fingerprint != fingerprint {
+			err = ErrMismatch
+			return
+		}
+
+		select {
+		case <-e.done:
+		case <-ctx.Done():
+			err = ctx.Err()
+			return
+		}
+
+		s.mu.Lock()
+		stored := s.entries[key] == e
+		s.mu.Unlock()
+
+		// The released key is taken by the next request.
+		if stored {
+			response, replayed = e.response, true
+			return
+		}
+	}
+}
+
+// handle calls the handle function for the entry and stores or releases it.
+// The entry is released if the function panics.
+func (s *Store) handle(key string, e *entry, handle func() (Response, bool)) (response Response) {
+	store := false
+	defer func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if store {
+			e.response = response
+			e.expiresAt = time.Now().Add(s.window)
+		} else {
+			delete(s.entries, key)
+		}
+		close(e.done)
+	}()
+
+	response, store = handle()
+
+	return
+}
+
+// removeExpired drops the expired responses. The caller must hold the lock.
+func (s *Store) removeExpired(now time.Time) {
+	for key, e := range s.entries {
+		if !e.expiresAt.IsZero() && now.After(e.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+}
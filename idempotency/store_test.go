@@ -0,0 +1,140 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewStore(time.Hour)
+	ctx := context.Background()
+
+	calls := 0
+	handle := func() (Response, bool) {
+		calls++
+		return Response{Status: http.StatusOK, Body: []byte("first")}, true
+	}
+
+	response, replayed, err := s.Do(ctx, "key", "body", handle)
+
+	assert.NoError(err)
+	assert.False(replayed)
+	assert.Equal("first", string(response.Body))
+
+	response, replayed, err = s.Do(ctx, "key", "body", handle)
+
+	assert.NoError(err)
+	assert.True(replayed)
+	assert.Equal(http.StatusOK, response.Status)
+	assert.Equal("first", string(response.Body))
+	assert.Equal(1, calls)
+
+	_, _, err = s.Do(ctx, "key", "another body", handle)
+
+	assert.Equal(ErrMismatch, err)
+	assert.Equal(1, calls)
+
+	_, replayed, err = s.Do(ctx, "another key", "body", handle)
+
+	assert.NoError(err)
+	assert.False(replayed)
+	assert.Equal(2, calls)
+}
+
+func TestStoreRelease(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewStore(time.Hour)
+	ctx := context.Background()
+
+	_, _, err := s.Do(ctx, "key", "body", func() (Response, bool) {
+		return Response{Status: http.StatusServiceUnavailable}, false
+	})
+	require.NoError(t, err)
+
+	response, replayed, err := s.Do(ctx, "key", "another body", func() (Response, bool) {
+		return Response{Status: http.StatusOK}, true
+	})
+
+	assert.NoError(err)
+	assert.False(replayed)
+	assert.Equal(http.StatusOK, response.Status)
+
+	// The key is released if the handler panics.
+	func() {
+		defer func() { recover() }()
+		s.Do(ctx, "panic", "body", func() (Response, bool) { panic("failed") })
+	}()
+
+	_, replayed, err = s.Do(ctx, "panic", "body", func() (Response, bool) {
+		return Response{Status: http.StatusOK}, true
+	})
+
+	assert.NoError(err)
+	assert.False(replayed)
+}
+
+func TestStoreConcurrent(t *testing.T) {
+	assert := assert.New(t)
+
+	s := NewStore(time.Hour)
+
+	calls := int32(0)
+	release := make(chan struct{})
+	handle := func() (Response, bool) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return Response{Status: http.StatusOK}, true
+	}
+
+	replays := int32(0)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, replayed, err := s.Do(context.Background(), "key", "body", handle)
+			assert.NoError(err)
+			if replayed {
+				atomic.AddInt32(&replays, 1)
+			}
+		}()
+	}
+
+	// The duplicates give up waiting when the context is done.
+	time.Sleep(20 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err := s.Do(ctx, "key", "body", handle)
+
+	assert.Equal(context.DeadlineExceeded, err)
+
+	close(release)
+	wg.Wait()
+
+	assert.Equal(int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(int32(4), atomic.LoadInt32(&replays))
+}
+
+func TestStoreExpiry(t *testing.T) {
+	s := NewStore(50 * time.Millisecond)
+	ctx := context.Background()
+	handle := func() (Response, bool) { return Response{Status: http.StatusOK}, true }
+
+	s.Do(ctx, "key", "body", handle)
+	time.Sleep(100 * time.Millisecond)
+
+	_, replayed, err := s.Do(ctx, "key", "another body", handle)
+
+	assert.NoError(t, err)
+	assert.False(t, replayed)
+}
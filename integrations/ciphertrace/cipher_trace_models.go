@@ -28,21 +28,24 @@ type TransactionHistoryForAddress struct {
 	Transactions []string `json:"transactions"`
 }
 
+// Transaction represents an on-chain transaction.
+// Values, totals and fees are reported in the smallest units of the chain (satoshi, wei),
+// use common.Blockchain.FromBaseUnits to convert them.
 type Transaction struct {
 	TxHash  string `json:"tx_hash"`
 	Outputs []struct {
-		Pos     int     `json:"pos"`
-		Address string  `json:"address"`
-		Value   float64 `json:"value"`
+		Pos     int             `json:"pos"`
+		Address string          `json:"address"`
+		Value   decimal.Decimal `json:"value"`
 	} `json:"outputs"`
-	Total  float64 `json:"total"`
+	Total  decimal.Decimal `json:"total"`
 	Inputs []struct {
-		Pos     int     `json:"pos"`
-		Address string  `json:"address"`
-		Value   float64 `json:"value"`
+		Pos     int             `json:"pos"`
+		Address string          `json:"address"`
+		Value   decimal.Decimal `json:"value"`
 	} `json:"inputs"`
-	Date int     `json:"date"`
-	Fee  float64 `json:"fee"`
+	Date int             `json:"date"`
+	Fee  decimal.Decimal `json:"fee"`
 }
 
 type IpHistory struct {
@@ -61,38 +64,40 @@ type TransactionDetails struct {
 	IpHistory    map[string][]IpHistory `json:"ipHistory"`
 }
 
+// AddressSearchesInfo represents the address balance and history.
+// All amounts are reported in the smallest units of the chain.
 type AddressSearchesInfo struct {
-	LastUsedBlockHeight int         `json:"lastUsedBlockHeight"`
-	QuerySpent          float64     `json:"querySpent"`
-	QueryEndingBalance  float64     `json:"queryEndingBalance"`
-	EndDate             int         `json:"endDate"`
-	TotalSpendCount     int         `json:"totalSpendCount"`
-	TotalSpent          float64     `json:"totalSpent"`
-	TotalDepositCount   int         `json:"totalDepositCount"`
-	QueryDeposits       float64     `json:"queryDeposits"`
-	CurrentBalance      float64     `json:"currentBalance"`
-	QueryDepositCount   int         `json:"queryDepositCount"`
-	IPHistory           []IpHistory `json:"ipHistory"`
-	QuerySpendCount     int         `json:"querySpendCount"`
-	Address             string      `json:"address"`
+	LastUsedBlockHeight int             `json:"lastUsedBlockHeight"`
+	QuerySpent          decimal.Decimal `json:"querySpent"`
+	QueryEndingBalance  decimal.Decimal `json:"queryEndingBalance"`
+	EndDate             int             `json:"endDate"`
+	TotalSpendCount     int             `json:"totalSpendCount"`
+	TotalSpent          decimal.Decimal `json:"totalSpent"`
+	TotalDepositCount   int             `json:"totalDepositCount"`
+	QueryDeposits       decimal.Decimal `json:"queryDeposits"`
+	CurrentBalance      decimal.Decimal `json:"currentBalance"`
+	QueryDepositCount   int             `json:"queryDepositCount"`
+	IPHistory           []IpHistory     `json:"ipHistory"`
+	QuerySpendCount     int             `json:"querySpendCount"`
+	Address             string          `json:"address"`
 	TxHistory           []struct {
-		TxHash   string  `json:"txHash"`
-		TxIndex  int     `json:"txIndex"`
-		Balance  float64 `json:"balance"`
-		Date     int     `json:"date"`
-		Received float64 `json:"received"`
-		Spent    float64 `json:"spent"`
+		TxHash   string          `json:"txHash"`
+		TxIndex  int             `json:"txIndex"`
+		Balance  decimal.Decimal `json:"balance"`
+		Date     int             `json:"date"`
+		Received decimal.Decimal `json:"received"`
+		Spent    decimal.Decimal `json:"spent"`
 	} `json:"txHistory"`
-	InCase        bool    `json:"inCase"`
-	StartDate     int     `json:"startDate"`
-	Wallet        Wallet  `json:"wallet"`
-	TotalDeposits float64 `json:"totalDeposits"`
+	InCase        bool            `json:"inCase"`
+	StartDate     int             `json:"startDate"`
+	Wallet        Wallet          `json:"wallet"`
+	TotalDeposits decimal.Decimal `json:"totalDeposits"`
 }
 
 type Risk struct {
 	OutputValue     decimal.Decimal `json:"outputValue"`
 	CallBackSeconds int             `json:"callBackSeconds"`
-	Risk            decimal.Decimal `json:"risk"`
+	Risk            float64         `json:"risk"`
 	Address         string          `json:"address"`
 	InputValue      decimal.Decimal `json:"inputValue"`
 }
@@ -113,9 +118,9 @@ type SingleAddressRisk struct {
 }
 
 type ETHAddressRisk struct {
-	CallBackSeconds int     `json:"callBackSeconds"`
-	Address         string  `json:"address"`
-	Risk            float64 `json:"risk"`
-	UpdateToBlock   int     `json:"updateToBlock"`
-	Balance         string  `json:"balance"`
+	CallBackSeconds int             `json:"callBackSeconds"`
+	Address         string          `json:"address"`
+	Risk            float64         `json:"risk"`
+	UpdateToBlock   int             `json:"updateToBlock"`
+	Balance         decimal.Decimal `json:"balance"`
 }
@@ -0,0 +1,63 @@
+package ciphertrace
+
+import (
+	"encoding/json"
+	"testing"
+
+	"modulus/kyc/common"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestTransaction_DecimalAmounts(t *testing.T) {
+	data := []byte(`{
+		"tx_hash": "f4184fc596403b9d638783cf57adfe4c75c605f6356fbc91338530e9831e9e16",
+		"outputs": [{"pos": 0, "address": "1Q2TWHE3GMdB6BZKafqwxXtWAWgFt5Jvm3", "value": 2099999997690000}],
+		"total": 2099999997690000,
+		"inputs": [{"pos": 0, "address": "12cbQLTFMXRnSzktFkuoG3eHoMeFtpTu3S", "value": 2099999997690001}],
+		"date": 1231731025,
+		"fee": 1
+	}`)
+
+	tx := Transaction{}
+	if err := json.Unmarshal(data, &tx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	fee := tx.Inputs[0].Value.Sub(tx.Outputs[0].Value)
+	if !fee.Equal(tx.Fee) {
+		t.Errorf("Fee should be exactly %s, got %s", tx.Fee, fee)
+	}
+	if total := common.Bitcoin.FromBaseUnits(tx.Total).String(); total != "20999999.9769" {
+		t.Errorf("Unexpected total: %s", total)
+	}
+
+	resp, err := json.Marshal(tx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	decoded := Transaction{}
+	if err := json.Unmarshal(resp, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !decoded.Inputs[0].Value.Equal(tx.Inputs[0].Value) {
+		t.Errorf("Value lost precision: %s", decoded.Inputs[0].Value)
+	}
+}
+
+func TestETHAddressRisk_DecimalBalance(t *testing.T) {
+	risk := ETHAddressRisk{}
+	if err := json.Unmarshal([]byte(`{"address": "0xde0b295669a9fd93d5f28d9ec85e40f4cb697bae", "risk": 1.5, "balance": "123456789012345678901"}`), &risk); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if balance := common.Ethereum.FromBaseUnits(risk.Balance).String(); balance != "123.456789012345678901" {
+		t.Errorf("Unexpected balance: %s", balance)
+	}
+	if risk.Risk != 1.5 {
+		t.Errorf("Unexpected risk: %v", risk.Risk)
+	}
+	if wei := common.Ethereum.ToBaseUnits(decimal.RequireFromString("0.0000000000000000015")); !wei.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("Fractions of wei should be truncated, got %s", wei)
+	}
+}
@@ -111,8 +111,8 @@ func (cipherService *CipherService) GetWalletWithAddresses(walletId string, offs
 	if walletId == "" {
 		return &WalletWithAddresses{}, errors.New("wallet id can not be empty"), http.StatusBadRequest // if the provided walled id is empty return a 400 status and a message
 	}
-	if count == 0 {
-		return &WalletWithAddresses{}, errors.New("count should be bigger than 0"), http.StatusBadRequest // if the provided count is 0 return a 400 status and a message
+	if count <= 0 {
+		return &WalletWithAddresses{}, errors.New("count should be bigger than 0"), http.StatusBadRequest // if the provided count isn't positive return a 400 status and a message
 	}
 	if offset%100 != 0 || count%100 != 0 {
 		return &WalletWithAddresses{}, errors.New("offset and counter should be multipliers of 100"), http.StatusBadRequest // if the provided count or offest  is not a multiplier of 100 return a 400 status and a message
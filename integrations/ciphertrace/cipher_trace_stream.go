@@ -0,0 +1,323 @@
+package ciphertrace
+
+import (
+	"sync"
+	"time"
+)
+
+// Default and maximum values of StreamOptions.
+const (
+	DefaultPageSize    = 1000
+	DefaultConcurrency = 4
+	MaxConcurrency     = 16
+)
+
+// StreamOptions controls how a wallet is walked through.
+type StreamOptions struct {
+	// PageSize is the number of addresses requested at once. It should be a multiplier of 100 not bigger than 10000.
+	PageSize int
+	// Concurrency limits the number of simultaneous requests to the API. It's capped by MaxConcurrency.
+	Concurrency int
+}
+
+// withDefaults fills unset or invalid options with default values and caps the concurrency.
+func (o StreamOptions) withDefaults() StreamOptions {
+	if o.PageSize <= 0 {
+		o.PageSize = DefaultPageSize
+	}
+	if o.Concurrency < 1 {
+		o.Concurrency = DefaultConcurrency
+	}
+	if o.Concurrency > MaxConcurrency {
+		o.Concurrency = MaxConcurrency
+	}
+	return o
+}
+
+// StreamError represents an error occurred while walking through a wallet along with the HTTP status code.
+type StreamError struct {
+	Status int
+	Err    error
+}
+
+// Error implements the error interface for the StreamError.
+func (e StreamError) Error() string {
+	return e.Err.Error()
+}
+
+// AddressIterator walks through all addresses of a wallet.
+// Pages are fetched concurrently ahead of the consumer but no further than the concurrency limit,
+// so a slow consumer slows down the requests to the API.
+//
+//	it := service.WalletAddresses(walletId, StreamOptions{})
+//	defer it.Close()
+//	for it.Next() {
+//		fmt.Println(it.Address())
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type AddressIterator struct {
+	stream  *stream
+	wallet  *Wallet
+	page    []string
+	current string
+	err     error
+	ready   chan struct{}
+}
+
+// WalletAddresses returns an iterator over all addresses of the wallet.
+func (cipherService *CipherService) WalletAddresses(walletId string, opts StreamOptions) *AddressIterator {
+	opts = opts.withDefaults()
+
+	it := &AddressIterator{
+		stream: newStream(opts.Concurrency),
+		ready:  make(chan struct{}),
+	}
+
+	go func() {
+		defer it.stream.finish()
+
+		first := make(chan *WalletWithAddresses, 1)
+		ok := it.stream.enqueue(func() (interface{}, error) {
+			wallet, err, status := cipherService.GetWalletWithAddresses(walletId, 0, opts.PageSize)
+			if err != nil {
+				first <- nil
+				return nil, StreamError{Status: status, Err: err}
+			}
+			first <- wallet
+			return wallet.Addresses, nil
+		})
+		if !ok {
+			return
+		}
+
+		wallet := <-first
+		if wallet == nil {
+			return
+		}
+		it.wallet = &wallet.Wallet
+		close(it.ready)
+
+		for offset := opts.PageSize; offset < wallet.TotalAddressCount; offset += opts.PageSize {
+			offset := offset
+			ok := it.stream.enqueue(func() (interface{}, error) {
+				page, err, status := cipherService.GetWalletWithAddresses(walletId, offset, opts.PageSize)
+				if err != nil {
+					return nil, StreamError{Status: status, Err: err}
+				}
+				return page.Addresses, nil
+			})
+			if !ok {
+				return
+			}
+		}
+	}()
+
+	return it
+}
+
+// Next advances the iterator to the next address. It returns false when there are no more addresses or an error occurred.
+func (it *AddressIterator) Next() bool {
+	for len(it.page) == 0 {
+		if it.err != nil {
+			return false
+		}
+
+		page, ok, err := it.stream.next()
+		if err != nil {
+			it.err = err
+			it.Close()
+			return false
+		}
+		if !ok {
+			return false
+		}
+		it.page = page.([]string)
+	}
+
+	it.current, it.page = it.page[0], it.page[1:]
+
+	return true
+}
+
+// Address returns the current address.
+func (it *AddressIterator) Address() string {
+	return it.current
+}
+
+// Wallet returns the wallet being walked through. It's nil until the first page is fetched.
+func (it *AddressIterator) Wallet() *Wallet {
+	select {
+	case <-it.ready:
+		return it.wallet
+	default:
+		return nil
+	}
+}
+
+// Err returns the error occurred during the iteration if any.
+func (it *AddressIterator) Err() error {
+	return it.err
+}
+
+// Close stops the iteration and releases its resources. It's safe to call it several times.
+func (it *AddressIterator) Close() {
+	it.stream.close()
+}
+
+// HistoryIterator walks through transaction histories of all addresses of a wallet.
+// Histories are fetched concurrently ahead of the consumer but no further than the concurrency limit.
+type HistoryIterator struct {
+	stream    *stream
+	addresses *AddressIterator
+	current   *TransactionHistoryForAddress
+	err       error
+}
+
+// WalletHistories returns an iterator over transaction histories of all addresses of the wallet.
+// If from is zero, the whole history is requested. If to is zero, the history is requested up to now.
+func (cipherService *CipherService) WalletHistories(walletId string, from, to time.Time, opts StreamOptions) *HistoryIterator {
+	opts = opts.withDefaults()
+
+	var dates []int64
+	if !from.IsZero() {
+		dates = append(dates, from.Unix())
+		if !to.IsZero() {
+			dates = append(dates, to.Unix())
+		}
+	}
+
+	it := &HistoryIterator{
+		stream:    newStream(opts.Concurrency),
+		addresses: cipherService.WalletAddresses(walletId, opts),
+	}
+
+	go func() {
+		defer it.stream.finish()
+		defer it.addresses.Close()
+
+		for it.addresses.Next() {
+			address := it.addresses.Address()
+			ok := it.stream.enqueue(func() (interface{}, error) {
+				history, err, status := cipherService.GetTransactionHistoryForAddress(address, dates...)
+				if err != nil {
+					return nil, StreamError{Status: status, Err: err}
+				}
+				return history, nil
+			})
+			if !ok {
+				return
+			}
+		}
+		if err := it.addresses.Err(); err != nil {
+			it.stream.enqueue(func() (interface{}, error) {
+				return nil, err
+			})
+		}
+	}()
+
+	return it
+}
+
+// Next advances the iterator to the next history. It returns false when there are no more histories or an error occurred.
+func (it *HistoryIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	history, ok, err := it.stream.next()
+	if err != nil {
+		it.err = err
+		it.Close()
+		return false
+	}
+	if !ok {
+		return false
+	}
+	it.current = history.(*TransactionHistoryForAddress)
+
+	return true
+}
+
+// History returns the current transaction history.
+func (it *HistoryIterator) History() *TransactionHistoryForAddress {
+	return it.current
+}
+
+// Err returns the error occurred during the iteration if any.
+func (it *HistoryIterator) Err() error {
+	return it.err
+}
+
+// Close stops the iteration and releases its resources. It's safe to call it several times.
+func (it *HistoryIterator) Close() {
+	it.stream.close()
+}
+
+// fetchResult holds the outcome of a single fetch.
+type fetchResult struct {
+	value interface{}
+	err   error
+}
+
+// stream runs fetches with bounded parallelism and delivers their results in the order they were enqueued.
+type stream struct {
+	results chan chan fetchResult
+	done    chan struct{}
+	once    sync.Once
+}
+
+// newStream constructs a new stream allowing the specified number of simultaneous fetches.
+func newStream(concurrency int) *stream {
+	// The consumer holds one more pending result while waiting for it.
+	return &stream{
+		results: make(chan chan fetchResult, concurrency-1),
+		done:    make(chan struct{}),
+	}
+}
+
+// enqueue starts the fetch as soon as there is a free slot. It blocks until then.
+// It returns false if the stream is closed.
+func (s *stream) enqueue(fetch func() (interface{}, error)) bool {
+	result := make(chan fetchResult, 1)
+
+	select {
+	case s.results <- result:
+	case <-s.done:
+		return false
+	}
+
+	go func() {
+		value, err := fetch()
+		result <- fetchResult{value: value, err: err}
+	}()
+
+	return true
+}
+
+// finish signals that there will be no more fetches. It must be called by the producer.
+func (s *stream) finish() {
+	close(s.results)
+}
+
+// next waits for the result of the next fetch. It returns false if there are no more fetches.
+func (s *stream) next() (interface{}, bool, error) {
+	select {
+	case result, ok := <-s.results:
+		if !ok {
+			return nil, false, nil
+		}
+		r := <-result
+		return r.value, r.err == nil, r.err
+	case <-s.done:
+		return nil, false, nil
+	}
+}
+
+// close stops the stream.
+func (s *stream) close() {
+	s.once.Do(func() {
+		close(s.done)
+	})
+}
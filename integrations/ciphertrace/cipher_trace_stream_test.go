@@ -0,0 +1,217 @@
+package ciphertrace
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// walletServer serves a fake wallet with the specified number of addresses and tracks the parallelism of requests.
+type walletServer struct {
+	total    int
+	failAt   int
+	inFlight int32
+	maxSeen  int32
+	requests int32
+	mu       sync.Mutex
+}
+
+func (ws *walletServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n := atomic.AddInt32(&ws.inFlight, 1)
+	defer atomic.AddInt32(&ws.inFlight, -1)
+	atomic.AddInt32(&ws.requests, 1)
+
+	ws.mu.Lock()
+	if n > ws.maxSeen {
+		ws.maxSeen = n
+	}
+	ws.mu.Unlock()
+
+	time.Sleep(5 * time.Millisecond)
+
+	query := r.URL.Query()
+
+	switch r.URL.Path {
+	case "/api/v1/wallet/addresses":
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		count, _ := strconv.Atoi(query.Get("count"))
+		if ws.failAt > 0 && offset >= ws.failAt {
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte("upstream failure"))
+			return
+		}
+
+		wallet := WalletWithAddresses{
+			AddressOffset: offset,
+			Wallet: Wallet{
+				WalletID:          query.Get("wallet_id"),
+				TotalAddressCount: ws.total,
+			},
+		}
+		for i := offset; i < offset+count && i < ws.total; i++ {
+			wallet.Addresses = append(wallet.Addresses, fmt.Sprintf("address-%d", i))
+		}
+		json.NewEncoder(w).Encode(wallet)
+	case "/api/v1/tx/search":
+		json.NewEncoder(w).Encode(TransactionHistoryForAddress{
+			Address:      query.Get("address"),
+			Transactions: []string{"tx-" + query.Get("address")},
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestCipherService_WalletAddresses(t *testing.T) {
+	ws := &walletServer{total: 2550}
+	server := httptest.NewServer(ws)
+	defer server.Close()
+
+	service := NewCipherService(server.URL, "key", "user")
+
+	it := service.WalletAddresses("wallet", StreamOptions{PageSize: 100, Concurrency: 3})
+	defer it.Close()
+
+	count := 0
+	for it.Next() {
+		if expected := fmt.Sprintf("address-%d", count); it.Address() != expected {
+			t.Fatalf("Unexpected address order. Expected %s, got %s", expected, it.Address())
+		}
+		count++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if count != ws.total {
+		t.Errorf("Expected %d addresses, got %d", ws.total, count)
+	}
+	if it.Wallet() == nil || it.Wallet().WalletID != "wallet" {
+		t.Errorf("Wallet should be available after the first page")
+	}
+	if ws.maxSeen > 3 {
+		t.Errorf("Concurrency limit exceeded: %d requests in flight", ws.maxSeen)
+	}
+}
+
+func TestCipherService_WalletAddressesBackpressure(t *testing.T) {
+	ws := &walletServer{total: 10000}
+	server := httptest.NewServer(ws)
+	defer server.Close()
+
+	service := NewCipherService(server.URL, "key", "user")
+
+	it := service.WalletAddresses("wallet", StreamOptions{PageSize: 100, Concurrency: 2})
+
+	if !it.Next() {
+		t.Fatalf("Unexpected end of iteration: %v", it.Err())
+	}
+
+	// The consumer stalls, only a couple of pages might be fetched ahead.
+	time.Sleep(100 * time.Millisecond)
+	if requests := atomic.LoadInt32(&ws.requests); requests > 4 {
+		t.Errorf("Too many pages fetched ahead of the consumer: %d", requests)
+	}
+
+	// Addresses fetched before closing might still be consumed, but the iteration has to stop.
+	it.Close()
+	for it.Next() {
+	}
+	if requests := atomic.LoadInt32(&ws.requests); requests > 5 {
+		t.Errorf("Pages fetched after closing the iterator: %d", requests)
+	}
+}
+
+func TestCipherService_WalletAddressesError(t *testing.T) {
+	ws := &walletServer{total: 1000, failAt: 500}
+	server := httptest.NewServer(ws)
+	defer server.Close()
+
+	service := NewCipherService(server.URL, "key", "user")
+
+	it := service.WalletAddresses("wallet", StreamOptions{PageSize: 100})
+	defer it.Close()
+
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 500 {
+		t.Errorf("Expected 500 addresses before the failure, got %d", count)
+	}
+
+	err, ok := it.Err().(StreamError)
+	if !ok {
+		t.Fatalf("Expected StreamError, got %v", it.Err())
+	}
+	if err.Status != http.StatusBadGateway {
+		t.Errorf("Expected status %d, got %d", http.StatusBadGateway, err.Status)
+	}
+
+	it = service.WalletAddresses("", StreamOptions{})
+	if it.Next() {
+		t.Error("Iteration should fail for empty wallet id")
+	}
+	if err, ok := it.Err().(StreamError); !ok || err.Status != http.StatusBadRequest {
+		t.Errorf("Expected bad request error, got %v", it.Err())
+	}
+}
+
+func TestCipherService_WalletHistories(t *testing.T) {
+	ws := &walletServer{total: 250}
+	server := httptest.NewServer(ws)
+	defer server.Close()
+
+	service := NewCipherService(server.URL, "key", "user")
+
+	it := service.WalletHistories("wallet", time.Time{}, time.Time{}, StreamOptions{PageSize: 100, Concurrency: 8})
+	defer it.Close()
+
+	count := 0
+	for it.Next() {
+		address := fmt.Sprintf("address-%d", count)
+		if it.History().Address != address || it.History().Transactions[0] != "tx-"+address {
+			t.Fatalf("Unexpected history for %s: %+v", address, it.History())
+		}
+		count++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if count != ws.total {
+		t.Errorf("Expected %d histories, got %d", ws.total, count)
+	}
+
+	ws.failAt = 100
+
+	it = service.WalletHistories("wallet", time.Time{}, time.Time{}, StreamOptions{PageSize: 100})
+	defer it.Close()
+
+	count = 0
+	for it.Next() {
+		count++
+	}
+	if count != 100 {
+		t.Errorf("Expected 100 histories before the failure, got %d", count)
+	}
+	if it.Err() == nil {
+		t.Error("Expected the failure of the address listing")
+	}
+}
+
+func TestStreamOptions_withDefaults(t *testing.T) {
+	opts := StreamOptions{PageSize: -100, Concurrency: 1000}.withDefaults()
+	if opts.PageSize != DefaultPageSize || opts.Concurrency != MaxConcurrency {
+		t.Errorf("unexpected options: %+v", opts)
+	}
+
+	opts = StreamOptions{PageSize: 200, Concurrency: 2}.withDefaults()
+	if opts.PageSize != 200 || opts.Concurrency != 2 {
+		t.Errorf("unexpected options: %+v", opts)
+	}
+}
@@ -142,3 +142,26 @@ func (c Coinfirm) getParticipantCurrentStatus(headers http.Headers, pID string)
 
 	return
 }
+
+// getAddressReport requests the AML report of the crypto address from the API.
+func (c Coinfirm) getAddressReport(headers http.Headers, address string) (report model.AddressReport, code *int, err error) {
+	rcode, resp, err := http.Get(c.config.Host+"/reports/aml/standard/"+address, headers)
+	if err != nil {
+		return
+	}
+
+	if rcode != stdhttp.StatusOK {
+		code = &rcode
+		eresp := &model.ErrorResponse{}
+		if err = json.Unmarshal(resp, eresp); err != nil {
+			err = errors.New("http error")
+			return
+		}
+		err = eresp
+		return
+	}
+
+	err = json.Unmarshal(resp, &report)
+
+	return
+}
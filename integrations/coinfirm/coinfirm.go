@@ -1,16 +1,27 @@
 package coinfirm
 
 import (
-	"errors"
-	"fmt"
+	stdhttp "net/http"
 	"strconv"
+	"time"
 
 	"modulus/kyc/common"
 	"modulus/kyc/http"
 	"modulus/kyc/integrations/coinfirm/model"
+	"modulus/kyc/ttlcache"
+	"modulus/kyc/upload"
+
+	"github.com/pkg/errors"
 )
 
 var _ common.KYCPlatform = Coinfirm{}
+var _ common.CredentialsProber = Coinfirm{}
+
+// tokenTTL is the time the auth token is reused for.
+const tokenTTL = 10 * time.Minute
+
+// tokens caches the auth tokens of the Coinfirm users.
+var tokens = ttlcache.New()
 
 // Coinfirm represents the Coinfirm API client.
 type Coinfirm struct {
@@ -28,7 +39,7 @@ func New(config Config) Coinfirm {
 // CheckCustomer implements KYCPlatform interface for the Coinfirm.
 func (c Coinfirm) CheckCustomer(customer *common.UserData) (res common.KYCResult, err error) {
 	if customer == nil {
-		err = errors.New("customer is absent or no data received")
+		err = common.NewValidationError(common.Coinfirm, errors.New("customer is absent or no data received"))
 		return
 	}
 
@@ -36,27 +47,32 @@ func (c Coinfirm) CheckCustomer(customer *common.UserData) (res common.KYCResult
 
 	headers := headers()
 
-	token, code, err := c.newAuthToken(headers)
+	code, err := c.authorize(headers, false)
 	if err != nil {
 		if code != nil {
 			res.ErrorCode = strconv.Itoa(*code)
 		}
-		err = fmt.Errorf("during sending auth request: %s", err)
+		err = common.NewProviderError(common.Coinfirm, code, errors.Wrap(err, "during sending auth request"))
 		return
 	}
 
-	headers["Authorization"] = "Bearer " + token.Token
-
 	newParticipant := model.NewParticipant{
-		Email: customer.Email,
+		Email:           customer.Email,
+		CryptoAddresses: prepareCryptoAddresses(customer),
 	}
 
 	participant, code, err := c.newParticipant(headers, newParticipant)
+	if unauthorized(code) {
+		// The cached token might have expired or been revoked, so the request is repeated with a new one.
+		if code, err = c.authorize(headers, true); err == nil {
+			participant, code, err = c.newParticipant(headers, newParticipant)
+		}
+	}
 	if err != nil {
 		if code != nil {
 			res.ErrorCode = strconv.Itoa(*code)
 		}
-		err = fmt.Errorf("during registering customer: %s", err)
+		err = common.NewProviderError(common.Coinfirm, code, errors.Wrap(err, "during registering customer"))
 		return
 	}
 
@@ -65,28 +81,32 @@ func (c Coinfirm) CheckCustomer(customer *common.UserData) (res common.KYCResult
 		if code != nil {
 			res.ErrorCode = strconv.Itoa(*code)
 		}
-		err = fmt.Errorf("during sending customer details: %s", err)
+		err = common.NewProviderError(common.Coinfirm, code, errors.Wrap(err, "during sending customer details"))
 		return
 	}
 
-	// We wouldn't use parallel upload due to possible throttling as we upload from the same IP.
-	for _, docfile := range docfiles {
-		code, err = c.sendDocFile(headers, participant.UUID, &docfile)
-		if err != nil {
-			if code != nil {
-				res.ErrorCode = strconv.Itoa(*code)
-			}
-			err = fmt.Errorf("during sending customer document: %s", err)
-			return
+	// The files are uploaded one by one unless the parallel upload is configured, as Coinfirm throttles the uploads from the same IP.
+	uploads := make([]upload.Task, len(docfiles))
+	for i := range docfiles {
+		docfile := &docfiles[i]
+		uploads[i] = func() (*int, error) {
+			return c.sendDocFile(headers, participant.UUID, docfile)
 		}
 	}
+	if _, code, err = c.uploader().Run(uploads); err != nil {
+		if code != nil {
+			res.ErrorCode = strconv.Itoa(*code)
+		}
+		err = common.NewProviderError(common.Coinfirm, code, errors.Wrap(err, "during sending customer document"))
+		return
+	}
 
 	status, code, err := c.getParticipantCurrentStatus(headers, participant.UUID)
 	if err != nil {
 		if code != nil {
 			res.ErrorCode = strconv.Itoa(*code)
 		}
-		err = fmt.Errorf("during checking customer status: %s", err)
+		err = common.NewProviderError(common.Coinfirm, code, errors.Wrap(err, "during checking customer status"))
 		return
 	}
 
@@ -99,23 +119,27 @@ func (c Coinfirm) CheckCustomer(customer *common.UserData) (res common.KYCResult
 func (c Coinfirm) CheckStatus(pID string) (res common.KYCResult, err error) {
 	headers := headers()
 
-	token, code, err := c.newAuthToken(headers)
+	code, err := c.authorize(headers, false)
 	if err != nil {
 		if code != nil {
 			res.ErrorCode = strconv.Itoa(*code)
 		}
-		err = fmt.Errorf("during sending auth request: %s", err)
+		err = common.NewProviderError(common.Coinfirm, code, errors.Wrap(err, "during sending auth request"))
 		return
 	}
 
-	headers["Authorization"] = "Bearer " + token.Token
-
 	status, code, err := c.getParticipantCurrentStatus(headers, pID)
+	if unauthorized(code) {
+		// The cached token might have expired or been revoked, so the request is repeated with a new one.
+		if code, err = c.authorize(headers, true); err == nil {
+			status, code, err = c.getParticipantCurrentStatus(headers, pID)
+		}
+	}
 	if err != nil {
 		if code != nil {
 			res.ErrorCode = strconv.Itoa(*code)
 		}
-		err = fmt.Errorf("during checking customer status: %s", err)
+		err = common.NewProviderError(common.Coinfirm, code, errors.Wrap(err, "during checking customer status"))
 		return
 	}
 
@@ -124,6 +148,73 @@ func (c Coinfirm) CheckStatus(pID string) (res common.KYCResult, err error) {
 	return
 }
 
+// ScreenAddress requests the AML report of the crypto address to assess its risk.
+func (c Coinfirm) ScreenAddress(address string) (report model.AddressReport, err error) {
+	headers := headers()
+
+	code, err := c.authorize(headers, false)
+	if err != nil {
+		err = common.NewProviderError(common.Coinfirm, code, errors.Wrap(err, "during sending auth request"))
+		return
+	}
+
+	report, code, err = c.getAddressReport(headers, address)
+	if unauthorized(code) {
+		// The cached token might have expired or been revoked, so the request is repeated with a new one.
+		if code, err = c.authorize(headers, true); err == nil {
+			report, code, err = c.getAddressReport(headers, address)
+		}
+	}
+	if err != nil {
+		err = common.NewProviderError(common.Coinfirm, code, errors.Wrap(err, "during requesting address report"))
+	}
+
+	return
+}
+
+// authorize sets the auth token to the request headers. The token is cached for the user until it expires,
+// the renew flag drops the cached one and requests a new token. The cached loader might run in the background,
+// so it requests the token with its own headers instead of the request ones.
+func (c Coinfirm) authorize(requestHeaders http.Headers, renew bool) (code *int, err error) {
+	key := ttlcache.Key(c.config.Host, c.config.Email, c.config.Password)
+	if renew {
+		tokens.Invalidate(key)
+	}
+
+	token, code, err := tokens.Get(key, func() (interface{}, time.Duration, *int, error) {
+		token, code, err := c.newAuthToken(headers())
+		return token.Token, tokenTTL, code, err
+	})
+	if err != nil {
+		return
+	}
+
+	requestHeaders["Authorization"] = "Bearer " + token.(string)
+
+	return
+}
+
+// unauthorized tells whether the API has rejected the auth token.
+func unauthorized(code *int) bool {
+	return code != nil && *code == stdhttp.StatusUnauthorized
+}
+
+// uploader returns the executor of the document uploads of the Coinfirm user.
+func (c Coinfirm) uploader() upload.Executor {
+	return upload.New(c.config.Host+" "+c.config.Email, upload.Options{
+		Parallelism: c.config.UploadParallelism,
+		Rate:        c.config.UploadRate,
+	})
+}
+
+// ProbeCredentials implements CredentialsProber interface for the Coinfirm.
+// It requests a new auth token with the configured user credentials bypassing the cache.
+func (c Coinfirm) ProbeCredentials() (code *int, err error) {
+	_, code, err = c.newAuthToken(headers())
+
+	return
+}
+
 // headers is the helper returning mandatory headers but they're requiring to complement with authorization.
 func headers() http.Headers {
 	return http.Headers{
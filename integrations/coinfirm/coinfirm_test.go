@@ -1,10 +1,16 @@
 package coinfirm
 
 import (
+	"encoding/json"
+	"net"
 	"net/http"
+	"sync"
 	"testing"
+	"time"
 
 	"modulus/kyc/common"
+	"modulus/kyc/integrations/coinfirm/model"
+	"modulus/kyc/ttlcache"
 
 	"gopkg.in/jarcoal/httpmock.v1"
 	"github.com/stretchr/testify/assert"
@@ -34,6 +40,9 @@ func TestCheckCustomerNil(t *testing.T) {
 
 	assert.Error(err)
 	assert.Equal("customer is absent or no data received", err.Error())
+	kycErr, ok := common.AsKYCError(err)
+	assert.True(ok)
+	assert.Equal(common.ValidationError, kycErr.Category)
 	assert.Equal(common.Error, res.Status)
 	assert.Nil(res.Details)
 	assert.Empty(res.ErrorCode)
@@ -77,3 +86,238 @@ func TestCheckStatusSuccess(t *testing.T) {
 	assert.Empty(res.ErrorCode)
 	assert.Nil(res.StatusCheck)
 }
+
+func TestAuthorizeRefreshAhead(t *testing.T) {
+	assert := assert.New(t)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var authHeaders []http.Header
+	httpmock.RegisterResponder(http.MethodPost, c.config.Host+"/auth/login", func(req *http.Request) (*http.Response, error) {
+		authHeaders = append(authHeaders, req.Header)
+		return httpmock.NewStringResponse(http.StatusOK, tokenResp), nil
+	})
+
+	// The cached token is about to expire, so the next use refreshes it in the background.
+	key := ttlcache.Key(c.config.Host, c.config.Email, c.config.Password)
+	tokens.Invalidate(key)
+	_, _, err := tokens.Get(key, func() (interface{}, time.Duration, *int, error) {
+		return "old", time.Second, nil, nil
+	})
+	assert.NoError(err)
+	time.Sleep(850 * time.Millisecond)
+
+	// The request headers are written while the refresh runs.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := c.authorize(headers(), false)
+			assert.NoError(err)
+		}()
+	}
+	wg.Wait()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		requestHeaders := headers()
+		_, err := c.authorize(requestHeaders, false)
+		assert.NoError(err)
+		if requestHeaders["Authorization"] != "Bearer old" || time.Now().After(deadline) {
+			assert.Equal("Bearer yFaReURiYkAECZsPt8dR1bzHpa2Y5kXpqsp4KunyH870OAoY577vI8mhABCj4vkK", requestHeaders["Authorization"])
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if assert.Len(authHeaders, 1) {
+		assert.Empty(authHeaders[0].Get("Authorization"))
+	}
+}
+
+func TestScreenAddress(t *testing.T) {
+	assert := assert.New(t)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	address := "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"
+
+	httpmock.RegisterResponder(http.MethodPost, c.config.Host+"/auth/login", httpmock.NewStringResponder(http.StatusOK, tokenResp))
+	httpmock.RegisterResponder(http.MethodGet, c.config.Host+"/reports/aml/standard/"+address, httpmock.NewStringResponder(http.StatusOK, `{"report_id":"8a3b","address":"`+address+`","cscore":34,"cscore_section":{"cscore":34,"cscore_info":[{"name":"Exchange","impact":10,"id":1}]}}`))
+
+	report, err := c.ScreenAddress(address)
+
+	assert.NoError(err)
+	assert.Equal("8a3b", report.ReportID)
+	assert.Equal(address, report.Address)
+	assert.Equal(34, report.CScore)
+	if assert.NotNil(report.CScoreSection) && assert.Len(report.CScoreSection.CScoreInfo, 1) {
+		assert.Equal("Exchange", report.CScoreSection.CScoreInfo[0].Name)
+	}
+
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPost, c.config.Host+"/auth/login", httpmock.NewStringResponder(http.StatusOK, tokenResp))
+	httpmock.RegisterResponder(http.MethodGet, c.config.Host+"/reports/aml/standard/"+address, httpmock.NewStringResponder(http.StatusBadRequest, error400Resp))
+
+	_, err = c.ScreenAddress(address)
+
+	kycErr, ok := common.AsKYCError(err)
+	if assert.True(ok) {
+		assert.Equal(common.Coinfirm, kycErr.Provider)
+		assert.Equal(http.StatusBadRequest, kycErr.StatusCode)
+	}
+}
+
+func TestCheckCustomerCryptoAddresses(t *testing.T) {
+	assert := assert.New(t)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var participant model.NewParticipant
+
+	httpmock.RegisterResponder(http.MethodPost, c.config.Host+"/auth/login", httpmock.NewStringResponder(http.StatusOK, tokenResp))
+	httpmock.RegisterResponder(http.MethodPut, c.config.Host+"/kyc/customers/Fuzion", func(req *http.Request) (*http.Response, error) {
+		if err := json.NewDecoder(req.Body).Decode(&participant); err != nil {
+			return nil, err
+		}
+		return httpmock.NewStringResponse(http.StatusOK, newParticipantResp), nil
+	})
+	httpmock.RegisterResponder(http.MethodPut, c.config.Host+"/kyc/forms/Fuzion/33611d6d-2826-4c3e-a777-3f0397e283fc", httpmock.NewBytesResponder(http.StatusCreated, nil))
+	httpmock.RegisterResponder(http.MethodGet, c.config.Host+"/kyc/status/Fuzion/33611d6d-2826-4c3e-a777-3f0397e283fc", httpmock.NewStringResponder(http.StatusOK, statusLowResp))
+
+	res, err := c.CheckCustomer(&common.UserData{
+		Email: "john.doe@mail.com",
+		CryptoAddresses: []common.CryptoAddress{
+			{
+				Chain:   common.Bitcoin,
+				Address: "bc1q9vza2e8x573nczrlzms0wvx3gsqjx7vavgkx0l",
+			},
+		},
+	})
+
+	assert.NoError(err)
+	assert.Equal(common.Approved, res.Status)
+	assert.Equal(common.LowRisk, res.RiskLevel)
+	assert.Equal("john.doe@mail.com", participant.Email)
+	assert.Equal([]model.CryptoAddress{model.NewCryptoAddress("bc1q9vza2e8x573nczrlzms0wvx3gsqjx7vavgkx0l")}, participant.CryptoAddresses)
+}
+
+func TestProbeCredentials(t *testing.T) {
+	assert := assert.New(t)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(http.MethodPost, c.config.Host+"/auth/login", httpmock.NewStringResponder(http.StatusOK, tokenResp))
+
+	code, err := c.ProbeCredentials()
+
+	assert.NoError(err)
+	assert.Nil(code)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPost, c.config.Host+"/auth/login", httpmock.NewStringResponder(http.StatusUnauthorized, error400Resp))
+
+	code, err = c.ProbeCredentials()
+
+	assert.Error(err)
+	assert.Equal("Invalid email or password", err.Error())
+	assert.NotNil(code)
+	assert.Equal(http.StatusUnauthorized, *code)
+}
+
+func TestCheckCustomerErrorCategories(t *testing.T) {
+	assert := assert.New(t)
+
+	tokens = ttlcache.New()
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(http.MethodPost, c.config.Host+"/auth/login", httpmock.NewStringResponder(http.StatusUnauthorized, error400Resp))
+
+	res, err := c.CheckCustomer(&common.UserData{})
+
+	assert.Error(err)
+	assert.Equal("during sending auth request: Invalid email or password", err.Error())
+	assert.Equal("401", res.ErrorCode)
+
+	kycErr, ok := common.AsKYCError(err)
+	assert.True(ok)
+	assert.Equal(common.AuthenticationError, kycErr.Category)
+	assert.Equal(common.Coinfirm, kycErr.Provider)
+	assert.Equal(http.StatusUnauthorized, kycErr.StatusCode)
+	assert.False(kycErr.Retryable())
+
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPost, c.config.Host+"/auth/login", httpmock.NewStringResponder(http.StatusServiceUnavailable, error400Resp))
+
+	_, err = c.CheckCustomer(&common.UserData{})
+
+	kycErr, ok = common.AsKYCError(err)
+	assert.True(ok)
+	assert.Equal(common.ProviderUnavailableError, kycErr.Category)
+	assert.True(kycErr.Retryable())
+
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodPost, c.config.Host+"/auth/login", func(*http.Request) (*http.Response, error) {
+		return nil, &net.DNSError{Err: "i/o timeout", IsTimeout: true}
+	})
+
+	res, err = c.CheckCustomer(&common.UserData{})
+
+	assert.Error(err)
+	assert.Empty(res.ErrorCode)
+
+	kycErr, ok = common.AsKYCError(err)
+	assert.True(ok)
+	assert.Equal(common.TimeoutError, kycErr.Category)
+	assert.Zero(kycErr.StatusCode)
+	assert.True(kycErr.Retryable())
+}
+
+func TestAuthTokenCache(t *testing.T) {
+	assert := assert.New(t)
+
+	tokens = ttlcache.New()
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	authorization := ""
+	rejected := false
+	httpmock.RegisterResponder(http.MethodPost, c.config.Host+"/auth/login", httpmock.NewStringResponder(http.StatusOK, tokenResp))
+	httpmock.RegisterResponder(http.MethodGet, c.config.Host+"/kyc/status/Fuzion/33611d6d-2826-4c3e-a777-3f0397e283fc", func(req *http.Request) (*http.Response, error) {
+		authorization = req.Header.Get("Authorization")
+		if rejected {
+			rejected = false
+			return httpmock.NewStringResponse(http.StatusUnauthorized, error400Resp), nil
+		}
+		return httpmock.NewStringResponse(http.StatusOK, statusLowResp), nil
+	})
+
+	for i := 0; i < 3; i++ {
+		_, err := c.CheckStatus("33611d6d-2826-4c3e-a777-3f0397e283fc")
+
+		assert.NoError(err)
+	}
+
+	assert.NotEmpty(authorization)
+	assert.Equal(1, httpmock.GetCallCountInfo()["POST "+c.config.Host+"/auth/login"])
+	assert.Equal(3, httpmock.GetCallCountInfo()["GET "+c.config.Host+"/kyc/status/Fuzion/33611d6d-2826-4c3e-a777-3f0397e283fc"])
+
+	// The rejected token is dropped and the request is repeated with a new one.
+	rejected = true
+
+	res, err := c.CheckStatus("33611d6d-2826-4c3e-a777-3f0397e283fc")
+
+	assert.NoError(err)
+	assert.Equal(common.Approved, res.Status)
+	assert.Equal(2, httpmock.GetCallCountInfo()["POST "+c.config.Host+"/auth/login"])
+	assert.Equal(5, httpmock.GetCallCountInfo()["GET "+c.config.Host+"/kyc/status/Fuzion/33611d6d-2826-4c3e-a777-3f0397e283fc"])
+}
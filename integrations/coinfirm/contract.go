@@ -6,4 +6,9 @@ type Config struct {
 	Email    string
 	Password string
 	Company  string
+	// UploadParallelism limits the number of the documents uploaded at once, they're uploaded one by one by default
+	// as Coinfirm throttles the uploads from the same IP.
+	UploadParallelism int
+	// UploadRate limits the number of the document uploads started per second, it's unlimited by default.
+	UploadRate float64
 }
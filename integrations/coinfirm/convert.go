@@ -54,8 +54,12 @@ func prepareIndividualData(customer *common.UserData) (details model.Participant
 	details.Street = customer.CurrentAddress.Street
 	details.BirthDate = customer.DateOfBirth.Format(model.DateFormat)
 
+	details.Pep = customer.PoliticallyExposed
+
 	details.IDNumber, docfiles = prepareIndividualDocuments(customer)
 
+	prepareFinancialProfile(customer, &details)
+
 	return
 }
 
@@ -73,6 +77,84 @@ func prepareCompanyData(customer *common.UserData) (details model.ParticipantDet
 
 	details.IDNumber, docfiles = prepareCompanyDocuments(customer)
 
+	prepareFinancialProfile(customer, &details)
+
+	return
+}
+
+// prepareCryptoAddresses prepares crypto addresses of the customer for the participant registration.
+func prepareCryptoAddresses(customer *common.UserData) (addresses []model.CryptoAddress) {
+	for _, addr := range customer.CryptoAddresses {
+		if len(addr.Address) > 0 {
+			addresses = append(addresses, model.NewCryptoAddress(addr.Address))
+		}
+	}
+
+	return
+}
+
+// prepareFinancialProfile fills the source of wealth and funds and beneficial owners of the customer.
+func prepareFinancialProfile(customer *common.UserData, details *model.ParticipantDetails) {
+	details.Sow = toSow(customer.SourceOfWealth)
+
+	switch {
+	case customer.SourceOfFunds != nil && len(customer.SourceOfFunds.Description) > 0:
+		details.FileFundsText = customer.SourceOfFunds.Description
+	case customer.SourceOfWealth != nil:
+		details.FileFundsText = customer.SourceOfWealth.Description
+	}
+
+	for _, owner := range customer.BeneficialOwners {
+		beneficial := model.Beneficial{
+			Name:         owner.FullName,
+			Pep:          owner.PoliticallyExposed,
+			NationAlpha3: common.CountryAlpha2ToAlpha3[owner.Nationality],
+			Address:      owner.Address.String(),
+			Proc:         owner.OwnershipPercent,
+		}
+		if !time.Time(owner.DateOfBirth).IsZero() {
+			beneficial.BirthDate = owner.DateOfBirth.Format(model.DateFormat)
+		}
+		if owner.SourceOfWealth != nil {
+			beneficial.WealthText = owner.SourceOfWealth.Description
+			for _, source := range owner.SourceOfWealth.Sources {
+				if source == common.RealEstateSale {
+					beneficial.SowRealEstateSale = true
+				}
+			}
+		}
+		details.Beneficials = append(details.Beneficials, beneficial)
+	}
+}
+
+// toSow converts the source of wealth declaration into the API representation.
+func toSow(declaration *common.FundsDeclaration) (sow *model.Sow) {
+	if declaration == nil || len(declaration.Sources) == 0 {
+		return
+	}
+
+	sow = &model.Sow{}
+	for _, source := range declaration.Sources {
+		switch source {
+		case common.BusinessActivities:
+			sow.BusinessActivities = true
+		case common.StockSales:
+			sow.StockSales = true
+		case common.RealEstateSale:
+			sow.RealEstateSale = true
+		case common.Donation:
+			sow.Donation = true
+		case common.Inheritance:
+			sow.Inherited = true
+		case common.CryptoTrading:
+			sow.CryptoTrading = true
+		case common.ICOContribution:
+			sow.ICOContribution = true
+		default:
+			sow.Other = true
+		}
+	}
+
 	return
 }
 
@@ -241,13 +323,19 @@ func toResult(pID string, status model.StatusResponse) (res common.KYCResult, er
 			LastCheck:   time.Now(),
 		}
 	case model.Incomplete:
-		err = errors.New("data provided by participant is incomplete or does not meet the requirements set in KYC form")
-	case model.Low, model.Medium:
+		err = common.NewValidationError(common.Coinfirm, errors.New("data provided by participant is incomplete or does not meet the requirements set in KYC form"))
+	case model.Low:
+		res.Status = common.Approved
+		res.RiskLevel = common.LowRisk
+	case model.Medium:
 		res.Status = common.Approved
+		res.RiskLevel = common.MediumRisk
 	case model.High, model.Fail:
 		s := string(status.CurrentStatus)
+		res.RiskLevel = common.HighRisk
 		if status.CurrentStatus == model.Fail {
 			s = "unacceptable"
+			res.RiskLevel = common.UnacceptableRisk
 		}
 		res.Status = common.Denied
 		res.Details = &common.KYCDetails{
@@ -131,6 +131,107 @@ func TestPrepareCustomerDataForCompany(t *testing.T) {
 	assert.Len(docfiles, 3)
 }
 
+func TestPrepareFinancialProfile(t *testing.T) {
+	assert := assert.New(t)
+
+	customer := &common.UserData{
+		FirstName:          "John",
+		LastName:           "Doe",
+		PoliticallyExposed: true,
+		CryptoAddresses: []common.CryptoAddress{
+			{
+				Chain:   common.Bitcoin,
+				Address: "bc1q9vza2e8x573nczrlzms0wvx3gsqjx7vavgkx0l",
+			},
+			{
+				Chain: common.Ethereum,
+			},
+			{
+				Chain:   common.Ethereum,
+				Address: "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+			},
+		},
+		SourceOfWealth: &common.FundsDeclaration{
+			Sources:     []common.FundsSource{common.Inheritance, common.CryptoTrading, "Lottery"},
+			Description: "Inherited a family business",
+		},
+		SourceOfFunds: &common.FundsDeclaration{
+			Description: "Savings from the salary",
+		},
+		BeneficialOwners: []common.BeneficialOwner{
+			{
+				FullName:           "Jane Doe",
+				DateOfBirth:        common.Time(time.Date(1950, 1, 2, 0, 0, 0, 0, time.UTC)),
+				Nationality:        "GB",
+				PoliticallyExposed: true,
+				OwnershipPercent:   60,
+				Address: common.Address{
+					Town:           "London",
+					Street:         "Baker St",
+					BuildingNumber: "221B",
+				},
+				SourceOfWealth: &common.FundsDeclaration{
+					Sources:     []common.FundsSource{common.RealEstateSale},
+					Description: "Sold a house",
+				},
+			},
+			{
+				FullName: "Richard Roe",
+			},
+		},
+	}
+
+	assert.Equal([]model.CryptoAddress{
+		model.NewCryptoAddress("bc1q9vza2e8x573nczrlzms0wvx3gsqjx7vavgkx0l"),
+		model.NewCryptoAddress("0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"),
+	}, prepareCryptoAddresses(customer))
+
+	details, _ := prepareCustomerData(customer)
+
+	assert.True(details.Pep)
+	assert.Equal(&model.Sow{
+		Inherited:     true,
+		CryptoTrading: true,
+		Other:         true,
+	}, details.Sow)
+	assert.Equal("Savings from the salary", details.FileFundsText)
+	assert.Equal([]model.Beneficial{
+		{
+			Name:              "Jane Doe",
+			SowRealEstateSale: true,
+			Pep:               true,
+			NationAlpha3:      "GBR",
+			Address:           customer.BeneficialOwners[0].Address.String(),
+			BirthDate:         "1950-01-02",
+			Proc:              60,
+			WealthText:        "Sold a house",
+		},
+		{
+			Name: "Richard Roe",
+		},
+	}, details.Beneficials)
+
+	customer.CompanyName = "Foobar"
+	customer.SourceOfFunds = nil
+
+	details, _ = prepareCustomerData(customer)
+
+	assert.False(details.Pep)
+	assert.NotNil(details.Sow)
+	assert.Equal("Inherited a family business", details.FileFundsText)
+	assert.Len(details.Beneficials, 2)
+
+	customer.SourceOfWealth = nil
+	customer.BeneficialOwners = nil
+
+	details, _ = prepareCustomerData(customer)
+
+	assert.Nil(details.Sow)
+	assert.Empty(details.FileFundsText)
+	assert.Nil(details.Beneficials)
+	assert.Nil(prepareCryptoAddresses(&common.UserData{}))
+}
+
 func TestPrepareIndividualDocuments(t *testing.T) {
 	assert := assert.New(t)
 
@@ -430,6 +531,7 @@ func TestToResult(t *testing.T) {
 
 	assert.NoError(err)
 	assert.Equal(common.Approved, res.Status)
+	assert.Equal(common.LowRisk, res.RiskLevel)
 	assert.Nil(res.Details)
 	assert.Empty(res.ErrorCode)
 	assert.Nil(res.StatusCheck)
@@ -439,6 +541,7 @@ func TestToResult(t *testing.T) {
 
 	assert.NoError(err)
 	assert.Equal(common.Approved, res.Status)
+	assert.Equal(common.MediumRisk, res.RiskLevel)
 	assert.Nil(res.Details)
 	assert.Empty(res.ErrorCode)
 	assert.Nil(res.StatusCheck)
@@ -448,6 +551,7 @@ func TestToResult(t *testing.T) {
 
 	assert.NoError(err)
 	assert.Equal(common.Denied, res.Status)
+	assert.Equal(common.HighRisk, res.RiskLevel)
 	assert.NotNil(res.Details)
 	assert.Equal(common.Unknown, res.Details.Finality)
 	assert.Len(res.Details.Reasons, 1)
@@ -460,6 +564,7 @@ func TestToResult(t *testing.T) {
 
 	assert.NoError(err)
 	assert.Equal(common.Denied, res.Status)
+	assert.Equal(common.UnacceptableRisk, res.RiskLevel)
 	assert.NotNil(res.Details)
 	assert.Equal(common.Unknown, res.Details.Finality)
 	assert.Len(res.Details.Reasons, 1)
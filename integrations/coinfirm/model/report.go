@@ -0,0 +1,22 @@
+package model
+
+// AddressReport represents the AML report of the crypto address.
+type AddressReport struct {
+	ReportID      string         `json:"report_id"`
+	Address       string         `json:"address"`
+	CScore        int            `json:"cscore"`
+	CScoreSection *CScoreSection `json:"cscore_section,omitempty"`
+}
+
+// CScoreSection represents the details of the risk score of the address.
+type CScoreSection struct {
+	CScore     int          `json:"cscore"`
+	CScoreInfo []CScoreInfo `json:"cscore_info"`
+}
+
+// CScoreInfo represents the risk indicator contributing to the risk score of the address.
+type CScoreInfo struct {
+	Name   string `json:"name"`
+	Impact int    `json:"impact"`
+	ID     int    `json:"id"`
+}
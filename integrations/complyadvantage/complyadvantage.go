@@ -32,6 +32,7 @@ func (c ComplyAdvantage) CheckCustomer(customer *common.UserData) (result common
 		if status != nil {
 			result.ErrorCode = fmt.Sprintf("%d", *status)
 		}
+		err = common.NewProviderError(common.ComplyAdvantage, status, err)
 		return
 	}
 
@@ -44,6 +45,7 @@ func (c ComplyAdvantage) CheckCustomer(customer *common.UserData) (result common
 func (c ComplyAdvantage) performSearch(r Request) (response Response, status *int, err error) {
 	body, err := json.Marshal(r)
 	if err != nil {
+		err = common.NewValidationError(common.ComplyAdvantage, fmt.Errorf("during encoding request: %s", err))
 		return
 	}
 
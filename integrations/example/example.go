@@ -2,6 +2,7 @@ package example
 
 import (
 	"errors"
+	"net/http"
 	"time"
 
 	"modulus/kyc/common"
@@ -15,11 +16,11 @@ type Example struct{}
 // CheckCustomer implements KYCPlatform interface for the example KYC provider.
 func (ex Example) CheckCustomer(customer *common.UserData) (res common.KYCResult, err error) {
 	if customer == nil {
-		err = errors.New("no customer supplied")
+		err = common.NewValidationError(common.Example, errors.New("no customer supplied"))
 		return
 	}
 	if len(customer.FirstName) == 0 {
-		err = errors.New("missing the required field: FirstName")
+		err = common.NewValidationError(common.Example, errors.New("missing the required field: FirstName"))
 		return
 	}
 
@@ -35,7 +36,7 @@ func (ex Example) CheckCustomer(customer *common.UserData) (res common.KYCResult
 		res = unclearResult("lily_was_here")
 	case "Erika":
 		res.ErrorCode = "429"
-		err = errors.New("during sending request: http error")
+		err = providerError(http.StatusTooManyRequests)
 	default:
 		res = errorResult()
 	}
@@ -54,7 +55,7 @@ func (ex Example) CheckStatus(referenceID string) (res common.KYCResult, err err
 		res = unclearResult("uma")
 	case "elin":
 		res.ErrorCode = "401"
-		err = errors.New("during sending request: http error")
+		err = providerError(http.StatusUnauthorized)
 	default:
 		res = errorResult()
 	}
@@ -62,6 +63,11 @@ func (ex Example) CheckStatus(referenceID string) (res common.KYCResult, err err
 	return
 }
 
+// providerError simulates the error of the provider response with the HTTP status code specified.
+func providerError(code int) error {
+	return common.NewProviderError(common.Example, &code, errors.New("during sending request: http error"))
+}
+
 func errorResult() (res common.KYCResult) {
 	res.Details = &common.KYCDetails{
 		Reasons: []string{
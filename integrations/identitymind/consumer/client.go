@@ -3,12 +3,13 @@ package consumer
 import (
 	"encoding/base64"
 	"encoding/json"
-	"errors"
 	"fmt"
 	stdhttp "net/http"
 
 	"modulus/kyc/common"
 	"modulus/kyc/http"
+
+	"github.com/pkg/errors"
 )
 
 const (
@@ -37,19 +38,19 @@ func NewClient(config Config) Client {
 // CheckCustomer implements customer verification using IdentityMind API.
 func (c Client) CheckCustomer(customer *common.UserData) (result common.KYCResult, err error) {
 	if customer == nil {
-		err = errors.New("no customer supplied")
+		err = common.NewValidationError(common.IdentityMind, errors.New("no customer supplied"))
 		return
 	}
 
 	req := &KYCRequestData{}
 	if err = req.populateFields(customer); err != nil {
-		err = fmt.Errorf("invalid customer data: %s", err)
+		err = common.NewValidationError(common.IdentityMind, fmt.Errorf("invalid customer data: %s", err))
 		return
 	}
 
 	body, err := req.createRequestBody()
 	if err != nil {
-		err = fmt.Errorf("during creating request body: %s", err)
+		err = common.NewValidationError(common.IdentityMind, fmt.Errorf("during creating request body: %s", err))
 		return
 	}
 
@@ -58,7 +59,7 @@ func (c Client) CheckCustomer(customer *common.UserData) (result common.KYCResul
 		if errorCode != nil {
 			result.ErrorCode = fmt.Sprintf("%d", *errorCode)
 		}
-		err = fmt.Errorf("during sending request: %s", err)
+		err = common.NewProviderError(common.IdentityMind, errorCode, errors.Wrap(err, "during sending request"))
 		return
 	}
 
@@ -88,7 +89,7 @@ func (c Client) sendRequest(body []byte) (response *ApplicationResponseData, err
 	response = &ApplicationResponseData{}
 	err = json.Unmarshal(resp, response)
 	if len(response.ErrorMessage) > 0 {
-		err = errors.New(response.ErrorMessage)
+		err = common.NewError(common.IdentityMind, common.ProviderRejectedError, errors.New(response.ErrorMessage))
 	}
 
 	return
@@ -103,21 +104,22 @@ func (c Client) CheckStatus(referenceID string) (result common.KYCResult, err er
 
 	status, resp, err := http.Get(c.host+stateRetrievalEndpoint+referenceID, headers)
 	if err != nil {
-		err = fmt.Errorf("during sending request: %s", err)
+		err = common.NewProviderError(common.IdentityMind, nil, errors.Wrap(err, "during sending request"))
 		return
 	}
 	if status != stdhttp.StatusOK {
 		result.ErrorCode = fmt.Sprintf("%d", status)
-		err = errors.New("http error")
+		err = common.NewProviderError(common.IdentityMind, &status, errors.New("http error"))
 		return
 	}
 
 	response := &ApplicationResponseData{}
 	if err = json.Unmarshal(resp, response); err != nil {
+		err = common.NewProviderError(common.IdentityMind, nil, errors.Wrap(err, "during decoding response"))
 		return
 	}
 	if len(response.ErrorMessage) > 0 {
-		err = errors.New(response.ErrorMessage)
+		err = common.NewError(common.IdentityMind, common.ProviderRejectedError, errors.New(response.ErrorMessage))
 		return
 	}
 
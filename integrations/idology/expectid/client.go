@@ -24,19 +24,23 @@ func NewClient(config Config) Client {
 // CheckCustomer implements customer verification using IDology API.
 func (c Client) CheckCustomer(customer *common.UserData) (result common.KYCResult, err error) {
 	if customer == nil {
-		err = errors.New("no customer supplied")
+		err = common.NewValidationError(common.IDology, errors.New("no customer supplied"))
 		return
 	}
 
 	requestBody := c.makeRequestBody(customer)
 
-	response, err := c.sendRequest(requestBody)
+	response, code, err := c.sendRequest(requestBody)
 	if err != nil {
+		if code != nil {
+			result.ErrorCode = fmt.Sprintf("%d", *code)
+		}
+		err = common.NewProviderError(common.IDology, code, err)
 		return
 	}
 
 	if response.Error != nil {
-		err = fmt.Errorf("during verification: %s", *response.Error)
+		err = common.NewError(common.IDology, common.ProviderRejectedError, fmt.Errorf("during verification: %s", *response.Error))
 		return
 	}
 
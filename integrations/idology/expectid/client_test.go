@@ -142,5 +142,40 @@ var _ = Describe("Client", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(Equal("during verification: Invalid username and password"))
 		})
+
+		It("should keep the status code of the failed response", func() {
+			httpmock.RegisterResponder(
+				http.MethodPost,
+				client.config.Host,
+				httpmock.NewBytesResponder(http.StatusServiceUnavailable, []byte("Service Unavailable")),
+			)
+
+			result, err := client.CheckCustomer(newCustomer())
+
+			Expect(err).To(HaveOccurred())
+			Expect(result.ErrorCode).To(Equal("503"))
+
+			kycErr, ok := common.AsKYCError(err)
+			Expect(ok).To(BeTrue())
+			Expect(kycErr.Category).To(Equal(common.ProviderUnavailableError))
+			Expect(kycErr.StatusCode).To(Equal(http.StatusServiceUnavailable))
+		})
+
+		It("should classify the malformed response as the rejection", func() {
+			httpmock.RegisterResponder(
+				http.MethodPost,
+				client.config.Host,
+				httpmock.NewBytesResponder(http.StatusOK, []byte("<response>")),
+			)
+
+			_, err := client.CheckCustomer(newCustomer())
+
+			Expect(err).To(HaveOccurred())
+
+			kycErr, ok := common.AsKYCError(err)
+			Expect(ok).To(BeTrue())
+			Expect(kycErr.Category).To(Equal(common.ProviderRejectedError))
+			Expect(kycErr.Retryable()).To(BeFalse())
+		})
 	})
 })
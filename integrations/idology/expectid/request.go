@@ -2,7 +2,9 @@ package expectid
 
 import (
 	"encoding/xml"
+	"errors"
 	"fmt"
+	stdhttp "net/http"
 	"net/url"
 	"time"
 
@@ -13,12 +15,13 @@ import (
 // sendRequest sends a vefirication request into the API.
 // It expects an url-encoded request body as the param.
 // It returns a response from the API or the error if occured.
-func (c Client) sendRequest(requestBody string) (resp *Response, err error) {
+// The status code is returned for the failed API responses.
+func (c Client) sendRequest(requestBody string) (resp *Response, code *int, err error) {
 	headers := http.Headers{
 		"Content-Type": "application/x-www-form-urlencoded",
 	}
 
-	_, response, err := http.Post(c.config.Host, headers, []byte(requestBody))
+	status, response, err := http.Post(c.config.Host, headers, []byte(requestBody))
 	if err != nil {
 		return
 	}
@@ -26,6 +29,16 @@ func (c Client) sendRequest(requestBody string) (resp *Response, err error) {
 	resp = &Response{}
 	err = xml.Unmarshal(response, resp)
 
+	if status != stdhttp.StatusOK {
+		code = &status
+		if err == nil && resp.Error != nil {
+			err = fmt.Errorf("during verification: %s", *resp.Error)
+		} else {
+			err = errors.New("http error")
+		}
+		resp = nil
+	}
+
 	return
 }
 
@@ -3,16 +3,18 @@ package jumio
 import (
 	"encoding/base64"
 	"encoding/json"
-	"errors"
 	"fmt"
 	stdhttp "net/http"
 	"time"
 
 	"modulus/kyc/common"
 	"modulus/kyc/http"
+
+	"github.com/pkg/errors"
 )
 
 var _ common.KYCPlatform = Jumio{}
+var _ common.CredentialsProber = Jumio{}
 
 // Jumio defines the model for the Jumio performNetverify API.
 type Jumio struct {
@@ -31,13 +33,13 @@ func New(config Config) Jumio {
 // CheckCustomer implements customer verification using the Jumio performNetverify API.
 func (j Jumio) CheckCustomer(customer *common.UserData) (result common.KYCResult, err error) {
 	if customer == nil {
-		err = errors.New("no customer supplied")
+		err = common.NewValidationError(common.Jumio, errors.New("no customer supplied"))
 		return
 	}
 
 	req := &Request{}
 	if err = req.populateFields(customer); err != nil {
-		err = fmt.Errorf("invalid customer data: %s", err)
+		err = common.NewValidationError(common.Jumio, fmt.Errorf("invalid customer data: %s", err))
 		return
 	}
 
@@ -46,7 +48,7 @@ func (j Jumio) CheckCustomer(customer *common.UserData) (result common.KYCResult
 		if errorCode != nil {
 			result.ErrorCode = fmt.Sprintf("%d", *errorCode)
 		}
-		err = fmt.Errorf("during sending request: %s", err)
+		err = common.NewProviderError(common.Jumio, errorCode, errors.Wrap(err, "during sending request"))
 		return
 	}
 
@@ -96,7 +98,7 @@ func (j Jumio) sendRequest(request *Request) (response *Response, errorCode *int
 // CheckStatus implements the KYCPlatform interface for Jumio.
 func (j Jumio) CheckStatus(referenceID string) (result common.KYCResult, err error) {
 	if len(referenceID) == 0 {
-		err = errors.New("empty Jumio’s reference number of an existing scan")
+		err = common.NewValidationError(common.Jumio, errors.New("empty Jumio’s reference number of an existing scan"))
 		return
 	}
 
@@ -105,7 +107,7 @@ func (j Jumio) CheckStatus(referenceID string) (result common.KYCResult, err err
 		if errorCode != nil {
 			result.ErrorCode = fmt.Sprintf("%d", *errorCode)
 		}
-		err = fmt.Errorf("during sending request: %s", err)
+		err = common.NewProviderError(common.Jumio, errorCode, errors.Wrap(err, "during sending request"))
 		return
 	}
 
@@ -124,7 +126,7 @@ func (j Jumio) CheckStatus(referenceID string) (result common.KYCResult, err err
 			if errorCode != nil {
 				result.ErrorCode = fmt.Sprintf("%d", *errorCode)
 			}
-			err = fmt.Errorf("during sending request: %s", err)
+			err = common.NewProviderError(common.Jumio, errorCode, errors.Wrap(err, "during sending request"))
 			return
 		}
 		result, err = scanDetails.toResult()
@@ -135,6 +137,20 @@ func (j Jumio) CheckStatus(referenceID string) (result common.KYCResult, err err
 	return
 }
 
+// probeScanReference is the nonexistent scan reference used for probing the credentials.
+const probeScanReference = "00000000-0000-0000-0000-000000000000"
+
+// ProbeCredentials implements CredentialsProber interface for the Jumio.
+// It retrieves the status of a nonexistent scan, so the "not found" response means the credentials are accepted.
+func (j Jumio) ProbeCredentials() (code *int, err error) {
+	_, code, err = j.retrieveScanStatus(probeScanReference)
+	if code != nil && *code == stdhttp.StatusNotFound {
+		code, err = nil, nil
+	}
+
+	return
+}
+
 // retrieveScanStatus retrieves the status of an Jumio scan.
 func (j Jumio) retrieveScanStatus(referenceID string) (status ScanStatus, errorCode *int, err error) {
 	statusCode, resp, err := http.Get(j.baseURL+scanStatusEndpoint+referenceID, j.headers())
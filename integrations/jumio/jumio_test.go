@@ -402,4 +402,41 @@ var _ = Describe("Jumio", func() {
 			}
 		})
 	})
+
+	Describe("ProbeCredentials", func() {
+		var service = New(Config{
+			BaseURL: USbaseURL,
+			Token:   "test_token",
+			Secret:  "test_secret",
+		})
+
+		var probeURL = USbaseURL + scanStatusEndpoint + probeScanReference
+
+		BeforeEach(func() {
+			httpmock.Activate()
+		})
+
+		AfterEach(func() {
+			httpmock.DeactivateAndReset()
+		})
+
+		It("should accept the credentials when the probe scan isn't found", func() {
+			httpmock.RegisterResponder(http.MethodGet, probeURL, httpmock.NewBytesResponder(http.StatusNotFound, nil))
+
+			code, err := service.ProbeCredentials()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(code).To(BeNil())
+		})
+
+		It("should fail with rejected credentials", func() {
+			httpmock.RegisterResponder(http.MethodGet, probeURL, httpmock.NewBytesResponder(http.StatusUnauthorized, nil))
+
+			code, err := service.ProbeCredentials()
+
+			Expect(err).To(HaveOccurred())
+			Expect(code).NotTo(BeNil())
+			Expect(*code).To(Equal(http.StatusUnauthorized))
+		})
+	})
 })
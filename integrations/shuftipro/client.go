@@ -7,7 +7,6 @@ import (
 	"fmt"
 	stdhttp "net/http"
 	"strconv"
-	"time"
 
 	"modulus/kyc/common"
 	"modulus/kyc/http"
@@ -46,51 +45,33 @@ func (c Client) CheckCustomer(customer *common.UserData) (res common.KYCResult,
 		return
 	}
 
-	timer := time.NewTimer(time.Minute)
-	done := make(chan struct{})
-
-	go func() {
-		defer close(done)
-
-		code, resp, err1 := http.Post(c.host, c.headers, body)
-		if err1 != nil {
-			err = err1
-			return
-		}
-		if code != stdhttp.StatusOK {
-			res.ErrorCode = strconv.Itoa(code)
-		}
+	code, resp, err := http.Post(c.host, c.headers, body)
+	if err != nil {
+		err = common.NewProviderError(common.ShuftiPro, nil, err)
+		return
+	}
+	if code != stdhttp.StatusOK {
+		res.ErrorCode = strconv.Itoa(code)
+	}
 
-		response := Response{}
-		err = json.Unmarshal(resp, &response)
-		if err != nil {
-			return
-		}
+	response := Response{}
+	err = json.Unmarshal(resp, &response)
+	if err != nil {
+		err = common.NewProviderError(common.ShuftiPro, statusCode(code), fmt.Errorf("during decoding response: %s", err))
+		return
+	}
 
-		if code != stdhttp.StatusOK {
-			if _, ok := response.Error.(map[string]interface{}); !ok {
-				err = fmt.Errorf("%scheck the error code in the result", event2description[response.Event])
-				return
-			}
-			err = errorFromResponse(resp)
+	if code != stdhttp.StatusOK {
+		if _, ok := response.Error.(map[string]interface{}); !ok {
+			err = common.NewProviderError(common.ShuftiPro, &code, fmt.Errorf("%scheck the error code in the result", event2description[response.Event]))
 			return
 		}
-
-		res = response.ToKYCResult()
-	}()
-
-	select {
-	case <-done:
-		timer.Stop()
-	case <-timer.C:
-		res.Status = common.Unclear
-		res.StatusCheck = &common.KYCStatusCheck{
-			Provider:    common.ShuftiPro,
-			ReferenceID: req.Reference,
-			LastCheck:   time.Now(),
-		}
+		err = common.NewProviderError(common.ShuftiPro, &code, errorFromResponse(resp))
+		return
 	}
 
+	res = response.ToKYCResult()
+
 	return
 }
 
@@ -106,6 +87,7 @@ func (c Client) CheckStatus(referenceID string) (res common.KYCResult, err error
 
 	code, resp, err := http.Post(c.host+statusEndpoint, c.headers, body)
 	if err != nil {
+		err = common.NewProviderError(common.ShuftiPro, nil, err)
 		return
 	}
 	if code != stdhttp.StatusOK {
@@ -115,15 +97,16 @@ func (c Client) CheckStatus(referenceID string) (res common.KYCResult, err error
 	response := Response{}
 	err = json.Unmarshal(resp, &response)
 	if err != nil {
+		err = common.NewProviderError(common.ShuftiPro, statusCode(code), fmt.Errorf("during decoding response: %s", err))
 		return
 	}
 
 	if code != stdhttp.StatusOK {
 		if _, ok := response.Error.(map[string]interface{}); !ok {
-			err = fmt.Errorf("%scheck the error code in the result", event2description[response.Event])
+			err = common.NewProviderError(common.ShuftiPro, &code, fmt.Errorf("%scheck the error code in the result", event2description[response.Event]))
 			return
 		}
-		err = errorFromResponse(resp)
+		err = common.NewProviderError(common.ShuftiPro, &code, errorFromResponse(resp))
 		return
 	}
 
@@ -140,3 +123,11 @@ func errorFromResponse(response []byte) error {
 	}
 	return efield.Error
 }
+
+// statusCode returns the status code of the failed API response or nil for the successful one.
+func statusCode(code int) *int {
+	if code == stdhttp.StatusOK {
+		return nil
+	}
+	return &code
+}
@@ -3,12 +3,9 @@ package shuftipro
 import (
 	"encoding/base64"
 	"errors"
-	"flag"
 	"fmt"
 	stdhttp "net/http"
-	"net/http/httptest"
 	"testing"
-	"time"
 
 	"modulus/kyc/common"
 	"modulus/kyc/http"
@@ -17,8 +14,6 @@ import (
 	"gopkg.in/jarcoal/httpmock.v1"
 )
 
-var timeout = flag.Bool("ttimeout", false, "Test request timeout simulation")
-
 var reqInvalidResponse = `{
     "reference": "17374217",
     "event": "request.invalid",
@@ -272,7 +267,7 @@ func TestCheckCustomer(t *testing.T) {
 		testCase{
 			name:      "Test changed response format",
 			responder: httpmock.NewStringResponder(stdhttp.StatusOK, changedResponse),
-			err:       errors.New("json: cannot unmarshal number into Go struct field Response.event of type shuftipro.Event"),
+			err:       errors.New("during decoding response: json: cannot unmarshal number into Go struct field Response.event of type shuftipro.Event"),
 		},
 		testCase{
 			name:      "Test changed error field format",
@@ -300,6 +295,8 @@ func TestCheckCustomer(t *testing.T) {
 			assert.Equal(tc.result, res)
 			if tc.err != nil {
 				assert.Equal(tc.err.Error(), err.Error())
+				_, classified := common.AsKYCError(err)
+				assert.True(classified)
 			} else {
 				assert.Equal(tc.err, err)
 			}
@@ -307,39 +304,6 @@ func TestCheckCustomer(t *testing.T) {
 	}
 }
 
-func TestCheckCustomerWithTimeout(t *testing.T) {
-	if !*timeout {
-		t.Skip("Use -ttimeout command line flag to test the timeout simulation")
-
-	}
-
-	ts := httptest.NewServer(stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
-		time.Sleep(time.Minute + time.Second)
-		fmt.Fprintln(w, "Hello, client")
-	}))
-	defer ts.Close()
-
-	client := NewClient(Config{
-		Host:        ts.URL,
-		ClientID:    "client_id",
-		SecretKey:   "secret_key",
-		CallbackURL: "callback_url",
-	})
-
-	res, err := client.CheckCustomer(&common.UserData{})
-
-	assert := assert.New(t)
-	assert.NoError(err)
-	assert.Equal(common.Unclear, res.Status)
-	assert.Equal(common.Unclear, res.Status)
-	assert.Nil(res.Details)
-	assert.Empty(res.ErrorCode)
-	assert.NotNil(res.StatusCheck)
-	assert.Equal(common.ShuftiPro, res.StatusCheck.Provider)
-	assert.NotEmpty(res.StatusCheck.ReferenceID)
-	assert.NotZero(res.StatusCheck.LastCheck)
-}
-
 func TestCheckStatus(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -389,7 +353,7 @@ func TestCheckStatus(t *testing.T) {
 			name:      "Test changed response format",
 			refID:     refID,
 			responder: httpmock.NewStringResponder(stdhttp.StatusOK, changedResponse),
-			err:       errors.New("json: cannot unmarshal number into Go struct field Response.event of type shuftipro.Event"),
+			err:       errors.New("during decoding response: json: cannot unmarshal number into Go struct field Response.event of type shuftipro.Event"),
 		},
 		testCase{
 			name:      "Test changed error field format",
@@ -410,6 +374,8 @@ func TestCheckStatus(t *testing.T) {
 			assert.Equal(tc.result, res)
 			if tc.err != nil {
 				assert.Equal(tc.err.Error(), err.Error())
+				_, classified := common.AsKYCError(err)
+				assert.True(classified)
 			} else {
 				assert.Equal(tc.err, err)
 			}
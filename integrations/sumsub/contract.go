@@ -4,8 +4,15 @@ package sumsub
 type Config struct {
 	Host   string
 	APIKey string
+	// UploadParallelism limits the number of the documents uploaded at once, DefaultUploadParallelism is used if it's zero.
+	UploadParallelism int
+	// UploadRate limits the number of the document uploads started per second, it's unlimited by default.
+	UploadRate float64
 }
 
+// DefaultUploadParallelism is the number of the documents uploaded at once by default.
+const DefaultUploadParallelism = 4
+
 // Different values of a verification result.
 const (
 	RedScore     = "RED"
@@ -8,6 +8,7 @@ import (
 	"modulus/kyc/integrations/sumsub/applicants"
 	"modulus/kyc/integrations/sumsub/documents"
 	"modulus/kyc/integrations/sumsub/verification"
+	"modulus/kyc/upload"
 
 	"github.com/pkg/errors"
 )
@@ -19,10 +20,16 @@ type SumSub struct {
 	applicants   applicants.Applicants
 	documents    documents.Documents
 	verification verification.Verification
+	uploads      upload.Executor
 }
 
 // New constructs new verification service object.
 func New(config Config) SumSub {
+	parallelism := config.UploadParallelism
+	if parallelism == 0 {
+		parallelism = DefaultUploadParallelism
+	}
+
 	return SumSub{
 		applicants: applicants.NewService(applicants.Config{
 			Host:   config.Host,
@@ -36,20 +43,24 @@ func New(config Config) SumSub {
 			Host:   config.Host,
 			APIKey: config.APIKey,
 		}),
+		uploads: upload.New(config.Host+" "+config.APIKey, upload.Options{
+			Parallelism: parallelism,
+			Rate:        config.UploadRate,
+		}),
 	}
 }
 
 // CheckCustomer implements KYCPlatform interface for Sum&Substance KYC provider.
 func (service SumSub) CheckCustomer(customer *common.UserData) (res common.KYCResult, err error) {
 	if customer == nil {
-		err = errors.New("no customer supplied")
+		err = common.NewValidationError(common.SumSub, errors.New("no customer supplied"))
 		return
 	}
 
 	// Process customer documents. At least one document has to be provided for verification.
 	mappedDocuments := documents.MapCommonCustomerDocuments(*customer)
 	if len(mappedDocuments) == 0 {
-		err = errors.New("at least one document has to be provided for verification")
+		err = common.NewValidationError(common.SumSub, errors.New("at least one document has to be provided for verification"))
 		return
 	}
 
@@ -59,9 +70,12 @@ func (service SumSub) CheckCustomer(customer *common.UserData) (res common.KYCRe
 		applicants.MapCommonCustomerToApplicant(*customer),
 	)
 	if err != nil {
+		var code *int
 		if applicantResponse != nil && applicantResponse.Code != nil {
+			code = applicantResponse.Code
 			res.ErrorCode = fmt.Sprintf("%d", *applicantResponse.Code)
 		}
+		err = common.NewProviderError(common.SumSub, code, err)
 		return
 	}
 
@@ -71,26 +85,36 @@ func (service SumSub) CheckCustomer(customer *common.UserData) (res common.KYCRe
 	}
 
 	// Upload applicant's documents.
-	for _, document := range mappedDocuments {
-		_, errorCode, err1 := service.documents.UploadDocument(applicantResponse.ID, document)
-		if err1 != nil {
-			if errorCode != nil {
-				res.ErrorCode = fmt.Sprintf("%d", *errorCode)
-			}
-			err = errors.Wrapf(
-				err1,
-				"Unable to upload document with filename: %s, type: %s, side: %s",
-				document.File.Filename,
-				document.Metadata.DocumentType,
-				document.Metadata.DocumentSubType,
-			)
+	uploads := make([]upload.Task, len(mappedDocuments))
+	for i := range mappedDocuments {
+		document := mappedDocuments[i]
+		uploads[i] = func() (errorCode *int, err error) {
+			_, errorCode, err = service.documents.UploadDocument(applicantResponse.ID, document)
 			return
 		}
 	}
+	if failed, errorCode, err1 := service.uploads.Run(uploads); err1 != nil {
+		if errorCode != nil {
+			res.ErrorCode = fmt.Sprintf("%d", *errorCode)
+		}
+		document := mappedDocuments[failed]
+		err = common.NewProviderError(common.SumSub, errorCode, errors.Wrapf(
+			err1,
+			"Unable to upload document with filename: %s, type: %s, side: %s",
+			document.File.Filename,
+			document.Metadata.DocumentType,
+			document.Metadata.DocumentSubType,
+		))
+		return
+	}
 
 	// Request applicant check.
 	if err = service.verification.RequestApplicantCheck(applicantResponse.ID); err != nil {
-		err = fmt.Errorf("during requesting applicant check: %s", err)
+		var code *int
+		if e, ok := err.(verification.Error); ok {
+			code = e.Code
+		}
+		err = common.NewProviderError(common.SumSub, code, errors.Wrap(err, "during requesting applicant check"))
 		return
 	}
 
@@ -109,9 +133,12 @@ func (service SumSub) CheckCustomer(customer *common.UserData) (res common.KYCRe
 func (service SumSub) CheckStatus(refID string) (res common.KYCResult, err error) {
 	status, result, err := service.verification.CheckApplicantStatus(refID)
 	if err != nil {
+		var code *int
 		if result != nil && result.ErrorCode != 0 {
+			code = &result.ErrorCode
 			res.ErrorCode = fmt.Sprintf("%d", result.ErrorCode)
 		}
+		err = common.NewProviderError(common.SumSub, code, err)
 		return
 	}
 
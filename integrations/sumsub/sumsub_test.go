@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 	"io/ioutil"
+	"sync"
 	"testing"
 	"time"
 
@@ -441,6 +442,47 @@ func TestSumSub_CheckCustomerDocumentUploadError(t *testing.T) {
 	}
 }
 
+func TestSumSub_CheckCustomerParallelUpload(t *testing.T) {
+	assert := assert.New(t)
+
+	var mu sync.Mutex
+	uploaded := map[string]bool{}
+	sumsubService := New(Config{Host: "parallel upload test", UploadParallelism: 3})
+	sumsubService.applicants = applicants.Mock{
+		CreateApplicantFn: func(email string, applicant applicants.ApplicantInfo) (*applicants.CreateApplicantResponse, error) {
+			return &applicants.CreateApplicantResponse{ID: "test id"}, nil
+		},
+	}
+	sumsubService.documents = documents.Mock{
+		UploadDocumentFn: func(applicantID string, document documents.Document) (*documents.Metadata, *int, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			uploaded[document.File.Filename] = true
+			if document.Metadata.DocumentType == "ID_CARD" {
+				code := 400
+				return nil, &code, errors.New("Bad document")
+			}
+			return &document.Metadata, nil, nil
+		},
+	}
+
+	customer := &common.UserData{
+		Passport: &common.Passport{Image: &common.DocumentFile{Filename: "passport.jpg"}},
+		IDCard:   &common.IDCard{Image: &common.DocumentFile{Filename: "idcard.jpg"}},
+		Selfie:   &common.Selfie{Image: &common.DocumentFile{Filename: "selfie.jpg"}},
+	}
+
+	result, err := sumsubService.CheckCustomer(customer)
+
+	if assert.Error(err) {
+		assert.Contains(err.Error(), "Unable to upload document with filename: idcard.jpg, type: ID_CARD")
+		assert.Equal("400", result.ErrorCode)
+	}
+	assert.True(uploaded["passport.jpg"])
+	assert.True(uploaded["idcard.jpg"])
+}
+
 func TestSumSub_CheckCustomerCreateApplicantError(t *testing.T) {
 	sumsubService := &SumSub{
 		applicants: applicants.Mock{
@@ -1,6 +1,7 @@
 package synapsefi
 
 import (
+	"strconv"
 	"time"
 
 	"modulus/kyc/common"
@@ -10,6 +11,7 @@ import (
 )
 
 var _ common.KYCPlatform = SynapseFI{}
+var _ common.CredentialsProber = SynapseFI{}
 
 // SynapseFI represents the verification service.
 type SynapseFI struct {
@@ -26,21 +28,21 @@ func New(config Config) SynapseFI {
 // CheckCustomer implements KYCPlatform interface for the SynapseFI.
 func (service SynapseFI) CheckCustomer(customer *common.UserData) (result common.KYCResult, err error) {
 	if customer == nil {
-		err = errors.New("no customer supplied")
+		err = common.NewValidationError(common.SynapseFI, errors.New("no customer supplied"))
 		return
 	}
 
 	user := verification.MapCustomerToUser(customer)
 
 	if len(user.Documents[0].VirtualDocs) == 0 {
-		err = errors.New("failed to get document's number from customer documents or no document was supplied")
+		err = common.NewValidationError(common.SynapseFI, errors.New("failed to get document's number from customer documents or no document was supplied"))
 		return
 	}
 
 	physDocs := verification.MapCustomerToPhysicalDocs(customer)
 
 	if len(physDocs) == 0 {
-		err = errors.New("failed to get document's content or no document was supplied")
+		err = common.NewValidationError(common.SynapseFI, errors.New("failed to get document's content or no document was supplied"))
 		return
 	}
 
@@ -49,6 +51,7 @@ func (service SynapseFI) CheckCustomer(customer *common.UserData) (result common
 		if code != nil {
 			result.ErrorCode = *code
 		}
+		err = providerError(err)
 		return
 	}
 
@@ -62,6 +65,7 @@ func (service SynapseFI) CheckCustomer(customer *common.UserData) (result common
 		if code != nil {
 			result.ErrorCode = *code
 		}
+		err = providerError(err)
 		return
 	}
 
@@ -82,6 +86,7 @@ func (service SynapseFI) CheckStatus(refID string) (result common.KYCResult, err
 		if code != nil {
 			result.ErrorCode = *code
 		}
+		err = providerError(err)
 		return
 	}
 
@@ -89,3 +94,20 @@ func (service SynapseFI) CheckStatus(refID string) (result common.KYCResult, err
 
 	return
 }
+
+// ProbeCredentials implements CredentialsProber interface for the SynapseFI.
+func (service SynapseFI) ProbeCredentials() (code *int, err error) {
+	return service.verification.ProbeCredentials()
+}
+
+// providerError classifies the error of the SynapseFI API request by the HTTP status code from the error response.
+func providerError(err error) error {
+	var code *int
+	if eresp, ok := err.(*verification.ErrorResponse); ok {
+		if status, err1 := strconv.Atoi(eresp.HTTPCode); err1 == nil {
+			code = &status
+		}
+	}
+
+	return common.NewProviderError(common.SynapseFI, code, err)
+}
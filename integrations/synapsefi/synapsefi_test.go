@@ -21,6 +21,7 @@ type Mock struct {
 	CreateUserFn      func(verification.User) (*verification.Response, *string, error)
 	AddPhysicalDocsFn func(string, string, string, []verification.SubDocument) (*string, error)
 	GetUserFn         func(string) (*verification.Response, *string, error)
+	ProbeFn           func() (*int, error)
 }
 
 func (m Mock) CreateUser(user verification.User) (*verification.Response, *string, error) {
@@ -35,6 +36,10 @@ func (m Mock) GetUser(refID string) (*verification.Response, *string, error) {
 	return m.GetUserFn(refID)
 }
 
+func (m Mock) ProbeCredentials() (*int, error) {
+	return m.ProbeFn()
+}
+
 func TestNew(t *testing.T) {
 	config := Config{
 		Host:         "host",
@@ -315,6 +320,39 @@ func TestSynapseFI_CheckCustomerError(t *testing.T) {
 	assert.Nil(result.Details)
 	assert.Empty(result.ErrorCode)
 	assert.Nil(result.StatusCheck)
+
+	kycErr, ok := common.AsKYCError(err)
+	assert.True(ok)
+	assert.Equal(common.ValidationError, kycErr.Category)
+}
+
+func TestSynapseFI_CheckStatusErrorCategory(t *testing.T) {
+	assert := assert.New(t)
+
+	service := SynapseFI{
+		verification: Mock{
+			GetUserFn: func(string) (*verification.Response, *string, error) {
+				code := "110"
+
+				return nil, &code, &verification.ErrorResponse{
+					Text:     map[string]string{"en": "Invalid/expired oauth_key."},
+					Code:     code,
+					HTTPCode: "401",
+				}
+			},
+		},
+	}
+
+	result, err := service.CheckStatus("userID")
+
+	assert.EqualError(err, "http status: 401 | error code: 110 | error: Invalid/expired oauth_key.")
+	assert.Equal("110", result.ErrorCode)
+
+	kycErr, ok := common.AsKYCError(err)
+	assert.True(ok)
+	assert.Equal(common.AuthenticationError, kycErr.Category)
+	assert.Equal(common.SynapseFI, kycErr.Provider)
+	assert.Equal(401, kycErr.StatusCode)
 }
 
 func TestAgainstSandbox(t *testing.T) {
@@ -18,6 +18,7 @@ type Verification interface {
 	CreateUser(user User) (*Response, *string, error)
 	AddPhysicalDocs(userID, rtoken, docsID string, docs []SubDocument) (*string, error)
 	GetUser(userID string) (*Response, *string, error)
+	ProbeCredentials() (*int, error)
 }
 
 func (c Config) calcFingerprint() string {
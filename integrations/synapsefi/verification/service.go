@@ -117,6 +117,24 @@ func (service service) GetUser(userID string) (resp *Response, code *string, err
 	return
 }
 
+// ProbeCredentials checks the client credentials used by the gateway and OAuth requests.
+// It lists a single user of the platform since the OAuth itself requires an existing user.
+func (service service) ProbeCredentials() (code *int, err error) {
+	headers := service.composeHeaders(false, "")
+	endpoint := service.config.Host + endpointUsers + "?per_page=1"
+
+	status, response, err := http.Get(endpoint, headers)
+	if err != nil {
+		return
+	}
+	if status != stdhttp.StatusOK {
+		code = &status
+		_, err = MapErrorResponse(response)
+	}
+
+	return
+}
+
 func (service service) getOAuthKey(userID, rtoken string) (key string, err error) {
 	req := OAuthRequest{
 		RefreshToken: rtoken,
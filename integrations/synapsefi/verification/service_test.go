@@ -537,3 +537,39 @@ func Test_service_AddPhysicalDocsError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, code)
 }
+
+func Test_service_ProbeCredentials(t *testing.T) {
+	service := NewService(Config{
+		Host:         "https://uat-api.synapsefi.com/v3.1/",
+		ClientID:     "client_id",
+		ClientSecret: "secret",
+	})
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		http.MethodGet,
+		"https://uat-api.synapsefi.com/v3.1/users?per_page=1",
+		func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, "client_id|secret", request.Header.Get("X-SP-GATEWAY"))
+			return httpmock.NewStringResponse(http.StatusOK, `{"users":[],"users_count":0}`), nil
+		},
+	)
+
+	code, err := service.ProbeCredentials()
+	assert.NoError(t, err)
+	assert.Nil(t, code)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder(
+		http.MethodGet,
+		"https://uat-api.synapsefi.com/v3.1/users?per_page=1",
+		httpmock.NewStringResponder(http.StatusUnauthorized, `{"error":{"en":"Invalid client credentials."},"error_code":"100","http_code":"401","success":false}`),
+	)
+
+	code, err = service.ProbeCredentials()
+	assert.Error(t, err)
+	assert.NotNil(t, code)
+	assert.Equal(t, http.StatusUnauthorized, *code)
+}
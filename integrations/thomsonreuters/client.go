@@ -7,6 +7,8 @@ import (
 
 	"modulus/kyc/http"
 	"modulus/kyc/integrations/thomsonreuters/model"
+
+	"github.com/pkg/errors"
 )
 
 // getRootGroups retrieves all the top-level groups with their immediate descendants.
@@ -17,7 +19,7 @@ func (tr ThomsonReuters) getRootGroups() (groups model.Groups, code *int, err er
 
 	status, resp, err := http.Get(tr.scheme+"://"+tr.host+tr.path+path, headers)
 	if err != nil {
-		err = fmt.Errorf("during fetching top level groups: %s", err)
+		err = errors.Wrap(err, "during fetching top level groups")
 		return
 	}
 
@@ -47,7 +49,7 @@ func (tr ThomsonReuters) getGroup(groupID string) (group model.Group, code *int,
 
 	status, resp, err := http.Get(tr.scheme+"://"+tr.host+tr.path+path, headers)
 	if err != nil {
-		err = fmt.Errorf("during fetching the group with id %s: %s", groupID, err)
+		err = errors.Wrapf(err, "during fetching the group with id %s", groupID)
 		return
 	}
 
@@ -77,7 +79,7 @@ func (tr ThomsonReuters) getCaseTemplate(groupID string) (caseTemplate model.Cas
 
 	status, resp, err := http.Get(tr.scheme+"://"+tr.host+tr.path+path, headers)
 	if err != nil {
-		err = fmt.Errorf("during fetching a case template for the group with id %s: %s", groupID, err)
+		err = errors.Wrapf(err, "during fetching a case template for the group with id %s", groupID)
 		return
 	}
 
@@ -213,7 +215,7 @@ func (tr ThomsonReuters) performSynchronousScreening(newcase model.NewCase) (res
 
 	status, resp, err := http.Post(tr.scheme+"://"+tr.host+tr.path+path, headers, payload)
 	if err != nil {
-		err = fmt.Errorf("during performing synchronous screening: %s", err)
+		err = errors.Wrap(err, "during performing synchronous screening")
 		return
 	}
 
@@ -2,7 +2,10 @@ package thomsonreuters
 
 import (
 	"errors"
+	"time"
+
 	"modulus/kyc/integrations/thomsonreuters/model"
+	"modulus/kyc/ttlcache"
 )
 
 // getGroupID returns group id.
@@ -35,3 +38,28 @@ func (tr ThomsonReuters) getGroupID() (groupID string, code *int, err error) {
 
 	return
 }
+
+// caseTemplate returns the case template of the group used for the screening.
+// The template is cached for the account, so the group and the template aren't fetched for every screening.
+func (tr ThomsonReuters) caseTemplate() (template model.CaseTemplateResponse, code *int, err error) {
+	value, code, err := referenceData.Get(tr.referenceDataKey(), func() (interface{}, time.Duration, *int, error) {
+		groupID, code, err := tr.getGroupID()
+		if err != nil {
+			return nil, 0, code, err
+		}
+
+		template, code, err := tr.getCaseTemplate(groupID)
+		return template, referenceDataTTL, code, err
+	})
+	if err != nil {
+		return
+	}
+	template = value.(model.CaseTemplateResponse)
+
+	return
+}
+
+// referenceDataKey returns the key of the reference data of the account.
+func (tr ThomsonReuters) referenceDataKey() string {
+	return ttlcache.Key(tr.scheme, tr.host, tr.path, tr.key, tr.secret)
+}
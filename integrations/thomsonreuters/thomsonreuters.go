@@ -4,13 +4,23 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	stdhttp "net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"modulus/kyc/common"
+	"modulus/kyc/ttlcache"
 )
 
 var _ common.KYCPlatform = ThomsonReuters{}
+var _ common.CredentialsProber = ThomsonReuters{}
+
+// referenceDataTTL is the time the group and its case template are reused for.
+const referenceDataTTL = time.Hour
+
+// referenceData caches the case templates of the groups used for the screening.
+var referenceData = ttlcache.New()
 
 // ThomsonReuters represents the Thomson Reuters API client.
 type ThomsonReuters struct {
@@ -49,33 +59,32 @@ func New(c Config) ThomsonReuters {
 // CheckCustomer implements KYCPlatform interface for Thomson Reuters.
 func (tr ThomsonReuters) CheckCustomer(customer *common.UserData) (result common.KYCResult, err error) {
 	if customer == nil {
-		err = errors.New("customer data is nil")
-		return
-	}
-
-	gID, code, err := tr.getGroupID()
-	if err != nil {
-		if code != nil {
-			result.ErrorCode = fmt.Sprintf("%d", *code)
-		}
+		err = common.NewValidationError(common.ThomsonReuters, errors.New("customer data is nil"))
 		return
 	}
 
-	template, code, err := tr.getCaseTemplate(gID)
+	template, code, err := tr.caseTemplate()
 	if err != nil {
 		if code != nil {
 			result.ErrorCode = fmt.Sprintf("%d", *code)
 		}
+		err = common.NewProviderError(common.ThomsonReuters, code, err)
 		return
 	}
 
 	newcase := newCase(template, customer)
 
 	src, code, err := tr.performSynchronousScreening(newcase)
+	if code != nil && (*code == stdhttp.StatusUnauthorized || *code == stdhttp.StatusForbidden || *code == stdhttp.StatusNotFound) {
+		// The access to the cached group might have been revoked or the group removed,
+		// so the reference data is fetched again for the next screening.
+		referenceData.Invalidate(tr.referenceDataKey())
+	}
 	if err != nil {
 		if code != nil {
 			result.ErrorCode = fmt.Sprintf("%d", *code)
 		}
+		err = common.NewProviderError(common.ThomsonReuters, code, err)
 		return
 	}
 
@@ -86,6 +95,14 @@ func (tr ThomsonReuters) CheckCustomer(customer *common.UserData) (result common
 
 // CheckStatus implements KYCPlatform interface for Thomson Reuters.
 func (tr ThomsonReuters) CheckStatus(referenceID string) (res common.KYCResult, err error) {
-	err = errors.New("Thomson Reuters doesn't support a verification status check")
+	err = common.NewError(common.ThomsonReuters, common.ProviderRejectedError, errors.New("Thomson Reuters doesn't support a verification status check"))
+	return
+}
+
+// ProbeCredentials implements CredentialsProber interface for Thomson Reuters.
+// It retrieves the top-level groups which is the lightest request signed with the API key.
+func (tr ThomsonReuters) ProbeCredentials() (code *int, err error) {
+	_, code, err = tr.getRootGroups()
+
 	return
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"modulus/kyc/common"
+	"modulus/kyc/ttlcache"
 
 	"gopkg.in/jarcoal/httpmock.v1"
 	"github.com/stretchr/testify/assert"
@@ -92,3 +93,61 @@ func TestCheckCustomerDenied(t *testing.T) {
 	assert.Empty(res.ErrorCode)
 	assert.Nil(res.StatusCheck)
 }
+
+func TestCheckCustomerReferenceDataCache(t *testing.T) {
+	assert := assert.New(t)
+
+	referenceData = ttlcache.New()
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	groupsURL := tr.scheme + "://" + tr.host + tr.path + "groups"
+	screeningStatus := http.StatusOK
+	httpmock.RegisterResponder(http.MethodGet, groupsURL, httpmock.NewStringResponder(http.StatusOK, groupsResponse))
+	httpmock.RegisterResponder(http.MethodGet, groupsURL+"/0a3687d0-65b4-1cc3-9975-f20b0000066f/caseTemplate", httpmock.NewStringResponder(http.StatusOK, caseTemplateResponse))
+	httpmock.RegisterResponder(http.MethodPost, tr.scheme+"://"+tr.host+tr.path+"cases/screeningRequest", func(*http.Request) (*http.Response, error) {
+		return httpmock.NewStringResponse(screeningStatus, syncScreeningResponseApproved), nil
+	})
+
+	for i := 0; i < 3; i++ {
+		res, err := tr.CheckCustomer(&common.UserData{})
+
+		assert.NoError(err)
+		assert.Equal(common.Approved, res.Status)
+	}
+
+	assert.Equal(1, httpmock.GetCallCountInfo()["GET "+groupsURL])
+
+	// The reference data is fetched again after the screening has been rejected.
+	screeningStatus = http.StatusUnauthorized
+	tr.CheckCustomer(&common.UserData{})
+	screeningStatus = http.StatusOK
+	tr.CheckCustomer(&common.UserData{})
+
+	assert.Equal(2, httpmock.GetCallCountInfo()["GET "+groupsURL])
+}
+
+func TestProbeCredentials(t *testing.T) {
+	assert := assert.New(t)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(http.MethodGet, tr.scheme+"://"+tr.host+tr.path+"groups", httpmock.NewStringResponder(http.StatusOK, groupsResponse))
+
+	code, err := tr.ProbeCredentials()
+
+	assert.NoError(err)
+	assert.Nil(code)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder(http.MethodGet, tr.scheme+"://"+tr.host+tr.path+"groups", httpmock.NewStringResponder(http.StatusUnauthorized, ""))
+
+	code, err = tr.ProbeCredentials()
+
+	assert.Error(err)
+	assert.Equal("during fetching top level groups: http error 401", err.Error())
+	assert.NotNil(code)
+	assert.Equal(http.StatusUnauthorized, *code)
+}
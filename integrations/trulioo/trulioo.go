@@ -2,19 +2,32 @@ package trulioo
 
 import (
 	"fmt"
+	stdhttp "net/http"
+	"time"
+
 	"modulus/kyc/common"
 	"modulus/kyc/integrations/trulioo/configuration"
 	"modulus/kyc/integrations/trulioo/verification"
+	"modulus/kyc/ttlcache"
 
 	"github.com/pkg/errors"
 )
 
 var _ common.KYCPlatform = Trulioo{}
+var _ common.CredentialsProber = Trulioo{}
+
+// consentsTTL is the time the consents of the country are reused for.
+const consentsTTL = 24 * time.Hour
+
+// consentsCache caches the consents required for the verification in the countries.
+var consentsCache = ttlcache.New()
 
 // Trulioo defines the verification service.
+// The consents are cached for the account if it's set.
 type Trulioo struct {
 	configuration configuration.Configuration
 	verification  verification.Verification
+	account       string
 }
 
 // New constructs a new service object.
@@ -23,36 +36,49 @@ func New(config Config) Trulioo {
 	return Trulioo{
 		configuration: configuration.NewService(config.ToConfigurationConfig()),
 		verification:  verification.NewService(config.ToVerificationConfig()),
+		account:       ttlcache.Key(config.Host, config.NAPILogin, config.NAPIPassword),
 	}
 }
 
 // CheckCustomer implements KYCPlatform interface for Trulioo.
 func (service Trulioo) CheckCustomer(customer *common.UserData) (res common.KYCResult, err error) {
 	if customer == nil {
-		err = errors.New("No customer supplied")
+		err = common.NewValidationError(common.Trulioo, errors.New("No customer supplied"))
 		return
 	}
 
-	consents, errorCode, err := service.configuration.Consents(customer.CountryAlpha2)
+	consents, errorCode, err := service.consents(customer.CountryAlpha2)
 	if err != nil {
 		if errorCode != nil {
 			res.ErrorCode = fmt.Sprintf("%d", *errorCode)
 		}
+		err = common.NewProviderError(common.Trulioo, errorCode, err)
 		return
 	}
 
 	dataFields := verification.MapCustomerToDataFields(customer)
 
 	response, err := service.verification.Verify(customer.CountryAlpha2, consents, dataFields)
+	var code *int
 	if response != nil && response.ErrorCode != nil {
+		code = response.ErrorCode
 		res.ErrorCode = fmt.Sprintf("%d", *response.ErrorCode)
 	}
+	if code != nil && *code == stdhttp.StatusUnauthorized && len(service.account) > 0 {
+		// The credentials might have been revoked, so the cached consents aren't reused.
+		consentsCache.Invalidate(service.consentsKey(customer.CountryAlpha2))
+	}
 	if err != nil {
+		err = common.NewProviderError(common.Trulioo, code, err)
 		return
 	}
 
 	if len(response.Errors) > 0 {
-		err = response.Errors
+		if code == nil {
+			err = common.NewError(common.Trulioo, common.ProviderRejectedError, response.Errors)
+			return
+		}
+		err = common.NewProviderError(common.Trulioo, code, response.Errors)
 		return
 	}
 
@@ -118,6 +144,41 @@ func (service Trulioo) CheckCustomer(customer *common.UserData) (res common.KYCR
 
 // CheckStatus implements KYCPlatform interface for Trulioo.
 func (service Trulioo) CheckStatus(referenceID string) (res common.KYCResult, err error) {
-	err = errors.New("Trulioo doesn't support a verification status check")
+	err = common.NewError(common.Trulioo, common.ProviderRejectedError, errors.New("Trulioo doesn't support a verification status check"))
+	return
+}
+
+// consents returns the consents required for the verification in the country.
+// They are cached for the account, so they aren't fetched for every verification.
+func (service Trulioo) consents(countryAlpha2 string) (consents configuration.Consents, code *int, err error) {
+	if len(service.account) == 0 {
+		return service.configuration.Consents(countryAlpha2)
+	}
+
+	value, code, err := consentsCache.Get(service.consentsKey(countryAlpha2), func() (interface{}, time.Duration, *int, error) {
+		consents, code, err := service.configuration.Consents(countryAlpha2)
+		return consents, consentsTTL, code, err
+	})
+	if err != nil {
+		return
+	}
+	consents = value.(configuration.Consents)
+
+	return
+}
+
+// consentsKey returns the key of the cached consents of the country.
+func (service Trulioo) consentsKey(countryAlpha2 string) string {
+	return ttlcache.Key(service.account, countryAlpha2)
+}
+
+// probeCountry is the country the consents are requested for when probing the credentials.
+const probeCountry = "US"
+
+// ProbeCredentials implements CredentialsProber interface for Trulioo.
+// It requests the consents required for the verification in the probe country.
+func (service Trulioo) ProbeCredentials() (code *int, err error) {
+	_, code, err = service.configuration.Consents(probeCountry)
+
 	return
 }
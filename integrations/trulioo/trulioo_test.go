@@ -12,6 +12,7 @@ import (
 	"modulus/kyc/common"
 	"modulus/kyc/integrations/trulioo/configuration"
 	"modulus/kyc/integrations/trulioo/verification"
+	"modulus/kyc/ttlcache"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -975,4 +976,71 @@ func TestCheckStatus(t *testing.T) {
 	assert.Equal(common.Error, res.Status)
 	assert.Error(err)
 	assert.Equal("Trulioo doesn't support a verification status check", err.Error())
+
+	if kycErr, ok := common.AsKYCError(err); assert.True(ok) {
+		assert.Equal(common.ProviderRejectedError, kycErr.Category)
+	}
+}
+
+func TestTrulioo_CheckCustomerConsentsCache(t *testing.T) {
+	consentsCache = ttlcache.New()
+
+	calls := 0
+	service := Trulioo{
+		configuration: configuration.Mock{
+			ConsentsFn: func(countryAlpha2 string) (configuration.Consents, *int, error) {
+				calls++
+				return configuration.Consents{"Birth Registry"}, nil, nil
+			},
+		},
+		verification: verification.Mock{
+			VerifyFn: func(countryAlpha2 string, consents configuration.Consents, fields verification.DataFields) (*verification.Response, error) {
+				assert.Equal(t, configuration.Consents{"Birth Registry"}, consents)
+				return &verification.Response{Record: verification.Record{RecordStatus: Match}}, nil
+			},
+		},
+		account: "account",
+	}
+
+	for _, country := range []string{"GB", "GB", "US", "GB"} {
+		_, err := service.CheckCustomer(&common.UserData{CountryAlpha2: country})
+
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 2, calls)
+
+	// The consents aren't cached for the service without the account.
+	service.account = ""
+	service.CheckCustomer(&common.UserData{CountryAlpha2: "GB"})
+
+	assert.Equal(t, 3, calls)
+}
+
+func TestTrulioo_ProbeCredentials(t *testing.T) {
+	service := Trulioo{
+		configuration: configuration.Mock{
+			ConsentsFn: func(countryAlpha2 string) (configuration.Consents, *int, error) {
+				assert.Equal(t, probeCountry, countryAlpha2)
+				return configuration.Consents{}, nil, nil
+			},
+		},
+	}
+
+	code, err := service.ProbeCredentials()
+
+	assert.NoError(t, err)
+	assert.Nil(t, code)
+
+	unauthorized := http.StatusUnauthorized
+	service.configuration = configuration.Mock{
+		ConsentsFn: func(countryAlpha2 string) (configuration.Consents, *int, error) {
+			return nil, &unauthorized, errors.New("Unauthorized")
+		},
+	}
+
+	code, err = service.ProbeCredentials()
+
+	assert.Error(t, err)
+	assert.Equal(t, "Unauthorized", err.Error())
+	assert.Equal(t, &unauthorized, code)
 }
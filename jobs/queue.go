@@ -0,0 +1,452 @@
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"modulus/kyc/common"
+
+	"github.com/google/uuid"
+)
+
+// Default settings of the Queue.
+const (
+	DefaultWorkers          = 8
+	DefaultQueueSize        = 100
+	DefaultTTL              = time.Hour
+	DefaultCallbackAttempts = 3
+	DefaultCallbackBackoff  = 5 * time.Second
+	DefaultCallbackWorkers  = 4
+)
+
+// callbackTimeout limits the time of the single callback attempt.
+const callbackTimeout = 30 * time.Second
+
+// SignatureHeader is the header of the callbacks holding the HMAC-SHA256 signature of the callback
+// in the "t=<unix time>,v1=<hex signature>" format. The signature is computed by the Signature function.
+const SignatureHeader = "X-KYC-Signature"
+
+var (
+	// ErrQueueFull means all the workers are busy and the queue has no room for the job.
+	ErrQueueFull = errors.New("job queue is full")
+	// ErrNotFound means there is no job with the specified id or it has expired.
+	ErrNotFound = errors.New("job not found")
+	// ErrStopped means the queue doesn't accept jobs any more.
+	ErrStopped = errors.New("job queue is stopped")
+	// ErrInvalidCallbackURL means the callback URL isn't the absolute http or https URL.
+	ErrInvalidCallbackURL = errors.New("invalid callback URL: absolute http or https URL expected")
+	// ErrCallbackHost means the host of the callback URL isn't allowed by the config.
+	ErrCallbackHost = errors.New("callback host isn't allowed")
+	// ErrCallbackAddress means the callback host is the loopback, private or link-local address.
+	ErrCallbackAddress = errors.New("callback address isn't public")
+)
+
+// Task performs the work of the job. The returned response is reported as the job response.
+type Task func() common.KYCResponse
+
+// Config holds the settings of the Queue. The zero values take the defaults.
+type Config struct {
+	// Workers is the number of the jobs running at once.
+	Workers int
+	// QueueSize is the number of the jobs waiting for a worker the queue accepts.
+	QueueSize int
+	// TTL is the time the finished jobs are kept for polling.
+	TTL time.Duration
+	// CallbackAttempts is the number of the attempts to post the finished job to its callback URL.
+	CallbackAttempts int
+	// CallbackBackoff is the delay before the second attempt, it doubles for every next one.
+	CallbackBackoff time.Duration
+	// CallbackWorkers is the number of the callbacks sent at once apart from the jobs running.
+	CallbackWorkers int
+	// CallbackKey signs the callbacks in the SignatureHeader, the callbacks aren't signed if it's empty.
+	CallbackKey []byte
+	// CallbackHosts are the hosts the callback URLs may point to, any public host is allowed if it's empty.
+	// The names are matched case-insensitively, the ones starting with "*." match the subdomains.
+	CallbackHosts []string
+	// AllowPrivateCallbacks turns off the rejection of the loopback, private and link-local callback addresses.
+	AllowPrivateCallbacks bool
+}
+
+// Queue runs the jobs by the bounded pool of workers and keeps them until they expire.
+// The finished jobs are posted to their callback URLs by the separate pool of workers, so the slow or failing
+// callbacks don't hold the jobs back. The jobs are kept in memory, so they are lost on restart.
+// It's safe for concurrent use.
+type Queue struct {
+	config     Config
+	tasks      chan queued
+	wg         sync.WaitGroup
+	callbacks  chan common.Job
+	callbackWG sync.WaitGroup
+	client     *http.Client
+	stopping   chan struct{}
+
+	mu      sync.Mutex
+	jobs    map[string]*common.Job
+	stopped bool
+}
+
+// queued represents the job waiting for a worker.
+type queued struct {
+	id   string
+	task Task
+}
+
+// NewQueue constructs a new Queue and starts its workers.
+func NewQueue(config Config) *Queue {
+	if config.Workers <= 0 {
+		config.Workers = DefaultWorkers
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = DefaultQueueSize
+	}
+	if config.TTL <= 0 {
+		config.TTL = DefaultTTL
+	}
+	if config.CallbackAttempts <= 0 {
+		config.CallbackAttempts = DefaultCallbackAttempts
+	}
+	if config.CallbackBackoff <= 0 {
+		config.CallbackBackoff = DefaultCallbackBackoff
+	}
+	if config.CallbackWorkers <= 0 {
+		config.CallbackWorkers = DefaultCallbackWorkers
+	}
+
+	q := &Queue{
+		config:    config,
+		tasks:     make(chan queued, config.QueueSize),
+		callbacks: make(chan common.Job, config.QueueSize),
+		stopping:  make(chan struct{}),
+		jobs:      map[string]*common.Job{},
+	}
+
+	// The addresses are checked after the host name is resolved, so the names resolving to the internal
+	// addresses are rejected too. The proxies and the redirects would bypass the checks, so they aren't used.
+	dialer := &net.Dialer{Timeout: callbackTimeout, Control: q.controlDial}
+	q.client = &http.Client{
+		Timeout:   callbackTimeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	q.wg.Add(config.Workers)
+	for i := 0; i < config.Workers; i++ {
+		go q.work()
+	}
+	q.callbackWG.Add(config.CallbackWorkers)
+	for i := 0; i < config.CallbackWorkers; i++ {
+		go q.sendCallbacks()
+	}
+
+	return q
+}
+
+// Submit enqueues the job running the task. The job id, status and creation time are assigned by the queue.
+// It returns ErrQueueFull if the queue has no room for the job.
+func (q *Queue) Submit(job common.Job, task Task) (submitted common.Job, err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.stopped {
+		err = ErrStopped
+		return
+	}
+
+	now := time.Now().UTC()
+	q.removeExpired(now)
+
+	job.ID = uuid.New().String()
+	job.Status = common.JobQueued
+	job.CreatedAt = now
+	job.StartedAt = nil
+	job.CompletedAt = nil
+	job.Response = nil
+
+	select {
+	case q.tasks <- queued{id: job.ID, task: task}:
+	default:
+		err = ErrQueueFull
+		return
+	}
+	q.jobs[job.ID] = &job
+	submitted = job
+
+	return
+}
+
+// Get retrieves the job with the specified id.
+func (q *Queue) Get(id string) (job common.Job, err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	stored, ok := q.jobs[id]
+	if !ok || q.expired(stored, time.Now()) {
+		err = ErrNotFound
+		return
+	}
+	job = *stored
+
+	return
+}
+
+// CheckCallbackURL validates the callback URL of the job. It must be the absolute http or https URL
+// of the allowed host which isn't the loopback, private or link-local address. The addresses the host name
+// resolves to are checked when the callback is sent.
+func (q *Queue) CheckCallbackURL(callbackURL string) error {
+	u, err := url.Parse(callbackURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Hostname()) == 0 {
+		return ErrInvalidCallbackURL
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if !q.allowedHost(host) {
+		return ErrCallbackHost
+	}
+	if ip := net.ParseIP(host); ip != nil && !q.allowedIP(ip) {
+		return ErrCallbackAddress
+	}
+
+	return nil
+}
+
+// allowedHost tells whether the callback host matches the configured ones.
+func (q *Queue) allowedHost(host string) bool {
+	if len(q.config.CallbackHosts) == 0 {
+		return true
+	}
+
+	for _, allowed := range q.config.CallbackHosts {
+		allowed = strings.ToLower(allowed)
+		if host == allowed || (strings.HasPrefix(allowed, "*.") && strings.HasSuffix(host, allowed[1:])) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allowedIP tells whether the callbacks may be sent to the address.
+func (q *Queue) allowedIP(ip net.IP) bool {
+	if q.config.AllowPrivateCallbacks {
+		return true
+	}
+
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified())
+}
+
+// controlDial rejects the connections of the callbacks to the addresses which aren't allowed.
+func (q *Queue) controlDial(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !q.allowedIP(ip) {
+		return fmt.Errorf("%s: %s", address, ErrCallbackAddress)
+	}
+
+	return nil
+}
+
+// Check tells whether the queue accepts the jobs.
+// It returns ErrStopped if the queue is stopped and ErrQueueFull if it has no room for the job.
+func (q *Queue) Check() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.stopped {
+		return ErrStopped
+	}
+	if len(q.tasks) == cap(q.tasks) {
+		return ErrQueueFull
+	}
+
+	return nil
+}
+
+// Stop stops accepting the jobs and waits for the accepted ones to finish and for their callbacks to be sent.
+// The callbacks failed aren't repeated any more. It returns the context error if the context is done before.
+func (q *Queue) Stop(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.stopped {
+		q.stopped = true
+		close(q.tasks)
+		close(q.stopping)
+		go func() {
+			q.wg.Wait()
+			close(q.callbacks)
+		}()
+	}
+	q.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		q.callbackWG.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// work runs the queued jobs until the queue is stopped.
+func (q *Queue) work() {
+	defer q.wg.Done()
+
+	for t := range q.tasks {
+		q.update(t.id, func(job *common.Job) {
+			now := time.Now().UTC()
+			job.Status = common.JobRunning
+			job.StartedAt = &now
+		})
+
+		response := t.task()
+
+		job := q.update(t.id, func(job *common.Job) {
+			now := time.Now().UTC()
+			job.Status = common.JobSucceeded
+			if len(response.Error) > 0 {
+				job.Status = common.JobFailed
+			}
+			job.CompletedAt = &now
+			job.Response = &response
+		})
+
+		if len(job.CallbackURL) > 0 {
+			select {
+			case q.callbacks <- job:
+			default:
+				log.Printf("Job %s callback to %s is dropped: callback queue is full\n", job.ID, job.CallbackURL)
+			}
+		}
+	}
+}
+
+// sendCallbacks posts the finished jobs to their callback URLs until the queue is stopped.
+func (q *Queue) sendCallbacks() {
+	defer q.callbackWG.Done()
+
+	for job := range q.callbacks {
+		q.notify(job)
+	}
+}
+
+// update modifies the stored job and returns its copy.
+func (q *Queue) update(id string, modify func(job *common.Job)) (job common.Job) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	stored := q.jobs[id]
+	modify(stored)
+
+	return *stored
+}
+
+// notify posts the finished job to its callback URL. The failed attempts are repeated with the growing delay
+// until the queue is stopped.
+func (q *Queue) notify(job common.Job) {
+	body, err := json.Marshal(job)
+	if err != nil {
+		log.Printf("Job %s callback: %s\n", job.ID, err)
+		return
+	}
+
+	backoff := q.config.CallbackBackoff
+	attempt := 1
+	for ; ; attempt++ {
+		err = q.post(job.CallbackURL, body)
+		if err == nil {
+			return
+		}
+		if attempt == q.config.CallbackAttempts {
+			break
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-q.stopping:
+			timer.Stop()
+			log.Printf("Job %s callback to %s is abandoned on stop after %d attempts: %s\n", job.ID, job.CallbackURL, attempt, err)
+			return
+		}
+		backoff *= 2
+	}
+
+	log.Printf("Job %s callback to %s has failed after %d attempts: %s\n", job.ID, job.CallbackURL, attempt, err)
+}
+
+// post sends the job payload to the callback URL signing it if the callback key is set.
+// Any response status other than 2xx is the failure.
+func (q *Queue) post(url string, body []byte) error {
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if len(q.config.CallbackKey) > 0 {
+		timestamp := time.Now().Unix()
+		request.Header.Set(SignatureHeader, "t="+strconv.FormatInt(timestamp, 10)+",v1="+Signature(q.config.CallbackKey, timestamp, body))
+	}
+
+	response, err := q.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	io.Copy(ioutil.Discard, response.Body)
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("http error %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// Signature computes the hex-encoded HMAC-SHA256 of the callback body sent at the unix time specified.
+// The signed message is the decimal timestamp, a dot and the body, so the receivers may reject the stale callbacks.
+func Signature(key []byte, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// removeExpired drops the expired jobs. The caller must hold the lock.
+func (q *Queue) removeExpired(now time.Time) {
+	for id, job := range q.jobs {
+		if q.expired(job, now) {
+			delete(q.jobs, id)
+		}
+	}
+}
+
+// expired tells whether the job has finished longer than the TTL ago.
+func (q *Queue) expired(job *common.Job, now time.Time) bool {
+	return job.CompletedAt != nil && now.Sub(*job.CompletedAt) > q.config.TTL
+}
@@ -0,0 +1,298 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"modulus/kyc/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// waitJob polls the job until it finishes.
+func waitJob(t *testing.T, q *Queue, id string) (job common.Job) {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := q.Get(id)
+		require.NoError(t, err)
+		if job.CompletedAt != nil {
+			return job
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("job %s hasn't finished in time", id)
+
+	return
+}
+
+func TestQueue(t *testing.T) {
+	assert := assert.New(t)
+
+	q := NewQueue(Config{Workers: 2})
+	defer q.Stop(context.Background())
+
+	release := make(chan struct{})
+	job, err := q.Submit(common.Job{Provider: common.Example, Instance: "eu"}, func() common.KYCResponse {
+		<-release
+		return common.KYCResponse{Result: &common.Result{Status: "Approved"}}
+	})
+
+	require.NoError(t, err)
+	assert.NotEmpty(job.ID)
+	assert.Equal(common.JobQueued, job.Status)
+	assert.Equal(common.Example, job.Provider)
+	assert.Equal("eu", job.Instance)
+	assert.False(job.CreatedAt.IsZero())
+	assert.Nil(job.Response)
+
+	close(release)
+	job = waitJob(t, q, job.ID)
+
+	assert.Equal(common.JobSucceeded, job.Status)
+	assert.NotNil(job.StartedAt)
+	if assert.NotNil(job.Response) {
+		assert.Equal("Approved", job.Response.Result.Status)
+	}
+
+	job, err = q.Submit(common.Job{Provider: common.Example}, func() common.KYCResponse {
+		return common.KYCResponse{Error: "provider failed"}
+	})
+
+	require.NoError(t, err)
+	job = waitJob(t, q, job.ID)
+
+	assert.Equal(common.JobFailed, job.Status)
+	assert.Equal("provider failed", job.Response.Error)
+
+	_, err = q.Get("unknown")
+	assert.Equal(ErrNotFound, err)
+}
+
+func TestQueueFull(t *testing.T) {
+	assert := assert.New(t)
+
+	q := NewQueue(Config{Workers: 1, QueueSize: 1})
+
+	release := make(chan struct{})
+	task := func() common.KYCResponse {
+		<-release
+		return common.KYCResponse{}
+	}
+
+	running, err := q.Submit(common.Job{}, task)
+	require.NoError(t, err)
+
+	// Wait for the worker to take the first job so the next one stays in the queue.
+	for {
+		job, _ := q.Get(running.ID)
+		if job.Status == common.JobRunning {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	assert.NoError(q.Check())
+
+	_, err = q.Submit(common.Job{}, task)
+	assert.NoError(err)
+
+	_, err = q.Submit(common.Job{}, task)
+	assert.Equal(ErrQueueFull, err)
+	assert.Equal(ErrQueueFull, q.Check())
+
+	close(release)
+	assert.NoError(q.Stop(context.Background()))
+
+	_, err = q.Submit(common.Job{}, task)
+	assert.Equal(ErrStopped, err)
+	assert.Equal(ErrStopped, q.Check())
+}
+
+func TestQueueStopTimeout(t *testing.T) {
+	q := NewQueue(Config{Workers: 1})
+
+	release := make(chan struct{})
+	defer close(release)
+
+	_, err := q.Submit(common.Job{}, func() common.KYCResponse {
+		<-release
+		return common.KYCResponse{}
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.Equal(t, context.DeadlineExceeded, q.Stop(ctx))
+}
+
+func TestQueueExpiry(t *testing.T) {
+	assert := assert.New(t)
+
+	q := NewQueue(Config{TTL: 50 * time.Millisecond})
+	defer q.Stop(context.Background())
+
+	job, err := q.Submit(common.Job{}, func() common.KYCResponse { return common.KYCResponse{} })
+	require.NoError(t, err)
+
+	waitJob(t, q, job.ID)
+	time.Sleep(100 * time.Millisecond)
+
+	_, err = q.Get(job.ID)
+	assert.Equal(ErrNotFound, err)
+}
+
+func TestQueueCallback(t *testing.T) {
+	assert := assert.New(t)
+
+	key := []byte("callback key")
+
+	var attempts int32
+	received := make(chan common.Job, 1)
+	signatures := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		job := common.Job{}
+		json.Unmarshal(body, &job)
+
+		var timestamp int64
+		var signature string
+		fmt.Sscanf(strings.Replace(r.Header.Get(SignatureHeader), ",", " ", 1), "t=%d v1=%s", &timestamp, &signature)
+		if signature == Signature(key, timestamp, body) {
+			signatures <- signature
+		}
+
+		received <- job
+	}))
+	defer server.Close()
+
+	q := NewQueue(Config{CallbackBackoff: time.Millisecond, CallbackKey: key, AllowPrivateCallbacks: true})
+	defer q.Stop(context.Background())
+
+	job, err := q.Submit(common.Job{Provider: common.Example, CallbackURL: server.URL}, func() common.KYCResponse {
+		return common.KYCResponse{Result: &common.Result{Status: "Denied"}}
+	})
+	require.NoError(t, err)
+
+	select {
+	case callback := <-received:
+		assert.Equal(job.ID, callback.ID)
+		assert.Equal(common.JobSucceeded, callback.Status)
+		if assert.NotNil(callback.Response) {
+			assert.Equal("Denied", callback.Response.Result.Status)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("callback hasn't been received")
+	}
+
+	assert.Equal(int32(2), atomic.LoadInt32(&attempts))
+
+	select {
+	case signature := <-signatures:
+		assert.Len(signature, 64)
+	default:
+		t.Error("callback signature isn't valid")
+	}
+}
+
+func TestQueueSlowCallback(t *testing.T) {
+	assert := assert.New(t)
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+
+	q := NewQueue(Config{Workers: 1, CallbackWorkers: 1, AllowPrivateCallbacks: true})
+	defer q.Stop(context.Background())
+	defer close(release)
+
+	task := func() common.KYCResponse {
+		return common.KYCResponse{}
+	}
+
+	// The callback of the first job hangs, the worker still takes the next job.
+	_, err := q.Submit(common.Job{CallbackURL: server.URL}, task)
+	require.NoError(t, err)
+
+	job, err := q.Submit(common.Job{}, task)
+	require.NoError(t, err)
+
+	job = waitJob(t, q, job.ID)
+
+	assert.Equal(common.JobSucceeded, job.Status)
+}
+
+func TestQueueCheckCallbackURL(t *testing.T) {
+	assert := assert.New(t)
+
+	q := NewQueue(Config{})
+	defer q.Stop(context.Background())
+
+	assert.NoError(q.CheckCallbackURL("https://hooks.example.com/kyc"))
+	assert.Equal(ErrInvalidCallbackURL, q.CheckCallbackURL("ftp://example.com"))
+	assert.Equal(ErrInvalidCallbackURL, q.CheckCallbackURL("/kyc"))
+	assert.Equal(ErrCallbackAddress, q.CheckCallbackURL("http://127.0.0.1:8080/kyc"))
+	assert.Equal(ErrCallbackAddress, q.CheckCallbackURL("http://10.0.0.5/kyc"))
+	assert.Equal(ErrCallbackAddress, q.CheckCallbackURL("http://169.254.169.254/latest/meta-data"))
+	assert.Equal(ErrCallbackAddress, q.CheckCallbackURL("http://[::1]/kyc"))
+
+	q = NewQueue(Config{CallbackHosts: []string{"hooks.example.com", "*.example.org"}})
+	defer q.Stop(context.Background())
+
+	assert.NoError(q.CheckCallbackURL("https://HOOKS.example.com/kyc"))
+	assert.NoError(q.CheckCallbackURL("https://kyc.example.org/kyc"))
+	assert.Equal(ErrCallbackHost, q.CheckCallbackURL("https://example.org/kyc"))
+	assert.Equal(ErrCallbackHost, q.CheckCallbackURL("https://evil.com/kyc"))
+}
+
+func TestQueueCallbackPrivateAddress(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+	}))
+	defer server.Close()
+
+	q := NewQueue(Config{})
+	defer q.Stop(context.Background())
+
+	// The host name passes the submission check, its address is rejected when the callback is sent.
+	callbackURL := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+
+	assert.NoError(q.CheckCallbackURL(callbackURL))
+
+	err := q.post(callbackURL, []byte("{}"))
+
+	if assert.Error(err) {
+		assert.Contains(err.Error(), ErrCallbackAddress.Error())
+	}
+	assert.Equal(int32(0), atomic.LoadInt32(&requests))
+}
+
+func TestSignature(t *testing.T) {
+	assert := assert.New(t)
+
+	signature := Signature([]byte("key"), 1700000000, []byte(`{"ID":"1"}`))
+
+	assert.Len(signature, 64)
+	assert.Equal(signature, Signature([]byte("key"), 1700000000, []byte(`{"ID":"1"}`)))
+	assert.NotEqual(signature, Signature([]byte("key"), 1700000001, []byte(`{"ID":"1"}`)))
+	assert.NotEqual(signature, Signature([]byte("other"), 1700000000, []byte(`{"ID":"1"}`)))
+}
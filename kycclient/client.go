@@ -0,0 +1,533 @@
+package kycclient
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"modulus/kyc/batch"
+	"modulus/kyc/common"
+	"modulus/kyc/integrations/ciphertrace"
+	"modulus/kyc/openapi"
+	"modulus/kyc/ownership"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultTimeout is the timeout of the requests sent by the client created without the HTTP client specified.
+const DefaultTimeout = 5 * time.Minute
+
+// Config holds the settings of the client.
+type Config struct {
+	// BaseURL is the URL of the KYC service, e.g. "http://kyc:8080".
+	BaseURL string
+	// AdminToken is the token authorizing the requests to the admin API.
+	AdminToken string
+	// AccessToken is the token granting the document vault scopes. It authorizes the document requests
+	// and the customer profiles carrying the document files.
+	AccessToken string
+	// HTTPClient sends the requests, the client with DefaultTimeout is used if it's nil.
+	HTTPClient *http.Client
+}
+
+// Client is the client of the KYC service API.
+type Client struct {
+	baseURL     string
+	adminToken  string
+	accessToken string
+	httpClient  *http.Client
+}
+
+// Error represents the error response of the service.
+type Error struct {
+	StatusCode int
+	Message    string
+	// Problem holds the problem details returned by the v2 API.
+	Problem *common.Problem
+}
+
+// Error implements the error interface for the Error.
+func (e *Error) Error() string {
+	return fmt.Sprintf("kyc service: http error %d: %s", e.StatusCode, e.Message)
+}
+
+// Retryable tells whether the same request might succeed if repeated later.
+func (e *Error) Retryable() bool {
+	if e.Problem != nil {
+		return e.Problem.Retryable
+	}
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode == http.StatusServiceUnavailable
+}
+
+// idempotencyKey is the context key of the idempotency key of the requests.
+type idempotencyKey struct{}
+
+// WithIdempotencyKey returns the context making the POST requests sent with it idempotent.
+// The service replays the response to the first request for the repeats with the same key and body,
+// so the retries of the verifications aren't billed by the providers twice.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+// New constructs a new client of the KYC service.
+func New(config Config) *Client {
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: DefaultTimeout}
+	}
+
+	return &Client{
+		baseURL:     strings.TrimSuffix(config.BaseURL, "/"),
+		adminToken:  config.AdminToken,
+		accessToken: config.AccessToken,
+		httpClient:  httpClient,
+	}
+}
+
+// Ping checks the service responds.
+func (c *Client) Ping(ctx context.Context) error {
+	return c.callText(ctx, "/Ping")
+}
+
+// Healthz sends the liveness probe.
+func (c *Client) Healthz(ctx context.Context) error {
+	return c.callText(ctx, "/healthz")
+}
+
+// Readyz sends the readiness probe. The returned error contains the reason the service isn't ready.
+func (c *Client) Readyz(ctx context.Context) error {
+	return c.callText(ctx, "/readyz")
+}
+
+// OpenAPI retrieves the OpenAPI document of the service API.
+func (c *Client) OpenAPI(ctx context.Context) (doc *openapi.Document, err error) {
+	doc = &openapi.Document{}
+	err = c.call(ctx, http.MethodGet, "/openapi.json", nil, "", doc)
+	return
+}
+
+// CheckCustomer verifies the customer by the KYC provider.
+// The error returned by the provider is reported in the Error of the response.
+func (c *Client) CheckCustomer(ctx context.Context, req common.CheckCustomerRequest) (resp common.KYCResponse, err error) {
+	err = c.call(ctx, http.MethodPost, "/CheckCustomer", req, "", &resp)
+	return
+}
+
+// CheckStatus checks the status of the verification.
+// The error returned by the provider is reported in the Error of the response.
+func (c *Client) CheckStatus(ctx context.Context, req common.CheckStatusRequest) (resp common.KYCResponse, err error) {
+	err = c.call(ctx, http.MethodPost, "/CheckStatus", req, "", &resp)
+	return
+}
+
+// SubmitJob starts the asynchronous verification of the customer.
+// The returned job is queued, its status is polled by GetJob.
+func (c *Client) SubmitJob(ctx context.Context, req common.JobRequest) (job common.Job, err error) {
+	err = c.call(ctx, http.MethodPost, "/jobs", req, "", &job, http.StatusAccepted)
+	return
+}
+
+// GetJob retrieves the asynchronous job. The verification response is present once the job has finished.
+func (c *Client) GetJob(ctx context.Context, id string) (job common.Job, err error) {
+	err = c.call(ctx, http.MethodGet, "/jobs/"+url.PathEscape(id), nil, "", &job)
+	return
+}
+
+// CreateBatch starts the bulk verification of the customers read from the CSV or NDJSON data by the provider instance.
+// The zero options leave the processing defaults of the service. The returned batch is running,
+// its state is polled by GetBatch.
+func (c *Client) CreateBatch(ctx context.Context, provider common.KYCProvider, instance string, format batch.Format, data io.Reader, opts batch.Options) (b common.Batch, err error) {
+	query := url.Values{}
+	query.Set("provider", string(provider))
+	if len(instance) > 0 {
+		query.Set("instance", instance)
+	}
+	if opts.Concurrency > 0 {
+		query.Set("concurrency", strconv.Itoa(opts.Concurrency))
+	}
+	if opts.Rate > 0 {
+		query.Set("rate", strconv.FormatFloat(opts.Rate, 'f', -1, 64))
+	}
+	path := "/batches?" + query.Encode()
+
+	resp, err := c.sendRaw(ctx, http.MethodPost, path, data, format.ContentType(), "")
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		err = responseError(resp)
+		return
+	}
+	err = errors.Wrap(json.NewDecoder(resp.Body).Decode(&b), "during decoding response of POST /batches")
+
+	return
+}
+
+// GetBatch retrieves the state of the batch.
+func (c *Client) GetBatch(ctx context.Context, id string) (b common.Batch, err error) {
+	err = c.call(ctx, http.MethodGet, "/batches/"+url.PathEscape(id), nil, "", &b)
+	return
+}
+
+// BatchResults downloads the results of the batch in the format specified writing them to w.
+// The rows not verified yet are reported with their current status.
+func (c *Client) BatchResults(ctx context.Context, id string, format batch.Format, w io.Writer) error {
+	resp, err := c.send(ctx, http.MethodGet, "/batches/"+url.PathEscape(id)+"/results?format="+url.QueryEscape(string(format)), nil, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return responseError(resp)
+	}
+	_, err = io.Copy(w, resp.Body)
+
+	return errors.Wrap(err, "during reading batch results")
+}
+
+// UploadDocument stores the document file in the document vault for the retention time specified,
+// the zero retention leaves the default one of the service. The document stored before with the same
+// content is returned if there is one. The request is authorized by the AccessToken.
+func (c *Client) UploadDocument(ctx context.Context, file common.DocumentFile, retention time.Duration) (doc common.StoredDocument, err error) {
+	query := url.Values{}
+	if len(file.Filename) > 0 {
+		query.Set("filename", file.Filename)
+	}
+	if retention > 0 {
+		query.Set("retention", retention.String())
+	}
+	path := "/documents"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	contentType := file.ContentType
+	if len(contentType) == 0 {
+		contentType = "application/octet-stream"
+	}
+
+	resp, err := c.sendRaw(ctx, http.MethodPost, path, bytes.NewReader(file.Data), contentType, c.accessToken)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		err = responseError(resp)
+		return
+	}
+	err = errors.Wrap(json.NewDecoder(resp.Body).Decode(&doc), "during decoding response of POST /documents")
+
+	return
+}
+
+// GetDocument downloads the document file from the document vault. The request is authorized by the AccessToken.
+func (c *Client) GetDocument(ctx context.Context, id string) (file common.DocumentFile, err error) {
+	resp, err := c.sendRaw(ctx, http.MethodGet, "/documents/"+url.PathEscape(id), nil, "", c.accessToken)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err = responseError(resp)
+		return
+	}
+
+	if file.Data, err = ioutil.ReadAll(resp.Body); err != nil {
+		err = errors.Wrap(err, "during reading document")
+		return
+	}
+	file.ContentType = resp.Header.Get("Content-Type")
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil {
+		file.Filename = params["filename"]
+	}
+	file.DocumentID = id
+
+	return
+}
+
+// CreateCustomer creates the customer profile from the customer data.
+// The document files carrying the data are moved to the document vault, they are authorized by the AccessToken.
+func (c *Client) CreateCustomer(ctx context.Context, userData *common.UserData) (customer common.Customer, err error) {
+	err = c.call(ctx, http.MethodPost, "/customers", userData, c.accessToken, &customer, http.StatusCreated)
+	return
+}
+
+// GetCustomer retrieves the customer with the verification history and the overall KYC status.
+// If refresh is true the status of the latest unclear verifications is checked first.
+func (c *Client) GetCustomer(ctx context.Context, id string, refresh bool) (customer common.Customer, err error) {
+	path := "/customers/" + url.PathEscape(id)
+	if refresh {
+		path += "?refresh=true"
+	}
+	err = c.call(ctx, http.MethodGet, path, nil, "", &customer)
+	return
+}
+
+// UpdateCustomer replaces the customer data keeping the verifications made.
+// The document files carrying the data are authorized by the AccessToken.
+func (c *Client) UpdateCustomer(ctx context.Context, id string, userData *common.UserData) (customer common.Customer, err error) {
+	err = c.call(ctx, http.MethodPut, "/customers/"+url.PathEscape(id), userData, c.accessToken, &customer)
+	return
+}
+
+// VerifyCustomer verifies the stored customer by the KYC provider and returns the customer with the new verification.
+// The error returned by the provider is reported in the Error of the verification.
+func (c *Client) VerifyCustomer(ctx context.Context, id string, req common.CustomerVerificationRequest) (customer common.Customer, err error) {
+	err = c.call(ctx, http.MethodPost, "/customers/"+url.PathEscape(id)+"/verifications", req, "", &customer, http.StatusCreated)
+	return
+}
+
+// Providers retrieves the list of the implemented KYC providers.
+func (c *Client) Providers(ctx context.Context) (providers []common.KYCProvider, err error) {
+	err = c.call(ctx, http.MethodGet, "/Provider", nil, "", &providers)
+	return
+}
+
+// IsProviderImplemented checks whether the KYC provider is implemented.
+func (c *Client) IsProviderImplemented(ctx context.Context, provider common.KYCProvider) (implemented bool, err error) {
+	resp := common.ProviderImplementedResponse{}
+	err = c.call(ctx, http.MethodGet, "/Provider?name="+url.QueryEscape(string(provider)), nil, "", &resp)
+	implemented = resp.Implemented
+	return
+}
+
+// CipherTraceCheck checks the risk of the transaction by CipherTrace.
+func (c *Client) CipherTraceCheck(ctx context.Context, req common.CipherTraceRequest) (risk *ciphertrace.AddressRisk, err error) {
+	risk = &ciphertrace.AddressRisk{}
+	err = c.call(ctx, http.MethodPost, "/cipherTrace", req, "", risk)
+	if err != nil {
+		risk = nil
+	}
+	return
+}
+
+// WalletAddresses streams the addresses of the CipherTrace wallet calling fn for every address.
+// The streaming stops if fn returns an error, the error is returned then.
+func (c *Client) WalletAddresses(ctx context.Context, walletID string, opts ciphertrace.StreamOptions, fn func(address string) error) error {
+	query := url.Values{}
+	if opts.PageSize > 0 {
+		query.Set("pageSize", strconv.Itoa(opts.PageSize))
+	}
+	if opts.Concurrency > 0 {
+		query.Set("concurrency", strconv.Itoa(opts.Concurrency))
+	}
+	path := "/crypto/wallet/" + url.PathEscape(walletID) + "/addresses"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	resp, err := c.send(ctx, http.MethodGet, path, nil, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return responseError(resp)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		line := struct {
+			common.WalletAddress
+			common.ErrorResponse
+		}{}
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			return errors.Wrap(err, "during decoding wallet addresses")
+		}
+		if len(line.Error) > 0 {
+			return &Error{StatusCode: resp.StatusCode, Message: line.Error}
+		}
+		if err := fn(line.Address); err != nil {
+			return err
+		}
+	}
+
+	return errors.Wrap(scanner.Err(), "during reading wallet addresses")
+}
+
+// IssueOwnershipChallenge issues the challenge for proving the wallet ownership.
+func (c *Client) IssueOwnershipChallenge(ctx context.Context, req common.OwnershipChallengeRequest) (challenge ownership.Challenge, err error) {
+	err = c.call(ctx, http.MethodPost, "/crypto/ownership/challenge", req, "", &challenge)
+	return
+}
+
+// VerifyOwnershipProof verifies the signed wallet ownership challenge.
+// The invalid signature is reported as the Error with the 422 status code, the challenge might be signed again then.
+func (c *Client) VerifyOwnershipProof(ctx context.Context, req common.OwnershipProofRequest) (resp common.OwnershipProofResponse, err error) {
+	err = c.call(ctx, http.MethodPost, "/crypto/ownership/verify", req, "", &resp)
+	return
+}
+
+// CreateVerification starts the verification of the customer.
+// The ID of the returned verification is present if its status might be checked later.
+func (c *Client) CreateVerification(ctx context.Context, req common.VerificationRequest) (verification common.Verification, err error) {
+	err = c.call(ctx, http.MethodPost, "/v2/verifications", req, "", &verification, http.StatusOK, http.StatusCreated)
+	return
+}
+
+// GetVerification checks the status of the verification.
+func (c *Client) GetVerification(ctx context.Context, id string) (verification common.Verification, err error) {
+	err = c.call(ctx, http.MethodGet, "/v2/verifications/"+url.PathEscape(id), nil, "", &verification)
+	return
+}
+
+// ListProviders retrieves the implemented KYC providers.
+func (c *Client) ListProviders(ctx context.Context) (providers []common.ProviderResource, err error) {
+	resp := common.ProviderListResponse{}
+	err = c.call(ctx, http.MethodGet, "/v2/providers", nil, "", &resp)
+	providers = resp.Providers
+	return
+}
+
+// GetProvider retrieves the KYC provider.
+func (c *Client) GetProvider(ctx context.Context, name common.KYCProvider) (provider common.ProviderResource, err error) {
+	err = c.call(ctx, http.MethodGet, "/v2/providers/"+url.PathEscape(string(name)), nil, "", &provider)
+	return
+}
+
+// ReloadConfig reloads the config of the service from its file.
+// If the new config is invalid the response describes the config staying active and the error is returned.
+func (c *Client) ReloadConfig(ctx context.Context) (resp common.ConfigResponse, err error) {
+	err = c.call(ctx, http.MethodPost, "/admin/reload", nil, c.adminToken, &resp, http.StatusOK, http.StatusUnprocessableEntity)
+	if err == nil && len(resp.Error) > 0 {
+		err = &Error{StatusCode: http.StatusUnprocessableEntity, Message: resp.Error}
+	}
+	return
+}
+
+// ProvidersHealth probes the credentials of the configured KYC providers.
+// Unhealthy providers aren't reported as the error, the statuses of the providers must be checked instead.
+func (c *Client) ProvidersHealth(ctx context.Context) (resp common.ProvidersHealthResponse, err error) {
+	err = c.call(ctx, http.MethodGet, "/admin/providers/health", nil, c.adminToken, &resp, http.StatusOK, http.StatusServiceUnavailable)
+	return
+}
+
+// call sends the request and decodes the JSON response into out.
+// The response with the status other than the expected ones (200 by default) is returned as the Error.
+func (c *Client) call(ctx context.Context, method, path string, body interface{}, token string, out interface{}, statuses ...int) error {
+	resp, err := c.send(ctx, method, path, body, token)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if len(statuses) == 0 {
+		statuses = []int{http.StatusOK}
+	}
+	for _, status := range statuses {
+		if resp.StatusCode == status {
+			return errors.Wrapf(json.NewDecoder(resp.Body).Decode(out), "during decoding response of %s %s", method, path)
+		}
+	}
+
+	return responseError(resp)
+}
+
+// callText sends the GET request to the endpoint responding with the plain text.
+func (c *Client) callText(ctx context.Context, path string) error {
+	resp, err := c.send(ctx, http.MethodGet, path, nil, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return responseError(resp)
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+
+	return nil
+}
+
+// send sends the request with the body encoded as JSON.
+func (c *Client) send(ctx context.Context, method, path string, body interface{}, token string) (resp *http.Response, err error) {
+	var (
+		reader      io.Reader
+		contentType string
+	)
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, errors.Wrap(err, "during encoding request")
+		}
+		reader, contentType = bytes.NewReader(payload), "application/json"
+	}
+
+	return c.sendRaw(ctx, method, path, reader, contentType, token)
+}
+
+// sendRaw sends the request with the body of the content type specified.
+// The token is sent as the bearer one if it isn't empty.
+func (c *Client) sendRaw(ctx context.Context, method, path string, body io.Reader, contentType, token string) (resp *http.Response, err error) {
+	req, err := http.NewRequest(method, c.baseURL+path, body)
+	if err != nil {
+		err = errors.Wrap(err, "during creating request")
+		return
+	}
+	req = req.WithContext(ctx)
+
+	if len(contentType) > 0 {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if len(token) > 0 {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if key, ok := ctx.Value(idempotencyKey{}).(string); ok && method == http.MethodPost {
+		req.Header.Set("Idempotency-Key", key)
+	}
+
+	resp, err = c.httpClient.Do(req)
+	if err != nil {
+		err = errors.Wrapf(err, "during sending %s %s", method, path)
+	}
+
+	return
+}
+
+// responseError forms the Error from the response with the problem details, the error payload or the plain text.
+func responseError(resp *http.Response) error {
+	body, _ := ioutil.ReadAll(resp.Body)
+
+	e := &Error{
+		StatusCode: resp.StatusCode,
+		Message:    strings.TrimSpace(string(body)),
+	}
+
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/problem+json") {
+		problem := &common.Problem{}
+		if json.Unmarshal(body, problem) == nil {
+			e.Problem = problem
+			e.Message = problem.Detail
+		}
+		return e
+	}
+
+	errResp := common.ErrorResponse{}
+	if json.Unmarshal(body, &errResp) == nil && len(errResp.Error) > 0 {
+		e.Message = errResp.Error
+	}
+	if len(e.Message) == 0 {
+		e.Message = http.StatusText(resp.StatusCode)
+	}
+
+	return e
+}
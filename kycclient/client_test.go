@@ -0,0 +1,469 @@
+package kycclient
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"modulus/kyc/batch"
+	"modulus/kyc/common"
+	"modulus/kyc/integrations/ciphertrace"
+	"modulus/kyc/main/config"
+	"modulus/kyc/main/handlers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestServer starts the server with the service handlers and the stubs of the handlers requiring a config.
+func newTestServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/Ping", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("Pong!"))
+	})
+	mux.HandleFunc("/healthz", handlers.Healthz)
+	mux.HandleFunc("/readyz", handlers.Readyz)
+	mux.HandleFunc("/openapi.json", handlers.OpenAPI)
+	mux.HandleFunc("/CheckCustomer", handlers.CheckCustomer)
+	mux.HandleFunc("/CheckStatus", handlers.CheckStatus)
+	mux.HandleFunc("/Provider", handlers.IsProviderImplemented)
+	mux.HandleFunc(handlers.JobsPath, handlers.Idempotent(handlers.SubmitJob))
+	mux.HandleFunc(handlers.JobsPath+"/", handlers.GetJob)
+	mux.HandleFunc(handlers.BatchesPath, handlers.CreateBatch)
+	mux.HandleFunc(handlers.BatchesPath+"/", handlers.GetBatch)
+	mux.HandleFunc(handlers.DocumentsPath, handlers.UploadDocument)
+	mux.HandleFunc(handlers.DocumentsPath+"/", handlers.GetDocument)
+	mux.HandleFunc(handlers.CustomersPath, handlers.CreateCustomer)
+	mux.HandleFunc(handlers.CustomersPath+"/", handlers.Customer)
+	mux.HandleFunc(handlers.V2Prefix, handlers.V2)
+	mux.HandleFunc("/crypto/wallet/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/crypto/wallet/w1/addresses" {
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"Error":"not found"}`)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		io.WriteString(w, `{"address":"a1"}`+"\n"+`{"address":"a2"}`+"\n")
+		if r.URL.Query().Get("pageSize") == "100" {
+			io.WriteString(w, `{"Error":"page failed"}`+"\n")
+		}
+	})
+	mux.HandleFunc("/admin/reload", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			io.WriteString(w, `{"Error":"invalid admin token"}`)
+			return
+		}
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		io.WriteString(w, `{"Version":"v1","Error":"bad config"}`)
+	})
+	mux.HandleFunc("/admin/providers/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		io.WriteString(w, `{"ConfigVersion":"v1","Providers":[{"Provider":"Coinfirm","Status":"Unreachable"}]}`)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestClientV1(t *testing.T) {
+	assert := assert.New(t)
+
+	server := newTestServer()
+	defer server.Close()
+
+	client := New(Config{BaseURL: server.URL + "/"})
+	ctx := context.Background()
+
+	assert.NoError(client.Ping(ctx))
+	assert.NoError(client.Healthz(ctx))
+
+	resp, err := client.CheckCustomer(ctx, common.CheckCustomerRequest{
+		Provider: common.Example,
+		UserData: &common.UserData{FirstName: "Abby"},
+	})
+
+	assert.NoError(err)
+	if assert.NotNil(resp.Result) {
+		assert.Equal("Approved", resp.Result.Status)
+	}
+
+	resp, err = client.CheckCustomer(ctx, common.CheckCustomerRequest{
+		Provider: common.Example,
+		UserData: &common.UserData{FirstName: "Erika"},
+	})
+
+	assert.NoError(err)
+	assert.Equal(common.RateLimitedError, resp.ErrorCategory)
+	assert.True(resp.Retryable)
+
+	_, err = client.CheckStatus(ctx, common.CheckStatusRequest{Provider: "Foo", ReferenceID: "x"})
+
+	if assert.IsType(&Error{}, err) {
+		assert.Equal(http.StatusNotFound, err.(*Error).StatusCode)
+		assert.Equal("unknown KYC provider in the request: Foo", err.(*Error).Message)
+		assert.Nil(err.(*Error).Problem)
+	}
+
+	providers, err := client.Providers(ctx)
+
+	assert.NoError(err)
+	assert.Len(providers, len(common.KYCProviders))
+
+	implemented, err := client.IsProviderImplemented(ctx, common.Example)
+
+	assert.NoError(err)
+	assert.True(implemented)
+
+	implemented, err = client.IsProviderImplemented(ctx, "Foo")
+
+	assert.NoError(err)
+	assert.False(implemented)
+}
+
+func TestClientJobs(t *testing.T) {
+	assert := assert.New(t)
+
+	server := newTestServer()
+	defer server.Close()
+
+	client := New(Config{BaseURL: server.URL})
+	ctx := context.Background()
+
+	job, err := client.SubmitJob(ctx, common.JobRequest{
+		CheckCustomerRequest: common.CheckCustomerRequest{
+			Provider: common.Example,
+			UserData: &common.UserData{FirstName: "Abby"},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.NotEmpty(job.ID)
+	assert.Equal(common.Example, job.Provider)
+
+	for job.Response == nil {
+		time.Sleep(10 * time.Millisecond)
+		job, err = client.GetJob(ctx, job.ID)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(common.JobSucceeded, job.Status)
+	if assert.NotNil(job.Response.Result) {
+		assert.Equal("Approved", job.Response.Result.Status)
+	}
+
+	_, err = client.GetJob(ctx, "unknown")
+
+	if assert.IsType(&Error{}, err) {
+		assert.Equal(http.StatusNotFound, err.(*Error).StatusCode)
+		assert.Equal("job not found", err.(*Error).Message)
+	}
+
+	// Testing the retries with the idempotency key.
+	req := common.JobRequest{
+		CheckCustomerRequest: common.CheckCustomerRequest{
+			Provider: common.Example,
+			UserData: &common.UserData{FirstName: "Urbi"},
+		},
+	}
+	idempotent := WithIdempotencyKey(ctx, "client-jobs-test")
+
+	first, err := client.SubmitJob(idempotent, req)
+	require.NoError(t, err)
+
+	retry, err := client.SubmitJob(idempotent, req)
+	require.NoError(t, err)
+	assert.Equal(first.ID, retry.ID)
+
+	other, err := client.SubmitJob(ctx, req)
+	require.NoError(t, err)
+	assert.NotEqual(first.ID, other.ID)
+}
+
+func TestClientBatches(t *testing.T) {
+	assert := assert.New(t)
+
+	server := newTestServer()
+	defer server.Close()
+
+	client := New(Config{BaseURL: server.URL})
+	ctx := context.Background()
+
+	b, err := client.CreateBatch(ctx, common.Example, "", batch.CSV, strings.NewReader("FirstName\nAbby\nDelilah\n"), batch.Options{Concurrency: 2})
+
+	require.NoError(t, err)
+	assert.NotEmpty(b.ID)
+	assert.Equal(2, b.Total)
+
+	for b.Status == common.BatchRunning {
+		time.Sleep(10 * time.Millisecond)
+		b, err = client.GetBatch(ctx, b.ID)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(common.BatchCompleted, b.Status)
+	assert.Equal(2, b.Succeeded)
+
+	results := &bytes.Buffer{}
+	err = client.BatchResults(ctx, b.ID, batch.NDJSON, results)
+
+	assert.NoError(err)
+	assert.Equal(2, strings.Count(results.String(), "\n"))
+	assert.Contains(results.String(), "Approved")
+	assert.Contains(results.String(), "Denied")
+
+	_, err = client.CreateBatch(ctx, common.Example, "", batch.CSV, strings.NewReader("FirstName\nAbby\n"), batch.Options{Concurrency: 1000})
+
+	if assert.IsType(&Error{}, err) {
+		assert.Equal(http.StatusBadRequest, err.(*Error).StatusCode)
+	}
+
+	_, err = client.GetBatch(ctx, "unknown")
+
+	if assert.IsType(&Error{}, err) {
+		assert.Equal(http.StatusNotFound, err.(*Error).StatusCode)
+	}
+}
+
+func TestClientDocuments(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "kyc-vault-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	previous := config.Current().Config
+	defer config.Set(previous)
+
+	cfg := previous.Clone()
+	cfg[config.VaultSection] = config.Options{
+		"Dir":         dir,
+		"Key":         strings.Repeat("ab", 32),
+		"ReadTokens":  "reader",
+		"WriteTokens": "reader",
+	}
+	config.Set(cfg)
+
+	server := newTestServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	file := common.DocumentFile{Filename: "passport.jpg", ContentType: "image/jpeg", Data: []byte("passport")}
+
+	_, err = New(Config{BaseURL: server.URL}).UploadDocument(ctx, file, 0)
+
+	if assert.IsType(&Error{}, err) {
+		assert.Equal(http.StatusUnauthorized, err.(*Error).StatusCode)
+	}
+
+	client := New(Config{BaseURL: server.URL, AccessToken: "reader"})
+
+	doc, err := client.UploadDocument(ctx, file, time.Hour)
+
+	require.NoError(t, err)
+	assert.NotEmpty(doc.ID)
+
+	// The same content is stored once.
+	again, err := client.UploadDocument(ctx, file, 0)
+
+	assert.NoError(err)
+	assert.Equal(doc.ID, again.ID)
+
+	downloaded, err := client.GetDocument(ctx, doc.ID)
+
+	assert.NoError(err)
+	assert.Equal(common.DocumentFile{Filename: "passport.jpg", ContentType: "image/jpeg", Data: []byte("passport"), DocumentID: doc.ID}, downloaded)
+
+	_, err = client.GetDocument(ctx, "unknown")
+
+	if assert.IsType(&Error{}, err) {
+		assert.Equal(http.StatusNotFound, err.(*Error).StatusCode)
+	}
+}
+
+func TestClientCustomers(t *testing.T) {
+	assert := assert.New(t)
+
+	server := newTestServer()
+	defer server.Close()
+
+	client := New(Config{BaseURL: server.URL})
+	ctx := context.Background()
+
+	customer, err := client.CreateCustomer(ctx, &common.UserData{FirstName: "Abby"})
+
+	require.NoError(t, err)
+	assert.NotEmpty(customer.ID)
+	assert.Equal(common.CustomerNotVerified, customer.Status)
+
+	customer, err = client.VerifyCustomer(ctx, customer.ID, common.CustomerVerificationRequest{Provider: common.Example})
+
+	assert.NoError(err)
+	assert.Equal(common.CustomerApproved, customer.Status)
+	assert.Len(customer.Verifications, 1)
+
+	customer, err = client.UpdateCustomer(ctx, customer.ID, &common.UserData{FirstName: "Jane"})
+
+	assert.NoError(err)
+	assert.Equal("Jane", customer.UserData.FirstName)
+
+	got, err := client.GetCustomer(ctx, customer.ID, true)
+
+	assert.NoError(err)
+	assert.Equal(customer.ID, got.ID)
+	assert.Len(got.Verifications, 1)
+
+	_, err = client.GetCustomer(ctx, "unknown", false)
+
+	if assert.IsType(&Error{}, err) {
+		assert.Equal(http.StatusNotFound, err.(*Error).StatusCode)
+		assert.Equal("customer not found", err.(*Error).Message)
+	}
+}
+
+func TestClientV2(t *testing.T) {
+	assert := assert.New(t)
+
+	server := newTestServer()
+	defer server.Close()
+
+	client := New(Config{BaseURL: server.URL})
+	ctx := context.Background()
+
+	verification, err := client.CreateVerification(ctx, common.VerificationRequest{
+		Provider: common.Example,
+		Customer: &common.CustomerData{FirstName: "Abby"},
+	})
+
+	assert.NoError(err)
+	assert.Equal("Approved", verification.Status)
+
+	verification, err = client.GetVerification(ctx, "RXhhbXBsZS9hZGE")
+
+	assert.NoError(err)
+	assert.Equal("RXhhbXBsZS9hZGE", verification.ID)
+
+	_, err = client.CreateVerification(ctx, common.VerificationRequest{
+		Provider: common.Example,
+		Customer: &common.CustomerData{FirstName: "Erika"},
+	})
+
+	if assert.IsType(&Error{}, err) {
+		e := err.(*Error)
+		assert.Equal(http.StatusTooManyRequests, e.StatusCode)
+		assert.Equal("during sending request: http error", e.Message)
+		if assert.NotNil(e.Problem) {
+			assert.Equal(common.ProblemRateLimited, e.Problem.Code)
+		}
+		assert.True(e.Retryable())
+	}
+
+	providers, err := client.ListProviders(ctx)
+
+	assert.NoError(err)
+	assert.Contains(providers, common.ProviderResource{Name: common.Jumio, Implemented: true})
+
+	provider, err := client.GetProvider(ctx, "Sum&Substance")
+
+	assert.NoError(err)
+	assert.Equal(common.ProviderResource{Name: common.SumSub, Implemented: true}, provider)
+
+	_, err = client.GetProvider(ctx, "Foo")
+
+	if assert.IsType(&Error{}, err) {
+		assert.Equal(common.ProblemUnknownProvider, err.(*Error).Problem.Code)
+	}
+}
+
+func TestClientOpenAPI(t *testing.T) {
+	assert := assert.New(t)
+
+	server := newTestServer()
+	defer server.Close()
+
+	client := New(Config{BaseURL: server.URL})
+
+	doc, err := client.OpenAPI(context.Background())
+
+	assert.NoError(err)
+	assert.Contains(doc.Components.Schemas, "UserData")
+
+	// The config isn't loaded in the tests, so the service isn't ready.
+	err = client.Readyz(context.Background())
+
+	if assert.IsType(&Error{}, err) {
+		assert.Equal(http.StatusServiceUnavailable, err.(*Error).StatusCode)
+		assert.Equal("config isn't loaded", err.(*Error).Message)
+	}
+}
+
+func TestClientWalletAddresses(t *testing.T) {
+	assert := assert.New(t)
+
+	server := newTestServer()
+	defer server.Close()
+
+	client := New(Config{BaseURL: server.URL})
+	ctx := context.Background()
+
+	addresses := []string{}
+	collect := func(address string) error {
+		addresses = append(addresses, address)
+		return nil
+	}
+
+	err := client.WalletAddresses(ctx, "w1", ciphertrace.StreamOptions{}, collect)
+
+	assert.NoError(err)
+	assert.Equal([]string{"a1", "a2"}, addresses)
+
+	// Testing the error in the middle of the stream.
+	addresses = nil
+	err = client.WalletAddresses(ctx, "w1", ciphertrace.StreamOptions{PageSize: 100}, collect)
+
+	assert.EqualError(err, "kyc service: http error 200: page failed")
+	assert.Equal([]string{"a1", "a2"}, addresses)
+
+	// Testing the error returned by the callback.
+	stop := errors.New("stop")
+	err = client.WalletAddresses(ctx, "w1", ciphertrace.StreamOptions{}, func(string) error { return stop })
+
+	assert.Equal(stop, err)
+
+	err = client.WalletAddresses(ctx, "w2", ciphertrace.StreamOptions{}, collect)
+
+	assert.EqualError(err, "kyc service: http error 404: not found")
+}
+
+func TestClientAdmin(t *testing.T) {
+	assert := assert.New(t)
+
+	server := newTestServer()
+	defer server.Close()
+
+	ctx := context.Background()
+
+	_, err := New(Config{BaseURL: server.URL}).ReloadConfig(ctx)
+
+	assert.EqualError(err, "kyc service: http error 401: invalid admin token")
+
+	client := New(Config{BaseURL: server.URL, AdminToken: "secret"})
+
+	resp, err := client.ReloadConfig(ctx)
+
+	assert.EqualError(err, "kyc service: http error 422: bad config")
+	assert.Equal("v1", resp.Version)
+
+	health, err := client.ProvidersHealth(ctx)
+
+	require.NoError(t, err)
+	assert.Equal("v1", health.ConfigVersion)
+	assert.Equal(common.KYCProvider("Coinfirm"), health.Providers[0].Provider)
+}
@@ -0,0 +1,649 @@
+package kycpb
+
+import (
+	"time"
+
+	"modulus/kyc/common"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UserDataToCommon converts the protobuf user data into the common model.
+func UserDataToCommon(u *UserData) *common.UserData {
+	if u == nil {
+		return nil
+	}
+
+	user := &common.UserData{
+		FirstName:                u.FirstName,
+		LastName:                 u.LastName,
+		MaternalLastName:         u.MaternalLastName,
+		MiddleName:               u.MiddleName,
+		FullName:                 u.FullName,
+		LegalName:                u.LegalName,
+		LatinISO1Name:            u.LatinIso1Name,
+		AccountName:              u.AccountName,
+		Email:                    u.Email,
+		IPaddress:                u.IpAddress,
+		Gender:                   common.Gender(u.Gender),
+		DateOfBirth:              timeToCommon(u.DateOfBirth),
+		PlaceOfBirth:             u.PlaceOfBirth,
+		CountryOfBirthAlpha2:     u.CountryOfBirthAlpha2,
+		StateOfBirth:             u.StateOfBirth,
+		CountryAlpha2:            u.CountryAlpha2,
+		Nationality:              u.Nationality,
+		Phone:                    u.Phone,
+		MobilePhone:              u.MobilePhone,
+		BankAccountNumber:        u.BankAccountNumber,
+		VehicleRegistrationPlate: u.VehicleRegistrationPlate,
+		PoliticallyExposed:       u.PoliticallyExposed,
+		SourceOfWealth:           fundsToCommon(u.SourceOfWealth),
+		SourceOfFunds:            fundsToCommon(u.SourceOfFunds),
+		CompanyName:              u.CompanyName,
+		Website:                  u.Website,
+	}
+
+	if u.CurrentAddress != nil {
+		user.CurrentAddress = addressToCommon(u.CurrentAddress)
+	}
+	for _, address := range u.SupplementalAddresses {
+		user.SupplementalAddresses = append(user.SupplementalAddresses, addressToCommon(address))
+	}
+	if u.Location != nil {
+		user.Location = &common.Location{
+			Latitude:  u.Location.Latitude,
+			Longitude: u.Location.Longitude,
+		}
+	}
+	if u.Business != nil {
+		user.Business = &common.Business{
+			Name:                      u.Business.Name,
+			RegistrationNumber:        u.Business.RegistrationNumber,
+			IncorporationDate:         timeToCommon(u.Business.IncorporationDate),
+			IncorporationJurisdiction: u.Business.IncorporationJurisdiction,
+		}
+	}
+	if p := u.Passport; p != nil {
+		user.Passport = &common.Passport{
+			Number:        p.Number,
+			Mrz1:          p.Mrz1,
+			Mrz2:          p.Mrz2,
+			CountryAlpha2: p.CountryAlpha2,
+			State:         p.State,
+			IssuedDate:    timeToCommon(p.IssuedDate),
+			ValidUntil:    timeToCommon(p.ValidUntil),
+			Image:         fileToCommon(p.Image),
+		}
+	}
+	if d := u.IdCard; d != nil {
+		user.IDCard = &common.IDCard{
+			Number:        d.Number,
+			CountryAlpha2: d.CountryAlpha2,
+			IssuedDate:    timeToCommon(d.IssuedDate),
+			ValidUntil:    timeToCommon(d.ValidUntil),
+			Image:         fileToCommon(d.Image),
+		}
+	}
+	if d := u.Snils; d != nil {
+		user.SNILS = &common.SNILS{
+			Number:     d.Number,
+			IssuedDate: timeToCommon(d.IssuedDate),
+			Image:      fileToCommon(d.Image),
+		}
+	}
+	if d := u.HealthId; d != nil {
+		user.HealthID = &common.HealthID{
+			Number: d.Number,
+			Image:  fileToCommon(d.Image),
+		}
+	}
+	if d := u.SocialServiceId; d != nil {
+		user.SocialServiceID = &common.SocialServiceID{
+			Number:     d.Number,
+			IssuedDate: timeToCommon(d.IssuedDate),
+			Image:      fileToCommon(d.Image),
+		}
+	}
+	if d := u.TaxId; d != nil {
+		user.TaxID = &common.TaxID{
+			Number: d.Number,
+			Image:  fileToCommon(d.Image),
+		}
+	}
+	if d := u.DriverLicense; d != nil {
+		user.DriverLicense = &common.DriverLicense{
+			Number:        d.Number,
+			Version:       d.Version,
+			CountryAlpha2: d.CountryAlpha2,
+			State:         d.State,
+			IssuedDate:    timeToCommon(d.IssuedDate),
+			ValidUntil:    timeToCommon(d.ValidUntil),
+			FrontImage:    fileToCommon(d.FrontImage),
+			BackImage:     fileToCommon(d.BackImage),
+		}
+	}
+	if d := u.DriverLicenseTranslation; d != nil {
+		user.DriverLicenseTranslation = &common.DriverLicenseTranslation{
+			Number:        d.Number,
+			CountryAlpha2: d.CountryAlpha2,
+			State:         d.State,
+			IssuedDate:    timeToCommon(d.IssuedDate),
+			ValidUntil:    timeToCommon(d.ValidUntil),
+			FrontImage:    fileToCommon(d.FrontImage),
+			BackImage:     fileToCommon(d.BackImage),
+		}
+	}
+	if d := u.CreditCard; d != nil {
+		user.CreditCard = &common.CreditCard{
+			Number:     d.Number,
+			ValidUntil: timeToCommon(d.ValidUntil),
+			Image:      fileToCommon(d.Image),
+		}
+	}
+	if d := u.DebitCard; d != nil {
+		user.DebitCard = &common.DebitCard{
+			Number:     d.Number,
+			ValidUntil: timeToCommon(d.ValidUntil),
+			Image:      fileToCommon(d.Image),
+		}
+	}
+	if d := u.UtilityBill; d != nil {
+		user.UtilityBill = &common.UtilityBill{
+			CountryAlpha2: d.CountryAlpha2,
+			Image:         fileToCommon(d.Image),
+		}
+	}
+	if d := u.ResidencePermit; d != nil {
+		user.ResidencePermit = &common.ResidencePermit{
+			CountryAlpha2: d.CountryAlpha2,
+			IssuedDate:    timeToCommon(d.IssuedDate),
+			ValidUntil:    timeToCommon(d.ValidUntil),
+			Image:         fileToCommon(d.Image),
+		}
+	}
+	if d := u.EmploymentCertificate; d != nil {
+		user.EmploymentCertificate = &common.EmploymentCertificate{
+			IssuedDate: timeToCommon(d.IssuedDate),
+			Image:      fileToCommon(d.Image),
+		}
+	}
+	if d := u.Other; d != nil {
+		user.Other = &common.Other{
+			Number:        d.Number,
+			CountryAlpha2: d.CountryAlpha2,
+			State:         d.State,
+			IssuedDate:    timeToCommon(d.IssuedDate),
+			ValidUntil:    timeToCommon(d.ValidUntil),
+			Image:         fileToCommon(d.Image),
+		}
+	}
+	if d := u.Document; d != nil {
+		user.Document = &common.Document{
+			Type:          common.DocumentType(d.Type),
+			Number:        d.Number,
+			CountryAlpha2: d.CountryAlpha2,
+			IssuedDate:    timeToCommon(d.IssuedDate),
+			ValidUntil:    timeToCommon(d.ValidUntil),
+			Image:         fileToCommon(d.Image),
+		}
+	}
+	if u.Agreement != nil {
+		user.Agreement = &common.Agreement{Image: fileToCommon(u.Agreement)}
+	}
+	if u.Contract != nil {
+		user.Contract = &common.Contract{Image: fileToCommon(u.Contract)}
+	}
+	if u.DocumentPhoto != nil {
+		user.DocumentPhoto = &common.DocumentPhoto{Image: fileToCommon(u.DocumentPhoto)}
+	}
+	if u.Selfie != nil {
+		user.Selfie = &common.Selfie{Image: fileToCommon(u.Selfie)}
+	}
+	if u.Avatar != nil {
+		user.Avatar = &common.Avatar{Image: fileToCommon(u.Avatar)}
+	}
+	if u.VideoAuth != nil {
+		user.VideoAuth = (*common.VideoAuth)(fileToCommon(u.VideoAuth))
+	}
+	if u.CompanyBoard != nil {
+		user.CompanyBoard = (*common.CompanyBoard)(fileToCommon(u.CompanyBoard))
+	}
+	if u.CompanyRegistration != nil {
+		user.CompanyRegistration = (*common.CompanyRegistration)(fileToCommon(u.CompanyRegistration))
+	}
+	for _, address := range u.CryptoAddresses {
+		crypto := common.CryptoAddress{
+			Chain:   common.Blockchain(address.Chain),
+			Address: address.Address,
+		}
+		if proof := address.OwnershipProof; proof != nil {
+			crypto.OwnershipProof = &common.WalletOwnershipProof{
+				Chain:       common.Blockchain(proof.Chain),
+				Address:     proof.Address,
+				Scheme:      proof.Scheme,
+				Message:     proof.Message,
+				Signature:   proof.Signature,
+				ChallengeID: proof.ChallengeId,
+				VerifiedAt:  time.Time(timeToCommon(proof.VerifiedAt)),
+			}
+		}
+		user.CryptoAddresses = append(user.CryptoAddresses, crypto)
+	}
+	for _, owner := range u.BeneficialOwners {
+		beneficial := common.BeneficialOwner{
+			FullName:           owner.FullName,
+			DateOfBirth:        timeToCommon(owner.DateOfBirth),
+			Nationality:        owner.Nationality,
+			OwnershipPercent:   int(owner.OwnershipPercent),
+			PoliticallyExposed: owner.PoliticallyExposed,
+			SourceOfWealth:     fundsToCommon(owner.SourceOfWealth),
+		}
+		if owner.Address != nil {
+			beneficial.Address = addressToCommon(owner.Address)
+		}
+		user.BeneficialOwners = append(user.BeneficialOwners, beneficial)
+	}
+
+	return user
+}
+
+// UserDataFromCommon converts the common user data model into the protobuf message.
+func UserDataFromCommon(u *common.UserData) *UserData {
+	if u == nil {
+		return nil
+	}
+
+	user := &UserData{
+		FirstName:                u.FirstName,
+		LastName:                 u.LastName,
+		MaternalLastName:         u.MaternalLastName,
+		MiddleName:               u.MiddleName,
+		FullName:                 u.FullName,
+		LegalName:                u.LegalName,
+		LatinIso1Name:            u.LatinISO1Name,
+		AccountName:              u.AccountName,
+		Email:                    u.Email,
+		IpAddress:                u.IPaddress,
+		Gender:                   Gender(u.Gender),
+		DateOfBirth:              timeFromCommon(u.DateOfBirth),
+		PlaceOfBirth:             u.PlaceOfBirth,
+		CountryOfBirthAlpha2:     u.CountryOfBirthAlpha2,
+		StateOfBirth:             u.StateOfBirth,
+		CountryAlpha2:            u.CountryAlpha2,
+		Nationality:              u.Nationality,
+		Phone:                    u.Phone,
+		MobilePhone:              u.MobilePhone,
+		BankAccountNumber:        u.BankAccountNumber,
+		VehicleRegistrationPlate: u.VehicleRegistrationPlate,
+		CurrentAddress:           addressFromCommon(u.CurrentAddress),
+		PoliticallyExposed:       u.PoliticallyExposed,
+		SourceOfWealth:           fundsFromCommon(u.SourceOfWealth),
+		SourceOfFunds:            fundsFromCommon(u.SourceOfFunds),
+		CompanyName:              u.CompanyName,
+		Website:                  u.Website,
+	}
+
+	if (u.CurrentAddress == common.Address{}) {
+		user.CurrentAddress = nil
+	}
+	for _, address := range u.SupplementalAddresses {
+		user.SupplementalAddresses = append(user.SupplementalAddresses, addressFromCommon(address))
+	}
+	if u.Location != nil {
+		user.Location = &Location{
+			Latitude:  u.Location.Latitude,
+			Longitude: u.Location.Longitude,
+		}
+	}
+	if u.Business != nil {
+		user.Business = &Business{
+			Name:                      u.Business.Name,
+			RegistrationNumber:        u.Business.RegistrationNumber,
+			IncorporationDate:         timeFromCommon(u.Business.IncorporationDate),
+			IncorporationJurisdiction: u.Business.IncorporationJurisdiction,
+		}
+	}
+	if p := u.Passport; p != nil {
+		user.Passport = &Passport{
+			Number:        p.Number,
+			Mrz1:          p.Mrz1,
+			Mrz2:          p.Mrz2,
+			CountryAlpha2: p.CountryAlpha2,
+			State:         p.State,
+			IssuedDate:    timeFromCommon(p.IssuedDate),
+			ValidUntil:    timeFromCommon(p.ValidUntil),
+			Image:         fileFromCommon(p.Image),
+		}
+	}
+	if d := u.IDCard; d != nil {
+		user.IdCard = &IdentityDocument{
+			Number:        d.Number,
+			CountryAlpha2: d.CountryAlpha2,
+			IssuedDate:    timeFromCommon(d.IssuedDate),
+			ValidUntil:    timeFromCommon(d.ValidUntil),
+			Image:         fileFromCommon(d.Image),
+		}
+	}
+	if d := u.SNILS; d != nil {
+		user.Snils = &IdentityDocument{
+			Number:     d.Number,
+			IssuedDate: timeFromCommon(d.IssuedDate),
+			Image:      fileFromCommon(d.Image),
+		}
+	}
+	if d := u.HealthID; d != nil {
+		user.HealthId = &IdentityDocument{
+			Number: d.Number,
+			Image:  fileFromCommon(d.Image),
+		}
+	}
+	if d := u.SocialServiceID; d != nil {
+		user.SocialServiceId = &IdentityDocument{
+			Number:     d.Number,
+			IssuedDate: timeFromCommon(d.IssuedDate),
+			Image:      fileFromCommon(d.Image),
+		}
+	}
+	if d := u.TaxID; d != nil {
+		user.TaxId = &IdentityDocument{
+			Number: d.Number,
+			Image:  fileFromCommon(d.Image),
+		}
+	}
+	if d := u.DriverLicense; d != nil {
+		user.DriverLicense = &DriverLicense{
+			Number:        d.Number,
+			Version:       d.Version,
+			CountryAlpha2: d.CountryAlpha2,
+			State:         d.State,
+			IssuedDate:    timeFromCommon(d.IssuedDate),
+			ValidUntil:    timeFromCommon(d.ValidUntil),
+			FrontImage:    fileFromCommon(d.FrontImage),
+			BackImage:     fileFromCommon(d.BackImage),
+		}
+	}
+	if d := u.DriverLicenseTranslation; d != nil {
+		user.DriverLicenseTranslation = &DriverLicense{
+			Number:        d.Number,
+			CountryAlpha2: d.CountryAlpha2,
+			State:         d.State,
+			IssuedDate:    timeFromCommon(d.IssuedDate),
+			ValidUntil:    timeFromCommon(d.ValidUntil),
+			FrontImage:    fileFromCommon(d.FrontImage),
+			BackImage:     fileFromCommon(d.BackImage),
+		}
+	}
+	if d := u.CreditCard; d != nil {
+		user.CreditCard = &IdentityDocument{
+			Number:     d.Number,
+			ValidUntil: timeFromCommon(d.ValidUntil),
+			Image:      fileFromCommon(d.Image),
+		}
+	}
+	if d := u.DebitCard; d != nil {
+		user.DebitCard = &IdentityDocument{
+			Number:     d.Number,
+			ValidUntil: timeFromCommon(d.ValidUntil),
+			Image:      fileFromCommon(d.Image),
+		}
+	}
+	if d := u.UtilityBill; d != nil {
+		user.UtilityBill = &IdentityDocument{
+			CountryAlpha2: d.CountryAlpha2,
+			Image:         fileFromCommon(d.Image),
+		}
+	}
+	if d := u.ResidencePermit; d != nil {
+		user.ResidencePermit = &IdentityDocument{
+			CountryAlpha2: d.CountryAlpha2,
+			IssuedDate:    timeFromCommon(d.IssuedDate),
+			ValidUntil:    timeFromCommon(d.ValidUntil),
+			Image:         fileFromCommon(d.Image),
+		}
+	}
+	if d := u.EmploymentCertificate; d != nil {
+		user.EmploymentCertificate = &IdentityDocument{
+			IssuedDate: timeFromCommon(d.IssuedDate),
+			Image:      fileFromCommon(d.Image),
+		}
+	}
+	if d := u.Other; d != nil {
+		user.Other = &IdentityDocument{
+			Number:        d.Number,
+			CountryAlpha2: d.CountryAlpha2,
+			State:         d.State,
+			IssuedDate:    timeFromCommon(d.IssuedDate),
+			ValidUntil:    timeFromCommon(d.ValidUntil),
+			Image:         fileFromCommon(d.Image),
+		}
+	}
+	if d := u.Document; d != nil {
+		user.Document = &IdentityDocument{
+			Type:          string(d.Type),
+			Number:        d.Number,
+			CountryAlpha2: d.CountryAlpha2,
+			IssuedDate:    timeFromCommon(d.IssuedDate),
+			ValidUntil:    timeFromCommon(d.ValidUntil),
+			Image:         fileFromCommon(d.Image),
+		}
+	}
+	if u.Agreement != nil {
+		user.Agreement = fileFromCommon(u.Agreement.Image)
+	}
+	if u.Contract != nil {
+		user.Contract = fileFromCommon(u.Contract.Image)
+	}
+	if u.DocumentPhoto != nil {
+		user.DocumentPhoto = fileFromCommon(u.DocumentPhoto.Image)
+	}
+	if u.Selfie != nil {
+		user.Selfie = fileFromCommon(u.Selfie.Image)
+	}
+	if u.Avatar != nil {
+		user.Avatar = fileFromCommon(u.Avatar.Image)
+	}
+	user.VideoAuth = fileFromCommon((*common.DocumentFile)(u.VideoAuth))
+	user.CompanyBoard = fileFromCommon((*common.DocumentFile)(u.CompanyBoard))
+	user.CompanyRegistration = fileFromCommon((*common.DocumentFile)(u.CompanyRegistration))
+	for _, address := range u.CryptoAddresses {
+		crypto := &CryptoAddress{
+			Chain:   string(address.Chain),
+			Address: address.Address,
+		}
+		if proof := address.OwnershipProof; proof != nil {
+			crypto.OwnershipProof = &WalletOwnershipProof{
+				Chain:       string(proof.Chain),
+				Address:     proof.Address,
+				Scheme:      proof.Scheme,
+				Message:     proof.Message,
+				Signature:   proof.Signature,
+				ChallengeId: proof.ChallengeID,
+				VerifiedAt:  timeFromCommon(common.Time(proof.VerifiedAt)),
+			}
+		}
+		user.CryptoAddresses = append(user.CryptoAddresses, crypto)
+	}
+	for _, owner := range u.BeneficialOwners {
+		user.BeneficialOwners = append(user.BeneficialOwners, &BeneficialOwner{
+			FullName:           owner.FullName,
+			DateOfBirth:        timeFromCommon(owner.DateOfBirth),
+			Nationality:        owner.Nationality,
+			Address:            addressFromCommon(owner.Address),
+			OwnershipPercent:   int32(owner.OwnershipPercent),
+			PoliticallyExposed: owner.PoliticallyExposed,
+			SourceOfWealth:     fundsFromCommon(owner.SourceOfWealth),
+		})
+	}
+
+	return user
+}
+
+// ResultFromCommon converts the verification result of the API into the protobuf message.
+func ResultFromCommon(r *common.Result) *Result {
+	if r == nil {
+		return nil
+	}
+
+	result := &Result{
+		Status:    r.Status,
+		RiskLevel: r.RiskLevel,
+		ErrorCode: r.ErrorCode,
+	}
+	if r.Details != nil {
+		result.Details = &Details{
+			Finality: r.Details.Finality,
+			Reasons:  r.Details.Reasons,
+		}
+	}
+	if r.StatusCheck != nil {
+		result.StatusCheck = &StatusCheck{
+			Provider:    string(r.StatusCheck.Provider),
+			Instance:    r.StatusCheck.Instance,
+			ReferenceId: r.StatusCheck.ReferenceID,
+			LastCheck:   timeFromCommon(common.Time(r.StatusCheck.LastCheck)),
+		}
+	}
+
+	return result
+}
+
+// ResultToCommon converts the protobuf verification result into the API representation.
+func ResultToCommon(r *Result) *common.Result {
+	if r == nil {
+		return nil
+	}
+
+	result := &common.Result{
+		Status:    r.Status,
+		RiskLevel: r.RiskLevel,
+		ErrorCode: r.ErrorCode,
+	}
+	if r.Details != nil {
+		result.Details = &common.Details{
+			Finality: r.Details.Finality,
+			Reasons:  r.Details.Reasons,
+		}
+	}
+	if r.StatusCheck != nil {
+		result.StatusCheck = &common.KYCStatusCheck{
+			Provider:    common.KYCProvider(r.StatusCheck.Provider),
+			Instance:    r.StatusCheck.Instance,
+			ReferenceID: r.StatusCheck.ReferenceId,
+			LastCheck:   time.Time(timeToCommon(r.StatusCheck.LastCheck)),
+		}
+	}
+
+	return result
+}
+
+// timeToCommon converts the timestamp into the common time, the missing timestamp becomes the zero time.
+func timeToCommon(t *timestamppb.Timestamp) common.Time {
+	if t == nil {
+		return common.Time{}
+	}
+	return common.Time(t.AsTime())
+}
+
+// timeFromCommon converts the common time into the timestamp, the zero time becomes the missing timestamp.
+func timeFromCommon(t common.Time) *timestamppb.Timestamp {
+	if time.Time(t).IsZero() {
+		return nil
+	}
+	return timestamppb.New(time.Time(t))
+}
+
+// addressToCommon converts the protobuf address into the common model.
+func addressToCommon(a *Address) common.Address {
+	return common.Address{
+		CountryAlpha2:     a.CountryAlpha2,
+		County:            a.County,
+		State:             a.State,
+		Town:              a.Town,
+		Suburb:            a.Suburb,
+		Street:            a.Street,
+		StreetType:        a.StreetType,
+		SubStreet:         a.SubStreet,
+		BuildingName:      a.BuildingName,
+		BuildingNumber:    a.BuildingNumber,
+		FlatNumber:        a.FlatNumber,
+		PostOfficeBox:     a.PostOfficeBox,
+		PostCode:          a.PostCode,
+		StateProvinceCode: a.StateProvinceCode,
+		StartDate:         timeToCommon(a.StartDate),
+		EndDate:           timeToCommon(a.EndDate),
+	}
+}
+
+// addressFromCommon converts the common address into the protobuf message.
+func addressFromCommon(a common.Address) *Address {
+	return &Address{
+		CountryAlpha2:     a.CountryAlpha2,
+		County:            a.County,
+		State:             a.State,
+		Town:              a.Town,
+		Suburb:            a.Suburb,
+		Street:            a.Street,
+		StreetType:        a.StreetType,
+		SubStreet:         a.SubStreet,
+		BuildingName:      a.BuildingName,
+		BuildingNumber:    a.BuildingNumber,
+		FlatNumber:        a.FlatNumber,
+		PostOfficeBox:     a.PostOfficeBox,
+		PostCode:          a.PostCode,
+		StateProvinceCode: a.StateProvinceCode,
+		StartDate:         timeFromCommon(a.StartDate),
+		EndDate:           timeFromCommon(a.EndDate),
+	}
+}
+
+// fileToCommon converts the protobuf document file into the common model.
+func fileToCommon(f *DocumentFile) *common.DocumentFile {
+	if f == nil {
+		return nil
+	}
+	return &common.DocumentFile{
+		Filename:    f.Filename,
+		ContentType: f.ContentType,
+		Data:        f.Data,
+	}
+}
+
+// fileFromCommon converts the common document file into the protobuf message.
+func fileFromCommon(f *common.DocumentFile) *DocumentFile {
+	if f == nil {
+		return nil
+	}
+	return &DocumentFile{
+		Filename:    f.Filename,
+		ContentType: f.ContentType,
+		Data:        f.Data,
+	}
+}
+
+// fundsToCommon converts the protobuf funds declaration into the common model.
+func fundsToCommon(f *FundsDeclaration) *common.FundsDeclaration {
+	if f == nil {
+		return nil
+	}
+
+	funds := &common.FundsDeclaration{Description: f.Description}
+	for _, source := range f.Sources {
+		funds.Sources = append(funds.Sources, common.FundsSource(source))
+	}
+
+	return funds
+}
+
+// fundsFromCommon converts the common funds declaration into the protobuf message.
+func fundsFromCommon(f *common.FundsDeclaration) *FundsDeclaration {
+	if f == nil {
+		return nil
+	}
+
+	funds := &FundsDeclaration{Description: f.Description}
+	for _, source := range f.Sources {
+		funds.Sources = append(funds.Sources, string(source))
+	}
+
+	return funds
+}
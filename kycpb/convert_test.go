@@ -0,0 +1,112 @@
+package kycpb
+
+import (
+	"testing"
+	"time"
+
+	"modulus/kyc/common"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserDataConversion(t *testing.T) {
+	assert := assert.New(t)
+
+	date := common.Time(time.Date(1982, 4, 3, 0, 0, 0, 0, time.UTC))
+	image := &common.DocumentFile{Filename: "id.jpg", ContentType: "image/jpeg", Data: []byte{0xff, 0xd8}}
+
+	user := &common.UserData{
+		FirstName:     "Smith",
+		LastName:      "James",
+		LatinISO1Name: "Smith James",
+		IPaddress:     "10.0.0.1",
+		Gender:        common.Male,
+		DateOfBirth:   date,
+		CountryAlpha2: "US",
+		CurrentAddress: common.Address{
+			CountryAlpha2: "US",
+			Town:          "Town",
+			StartDate:     date,
+		},
+		SupplementalAddresses: []common.Address{{Street: "Main"}},
+		Location:              &common.Location{Latitude: "1", Longitude: "2"},
+		Business:              &common.Business{Name: "Acme", IncorporationDate: date},
+		Passport: &common.Passport{
+			Number:     "123",
+			Mrz1:       "P<USA",
+			IssuedDate: date,
+			Image:      image,
+		},
+		IDCard:                &common.IDCard{Number: "456", CountryAlpha2: "US", Image: image},
+		SNILS:                 &common.SNILS{Number: "789", IssuedDate: date},
+		HealthID:              &common.HealthID{Number: "h"},
+		SocialServiceID:       &common.SocialServiceID{Number: "s"},
+		TaxID:                 &common.TaxID{Number: "t"},
+		DriverLicense:         &common.DriverLicense{Number: "d", Version: "2", FrontImage: image, BackImage: image},
+		CreditCard:            &common.CreditCard{Number: "4111", ValidUntil: date},
+		UtilityBill:           &common.UtilityBill{CountryAlpha2: "US", Image: image},
+		ResidencePermit:       &common.ResidencePermit{CountryAlpha2: "US", ValidUntil: date},
+		Agreement:             &common.Agreement{Image: image},
+		EmploymentCertificate: &common.EmploymentCertificate{IssuedDate: date},
+		Selfie:                &common.Selfie{Image: image},
+		Other:                 &common.Other{Number: "o", State: "CA"},
+		VideoAuth:             (*common.VideoAuth)(image),
+		Document:              &common.Document{Type: common.PassportType, Number: "123"},
+		PoliticallyExposed:    true,
+		CryptoAddresses: []common.CryptoAddress{
+			{
+				Chain:   common.Bitcoin,
+				Address: "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+				OwnershipProof: &common.WalletOwnershipProof{
+					Chain:       common.Bitcoin,
+					ChallengeID: "c1",
+					VerifiedAt:  time.Time(date),
+				},
+			},
+		},
+		SourceOfFunds: &common.FundsDeclaration{Sources: []common.FundsSource{"Salary"}, Description: "job"},
+		BeneficialOwners: []common.BeneficialOwner{
+			{FullName: "Owner", OwnershipPercent: 51, Address: common.Address{Town: "Town"}},
+		},
+		CompanyName:  "Acme",
+		CompanyBoard: (*common.CompanyBoard)(image),
+	}
+
+	message := UserDataFromCommon(user)
+
+	assert.Equal(Gender_MALE, message.Gender)
+	assert.Equal(image.Data, message.Passport.Image.Data)
+	assert.Equal(int64(386640000), message.DateOfBirth.Seconds)
+	assert.Equal(user, UserDataToCommon(message))
+
+	assert.Nil(UserDataFromCommon(nil))
+	assert.Nil(UserDataToCommon(nil))
+	assert.Nil(UserDataFromCommon(&common.UserData{}).CurrentAddress)
+}
+
+func TestResultConversion(t *testing.T) {
+	assert := assert.New(t)
+
+	result := &common.Result{
+		Status:    "Unclear",
+		RiskLevel: "Unknown",
+		Details: &common.Details{
+			Finality: "NonFinal",
+			Reasons:  []string{"reason"},
+		},
+		StatusCheck: &common.KYCStatusCheck{
+			Provider:    common.Example,
+			Instance:    "eu",
+			ReferenceID: "uma",
+			LastCheck:   time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+	}
+
+	message := ResultFromCommon(result)
+
+	assert.Equal("uma", message.StatusCheck.ReferenceId)
+	assert.Equal(result, ResultToCommon(message))
+
+	assert.Nil(ResultFromCommon(nil))
+	assert.Nil(ResultToCommon(nil))
+}
@@ -0,0 +1,4 @@
+package kycpb
+
+// The messages and the service are generated from kyc.proto by protoc with the protoc-gen-go and protoc-gen-go-grpc plugins.
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative kyc.proto
@@ -0,0 +1,3308 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: kyc.proto
+
+package kycpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Gender int32
+
+const (
+	Gender_GENDER_UNSPECIFIED Gender = 0
+	Gender_MALE               Gender = 1
+	Gender_FEMALE             Gender = 2
+)
+
+// Enum value maps for Gender.
+var (
+	Gender_name = map[int32]string{
+		0: "GENDER_UNSPECIFIED",
+		1: "MALE",
+		2: "FEMALE",
+	}
+	Gender_value = map[string]int32{
+		"GENDER_UNSPECIFIED": 0,
+		"MALE":               1,
+		"FEMALE":             2,
+	}
+)
+
+func (x Gender) Enum() *Gender {
+	p := new(Gender)
+	*p = x
+	return p
+}
+
+func (x Gender) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Gender) Descriptor() protoreflect.EnumDescriptor {
+	return file_kyc_proto_enumTypes[0].Descriptor()
+}
+
+func (Gender) Type() protoreflect.EnumType {
+	return &file_kyc_proto_enumTypes[0]
+}
+
+func (x Gender) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Gender.Descriptor instead.
+func (Gender) EnumDescriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{0}
+}
+
+type CheckCustomerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// The named config instance of the provider, the default provider config is used if it's empty.
+	Instance string    `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	UserData *UserData `protobuf:"bytes,3,opt,name=user_data,json=userData,proto3" json:"user_data,omitempty"`
+}
+
+func (x *CheckCustomerRequest) Reset() {
+	*x = CheckCustomerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCustomerRequest) ProtoMessage() {}
+
+func (x *CheckCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCustomerRequest.ProtoReflect.Descriptor instead.
+func (*CheckCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CheckCustomerRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *CheckCustomerRequest) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+func (x *CheckCustomerRequest) GetUserData() *UserData {
+	if x != nil {
+		return x.UserData
+	}
+	return nil
+}
+
+type CheckStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// The instance returned in the status check data of the verification.
+	Instance    string `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	ReferenceId string `protobuf:"bytes,3,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+}
+
+func (x *CheckStatusRequest) Reset() {
+	*x = CheckStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckStatusRequest) ProtoMessage() {}
+
+func (x *CheckStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckStatusRequest.ProtoReflect.Descriptor instead.
+func (*CheckStatusRequest) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CheckStatusRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *CheckStatusRequest) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+func (x *CheckStatusRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+type WatchStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Check *CheckStatusRequest `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`
+	// The interval between the status checks, the service default is used if it's zero.
+	PollIntervalSeconds uint32 `protobuf:"varint,2,opt,name=poll_interval_seconds,json=pollIntervalSeconds,proto3" json:"poll_interval_seconds,omitempty"`
+}
+
+func (x *WatchStatusRequest) Reset() {
+	*x = WatchStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStatusRequest) ProtoMessage() {}
+
+func (x *WatchStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStatusRequest.ProtoReflect.Descriptor instead.
+func (*WatchStatusRequest) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WatchStatusRequest) GetCheck() *CheckStatusRequest {
+	if x != nil {
+		return x.Check
+	}
+	return nil
+}
+
+func (x *WatchStatusRequest) GetPollIntervalSeconds() uint32 {
+	if x != nil {
+		return x.PollIntervalSeconds
+	}
+	return 0
+}
+
+// CheckResponse mirrors the KYCResponse of the HTTP API.
+type CheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *Result `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *CheckResponse) Reset() {
+	*x = CheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckResponse) ProtoMessage() {}
+
+func (x *CheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckResponse.ProtoReflect.Descriptor instead.
+func (*CheckResponse) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CheckResponse) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      string       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RiskLevel   string       `protobuf:"bytes,2,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	Details     *Details     `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	ErrorCode   string       `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	StatusCheck *StatusCheck `protobuf:"bytes,5,opt,name=status_check,json=statusCheck,proto3" json:"status_check,omitempty"`
+}
+
+func (x *Result) Reset() {
+	*x = Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Result) ProtoMessage() {}
+
+func (x *Result) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Result.ProtoReflect.Descriptor instead.
+func (*Result) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Result) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Result) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *Result) GetDetails() *Details {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *Result) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *Result) GetStatusCheck() *StatusCheck {
+	if x != nil {
+		return x.StatusCheck
+	}
+	return nil
+}
+
+type Details struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Finality string   `protobuf:"bytes,1,opt,name=finality,proto3" json:"finality,omitempty"`
+	Reasons  []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+}
+
+func (x *Details) Reset() {
+	*x = Details{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Details) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Details) ProtoMessage() {}
+
+func (x *Details) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Details.ProtoReflect.Descriptor instead.
+func (*Details) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Details) GetFinality() string {
+	if x != nil {
+		return x.Finality
+	}
+	return ""
+}
+
+func (x *Details) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type StatusCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Instance    string                 `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	ReferenceId string                 `protobuf:"bytes,3,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	LastCheck   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_check,json=lastCheck,proto3" json:"last_check,omitempty"`
+}
+
+func (x *StatusCheck) Reset() {
+	*x = StatusCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusCheck) ProtoMessage() {}
+
+func (x *StatusCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusCheck.ProtoReflect.Descriptor instead.
+func (*StatusCheck) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StatusCheck) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *StatusCheck) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+func (x *StatusCheck) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *StatusCheck) GetLastCheck() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCheck
+	}
+	return nil
+}
+
+type ListProvidersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListProvidersRequest) Reset() {
+	*x = ListProvidersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProvidersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersRequest) ProtoMessage() {}
+
+func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{7}
+}
+
+type ListProvidersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Providers []*Provider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *ListProvidersResponse) Reset() {
+	*x = ListProvidersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProvidersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersResponse) ProtoMessage() {}
+
+func (x *ListProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListProvidersResponse) GetProviders() []*Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type GetProviderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetProviderRequest) Reset() {
+	*x = GetProviderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProviderRequest) ProtoMessage() {}
+
+func (x *GetProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProviderRequest.ProtoReflect.Descriptor instead.
+func (*GetProviderRequest) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetProviderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Provider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Implemented bool   `protobuf:"varint,2,opt,name=implemented,proto3" json:"implemented,omitempty"`
+}
+
+func (x *Provider) Reset() {
+	*x = Provider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Provider) ProtoMessage() {}
+
+func (x *Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
+func (*Provider) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Provider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Provider) GetImplemented() bool {
+	if x != nil {
+		return x.Implemented
+	}
+	return false
+}
+
+type ScreenTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// BTC or ETH.
+	Coin   string `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (x *ScreenTransactionRequest) Reset() {
+	*x = ScreenTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScreenTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreenTransactionRequest) ProtoMessage() {}
+
+func (x *ScreenTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreenTransactionRequest.ProtoReflect.Descriptor instead.
+func (*ScreenTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ScreenTransactionRequest) GetCoin() string {
+	if x != nil {
+		return x.Coin
+	}
+	return ""
+}
+
+func (x *ScreenTransactionRequest) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type TransactionRisk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// Decimal numbers are represented as strings to keep the precision.
+	Risk            string                  `protobuf:"bytes,2,opt,name=risk,proto3" json:"risk,omitempty"`
+	AddressRisks    map[string]*AddressRisk `protobuf:"bytes,3,rep,name=address_risks,json=addressRisks,proto3" json:"address_risks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	UpdatedToBlock  int64                   `protobuf:"varint,4,opt,name=updated_to_block,json=updatedToBlock,proto3" json:"updated_to_block,omitempty"`
+	CallbackSeconds int64                   `protobuf:"varint,5,opt,name=callback_seconds,json=callbackSeconds,proto3" json:"callback_seconds,omitempty"`
+}
+
+func (x *TransactionRisk) Reset() {
+	*x = TransactionRisk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionRisk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionRisk) ProtoMessage() {}
+
+func (x *TransactionRisk) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionRisk.ProtoReflect.Descriptor instead.
+func (*TransactionRisk) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TransactionRisk) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *TransactionRisk) GetRisk() string {
+	if x != nil {
+		return x.Risk
+	}
+	return ""
+}
+
+func (x *TransactionRisk) GetAddressRisks() map[string]*AddressRisk {
+	if x != nil {
+		return x.AddressRisks
+	}
+	return nil
+}
+
+func (x *TransactionRisk) GetUpdatedToBlock() int64 {
+	if x != nil {
+		return x.UpdatedToBlock
+	}
+	return 0
+}
+
+func (x *TransactionRisk) GetCallbackSeconds() int64 {
+	if x != nil {
+		return x.CallbackSeconds
+	}
+	return 0
+}
+
+type AddressRisk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Risk            string `protobuf:"bytes,2,opt,name=risk,proto3" json:"risk,omitempty"`
+	InputValue      string `protobuf:"bytes,3,opt,name=input_value,json=inputValue,proto3" json:"input_value,omitempty"`
+	OutputValue     string `protobuf:"bytes,4,opt,name=output_value,json=outputValue,proto3" json:"output_value,omitempty"`
+	CallbackSeconds int64  `protobuf:"varint,5,opt,name=callback_seconds,json=callbackSeconds,proto3" json:"callback_seconds,omitempty"`
+}
+
+func (x *AddressRisk) Reset() {
+	*x = AddressRisk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressRisk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressRisk) ProtoMessage() {}
+
+func (x *AddressRisk) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressRisk.ProtoReflect.Descriptor instead.
+func (*AddressRisk) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AddressRisk) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddressRisk) GetRisk() string {
+	if x != nil {
+		return x.Risk
+	}
+	return ""
+}
+
+func (x *AddressRisk) GetInputValue() string {
+	if x != nil {
+		return x.InputValue
+	}
+	return ""
+}
+
+func (x *AddressRisk) GetOutputValue() string {
+	if x != nil {
+		return x.OutputValue
+	}
+	return ""
+}
+
+func (x *AddressRisk) GetCallbackSeconds() int64 {
+	if x != nil {
+		return x.CallbackSeconds
+	}
+	return 0
+}
+
+// UserData mirrors common.UserData. The images are sent as raw bytes.
+type UserData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstName                string                 `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName                 string                 `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	MaternalLastName         string                 `protobuf:"bytes,3,opt,name=maternal_last_name,json=maternalLastName,proto3" json:"maternal_last_name,omitempty"`
+	MiddleName               string                 `protobuf:"bytes,4,opt,name=middle_name,json=middleName,proto3" json:"middle_name,omitempty"`
+	FullName                 string                 `protobuf:"bytes,5,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	LegalName                string                 `protobuf:"bytes,6,opt,name=legal_name,json=legalName,proto3" json:"legal_name,omitempty"`
+	LatinIso1Name            string                 `protobuf:"bytes,7,opt,name=latin_iso1_name,json=latinIso1Name,proto3" json:"latin_iso1_name,omitempty"`
+	AccountName              string                 `protobuf:"bytes,8,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Email                    string                 `protobuf:"bytes,9,opt,name=email,proto3" json:"email,omitempty"`
+	IpAddress                string                 `protobuf:"bytes,10,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Gender                   Gender                 `protobuf:"varint,11,opt,name=gender,proto3,enum=kyc.v1.Gender" json:"gender,omitempty"`
+	DateOfBirth              *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	PlaceOfBirth             string                 `protobuf:"bytes,13,opt,name=place_of_birth,json=placeOfBirth,proto3" json:"place_of_birth,omitempty"`
+	CountryOfBirthAlpha2     string                 `protobuf:"bytes,14,opt,name=country_of_birth_alpha2,json=countryOfBirthAlpha2,proto3" json:"country_of_birth_alpha2,omitempty"`
+	StateOfBirth             string                 `protobuf:"bytes,15,opt,name=state_of_birth,json=stateOfBirth,proto3" json:"state_of_birth,omitempty"`
+	CountryAlpha2            string                 `protobuf:"bytes,16,opt,name=country_alpha2,json=countryAlpha2,proto3" json:"country_alpha2,omitempty"`
+	Nationality              string                 `protobuf:"bytes,17,opt,name=nationality,proto3" json:"nationality,omitempty"`
+	Phone                    string                 `protobuf:"bytes,18,opt,name=phone,proto3" json:"phone,omitempty"`
+	MobilePhone              string                 `protobuf:"bytes,19,opt,name=mobile_phone,json=mobilePhone,proto3" json:"mobile_phone,omitempty"`
+	BankAccountNumber        string                 `protobuf:"bytes,20,opt,name=bank_account_number,json=bankAccountNumber,proto3" json:"bank_account_number,omitempty"`
+	VehicleRegistrationPlate string                 `protobuf:"bytes,21,opt,name=vehicle_registration_plate,json=vehicleRegistrationPlate,proto3" json:"vehicle_registration_plate,omitempty"`
+	CurrentAddress           *Address               `protobuf:"bytes,22,opt,name=current_address,json=currentAddress,proto3" json:"current_address,omitempty"`
+	SupplementalAddresses    []*Address             `protobuf:"bytes,23,rep,name=supplemental_addresses,json=supplementalAddresses,proto3" json:"supplemental_addresses,omitempty"`
+	Location                 *Location              `protobuf:"bytes,24,opt,name=location,proto3" json:"location,omitempty"`
+	Business                 *Business              `protobuf:"bytes,25,opt,name=business,proto3" json:"business,omitempty"`
+	Passport                 *Passport              `protobuf:"bytes,26,opt,name=passport,proto3" json:"passport,omitempty"`
+	IdCard                   *IdentityDocument      `protobuf:"bytes,27,opt,name=id_card,json=idCard,proto3" json:"id_card,omitempty"`
+	Snils                    *IdentityDocument      `protobuf:"bytes,28,opt,name=snils,proto3" json:"snils,omitempty"`
+	HealthId                 *IdentityDocument      `protobuf:"bytes,29,opt,name=health_id,json=healthId,proto3" json:"health_id,omitempty"`
+	SocialServiceId          *IdentityDocument      `protobuf:"bytes,30,opt,name=social_service_id,json=socialServiceId,proto3" json:"social_service_id,omitempty"`
+	TaxId                    *IdentityDocument      `protobuf:"bytes,31,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"`
+	DriverLicense            *DriverLicense         `protobuf:"bytes,32,opt,name=driver_license,json=driverLicense,proto3" json:"driver_license,omitempty"`
+	DriverLicenseTranslation *DriverLicense         `protobuf:"bytes,33,opt,name=driver_license_translation,json=driverLicenseTranslation,proto3" json:"driver_license_translation,omitempty"`
+	CreditCard               *IdentityDocument      `protobuf:"bytes,34,opt,name=credit_card,json=creditCard,proto3" json:"credit_card,omitempty"`
+	DebitCard                *IdentityDocument      `protobuf:"bytes,35,opt,name=debit_card,json=debitCard,proto3" json:"debit_card,omitempty"`
+	UtilityBill              *IdentityDocument      `protobuf:"bytes,36,opt,name=utility_bill,json=utilityBill,proto3" json:"utility_bill,omitempty"`
+	ResidencePermit          *IdentityDocument      `protobuf:"bytes,37,opt,name=residence_permit,json=residencePermit,proto3" json:"residence_permit,omitempty"`
+	Agreement                *DocumentFile          `protobuf:"bytes,38,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	EmploymentCertificate    *IdentityDocument      `protobuf:"bytes,39,opt,name=employment_certificate,json=employmentCertificate,proto3" json:"employment_certificate,omitempty"`
+	Contract                 *DocumentFile          `protobuf:"bytes,40,opt,name=contract,proto3" json:"contract,omitempty"`
+	DocumentPhoto            *DocumentFile          `protobuf:"bytes,41,opt,name=document_photo,json=documentPhoto,proto3" json:"document_photo,omitempty"`
+	Selfie                   *DocumentFile          `protobuf:"bytes,42,opt,name=selfie,proto3" json:"selfie,omitempty"`
+	Avatar                   *DocumentFile          `protobuf:"bytes,43,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Other                    *IdentityDocument      `protobuf:"bytes,44,opt,name=other,proto3" json:"other,omitempty"`
+	VideoAuth                *DocumentFile          `protobuf:"bytes,45,opt,name=video_auth,json=videoAuth,proto3" json:"video_auth,omitempty"`
+	Document                 *IdentityDocument      `protobuf:"bytes,46,opt,name=document,proto3" json:"document,omitempty"`
+	PoliticallyExposed       bool                   `protobuf:"varint,47,opt,name=politically_exposed,json=politicallyExposed,proto3" json:"politically_exposed,omitempty"`
+	CryptoAddresses          []*CryptoAddress       `protobuf:"bytes,48,rep,name=crypto_addresses,json=cryptoAddresses,proto3" json:"crypto_addresses,omitempty"`
+	SourceOfWealth           *FundsDeclaration      `protobuf:"bytes,49,opt,name=source_of_wealth,json=sourceOfWealth,proto3" json:"source_of_wealth,omitempty"`
+	SourceOfFunds            *FundsDeclaration      `protobuf:"bytes,50,opt,name=source_of_funds,json=sourceOfFunds,proto3" json:"source_of_funds,omitempty"`
+	BeneficialOwners         []*BeneficialOwner     `protobuf:"bytes,51,rep,name=beneficial_owners,json=beneficialOwners,proto3" json:"beneficial_owners,omitempty"`
+	CompanyName              string                 `protobuf:"bytes,52,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	Website                  string                 `protobuf:"bytes,53,opt,name=website,proto3" json:"website,omitempty"`
+	CompanyBoard             *DocumentFile          `protobuf:"bytes,54,opt,name=company_board,json=companyBoard,proto3" json:"company_board,omitempty"`
+	CompanyRegistration      *DocumentFile          `protobuf:"bytes,55,opt,name=company_registration,json=companyRegistration,proto3" json:"company_registration,omitempty"`
+}
+
+func (x *UserData) Reset() {
+	*x = UserData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserData) ProtoMessage() {}
+
+func (x *UserData) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserData.ProtoReflect.Descriptor instead.
+func (*UserData) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UserData) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UserData) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *UserData) GetMaternalLastName() string {
+	if x != nil {
+		return x.MaternalLastName
+	}
+	return ""
+}
+
+func (x *UserData) GetMiddleName() string {
+	if x != nil {
+		return x.MiddleName
+	}
+	return ""
+}
+
+func (x *UserData) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *UserData) GetLegalName() string {
+	if x != nil {
+		return x.LegalName
+	}
+	return ""
+}
+
+func (x *UserData) GetLatinIso1Name() string {
+	if x != nil {
+		return x.LatinIso1Name
+	}
+	return ""
+}
+
+func (x *UserData) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *UserData) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserData) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *UserData) GetGender() Gender {
+	if x != nil {
+		return x.Gender
+	}
+	return Gender_GENDER_UNSPECIFIED
+}
+
+func (x *UserData) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *UserData) GetPlaceOfBirth() string {
+	if x != nil {
+		return x.PlaceOfBirth
+	}
+	return ""
+}
+
+func (x *UserData) GetCountryOfBirthAlpha2() string {
+	if x != nil {
+		return x.CountryOfBirthAlpha2
+	}
+	return ""
+}
+
+func (x *UserData) GetStateOfBirth() string {
+	if x != nil {
+		return x.StateOfBirth
+	}
+	return ""
+}
+
+func (x *UserData) GetCountryAlpha2() string {
+	if x != nil {
+		return x.CountryAlpha2
+	}
+	return ""
+}
+
+func (x *UserData) GetNationality() string {
+	if x != nil {
+		return x.Nationality
+	}
+	return ""
+}
+
+func (x *UserData) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UserData) GetMobilePhone() string {
+	if x != nil {
+		return x.MobilePhone
+	}
+	return ""
+}
+
+func (x *UserData) GetBankAccountNumber() string {
+	if x != nil {
+		return x.BankAccountNumber
+	}
+	return ""
+}
+
+func (x *UserData) GetVehicleRegistrationPlate() string {
+	if x != nil {
+		return x.VehicleRegistrationPlate
+	}
+	return ""
+}
+
+func (x *UserData) GetCurrentAddress() *Address {
+	if x != nil {
+		return x.CurrentAddress
+	}
+	return nil
+}
+
+func (x *UserData) GetSupplementalAddresses() []*Address {
+	if x != nil {
+		return x.SupplementalAddresses
+	}
+	return nil
+}
+
+func (x *UserData) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *UserData) GetBusiness() *Business {
+	if x != nil {
+		return x.Business
+	}
+	return nil
+}
+
+func (x *UserData) GetPassport() *Passport {
+	if x != nil {
+		return x.Passport
+	}
+	return nil
+}
+
+func (x *UserData) GetIdCard() *IdentityDocument {
+	if x != nil {
+		return x.IdCard
+	}
+	return nil
+}
+
+func (x *UserData) GetSnils() *IdentityDocument {
+	if x != nil {
+		return x.Snils
+	}
+	return nil
+}
+
+func (x *UserData) GetHealthId() *IdentityDocument {
+	if x != nil {
+		return x.HealthId
+	}
+	return nil
+}
+
+func (x *UserData) GetSocialServiceId() *IdentityDocument {
+	if x != nil {
+		return x.SocialServiceId
+	}
+	return nil
+}
+
+func (x *UserData) GetTaxId() *IdentityDocument {
+	if x != nil {
+		return x.TaxId
+	}
+	return nil
+}
+
+func (x *UserData) GetDriverLicense() *DriverLicense {
+	if x != nil {
+		return x.DriverLicense
+	}
+	return nil
+}
+
+func (x *UserData) GetDriverLicenseTranslation() *DriverLicense {
+	if x != nil {
+		return x.DriverLicenseTranslation
+	}
+	return nil
+}
+
+func (x *UserData) GetCreditCard() *IdentityDocument {
+	if x != nil {
+		return x.CreditCard
+	}
+	return nil
+}
+
+func (x *UserData) GetDebitCard() *IdentityDocument {
+	if x != nil {
+		return x.DebitCard
+	}
+	return nil
+}
+
+func (x *UserData) GetUtilityBill() *IdentityDocument {
+	if x != nil {
+		return x.UtilityBill
+	}
+	return nil
+}
+
+func (x *UserData) GetResidencePermit() *IdentityDocument {
+	if x != nil {
+		return x.ResidencePermit
+	}
+	return nil
+}
+
+func (x *UserData) GetAgreement() *DocumentFile {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+func (x *UserData) GetEmploymentCertificate() *IdentityDocument {
+	if x != nil {
+		return x.EmploymentCertificate
+	}
+	return nil
+}
+
+func (x *UserData) GetContract() *DocumentFile {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+func (x *UserData) GetDocumentPhoto() *DocumentFile {
+	if x != nil {
+		return x.DocumentPhoto
+	}
+	return nil
+}
+
+func (x *UserData) GetSelfie() *DocumentFile {
+	if x != nil {
+		return x.Selfie
+	}
+	return nil
+}
+
+func (x *UserData) GetAvatar() *DocumentFile {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+func (x *UserData) GetOther() *IdentityDocument {
+	if x != nil {
+		return x.Other
+	}
+	return nil
+}
+
+func (x *UserData) GetVideoAuth() *DocumentFile {
+	if x != nil {
+		return x.VideoAuth
+	}
+	return nil
+}
+
+func (x *UserData) GetDocument() *IdentityDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *UserData) GetPoliticallyExposed() bool {
+	if x != nil {
+		return x.PoliticallyExposed
+	}
+	return false
+}
+
+func (x *UserData) GetCryptoAddresses() []*CryptoAddress {
+	if x != nil {
+		return x.CryptoAddresses
+	}
+	return nil
+}
+
+func (x *UserData) GetSourceOfWealth() *FundsDeclaration {
+	if x != nil {
+		return x.SourceOfWealth
+	}
+	return nil
+}
+
+func (x *UserData) GetSourceOfFunds() *FundsDeclaration {
+	if x != nil {
+		return x.SourceOfFunds
+	}
+	return nil
+}
+
+func (x *UserData) GetBeneficialOwners() []*BeneficialOwner {
+	if x != nil {
+		return x.BeneficialOwners
+	}
+	return nil
+}
+
+func (x *UserData) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *UserData) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *UserData) GetCompanyBoard() *DocumentFile {
+	if x != nil {
+		return x.CompanyBoard
+	}
+	return nil
+}
+
+func (x *UserData) GetCompanyRegistration() *DocumentFile {
+	if x != nil {
+		return x.CompanyRegistration
+	}
+	return nil
+}
+
+type Address struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CountryAlpha2     string                 `protobuf:"bytes,1,opt,name=country_alpha2,json=countryAlpha2,proto3" json:"country_alpha2,omitempty"`
+	County            string                 `protobuf:"bytes,2,opt,name=county,proto3" json:"county,omitempty"`
+	State             string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Town              string                 `protobuf:"bytes,4,opt,name=town,proto3" json:"town,omitempty"`
+	Suburb            string                 `protobuf:"bytes,5,opt,name=suburb,proto3" json:"suburb,omitempty"`
+	Street            string                 `protobuf:"bytes,6,opt,name=street,proto3" json:"street,omitempty"`
+	StreetType        string                 `protobuf:"bytes,7,opt,name=street_type,json=streetType,proto3" json:"street_type,omitempty"`
+	SubStreet         string                 `protobuf:"bytes,8,opt,name=sub_street,json=subStreet,proto3" json:"sub_street,omitempty"`
+	BuildingName      string                 `protobuf:"bytes,9,opt,name=building_name,json=buildingName,proto3" json:"building_name,omitempty"`
+	BuildingNumber    string                 `protobuf:"bytes,10,opt,name=building_number,json=buildingNumber,proto3" json:"building_number,omitempty"`
+	FlatNumber        string                 `protobuf:"bytes,11,opt,name=flat_number,json=flatNumber,proto3" json:"flat_number,omitempty"`
+	PostOfficeBox     string                 `protobuf:"bytes,12,opt,name=post_office_box,json=postOfficeBox,proto3" json:"post_office_box,omitempty"`
+	PostCode          string                 `protobuf:"bytes,13,opt,name=post_code,json=postCode,proto3" json:"post_code,omitempty"`
+	StateProvinceCode string                 `protobuf:"bytes,14,opt,name=state_province_code,json=stateProvinceCode,proto3" json:"state_province_code,omitempty"`
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate           *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Address) GetCountryAlpha2() string {
+	if x != nil {
+		return x.CountryAlpha2
+	}
+	return ""
+}
+
+func (x *Address) GetCounty() string {
+	if x != nil {
+		return x.County
+	}
+	return ""
+}
+
+func (x *Address) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Address) GetTown() string {
+	if x != nil {
+		return x.Town
+	}
+	return ""
+}
+
+func (x *Address) GetSuburb() string {
+	if x != nil {
+		return x.Suburb
+	}
+	return ""
+}
+
+func (x *Address) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *Address) GetStreetType() string {
+	if x != nil {
+		return x.StreetType
+	}
+	return ""
+}
+
+func (x *Address) GetSubStreet() string {
+	if x != nil {
+		return x.SubStreet
+	}
+	return ""
+}
+
+func (x *Address) GetBuildingName() string {
+	if x != nil {
+		return x.BuildingName
+	}
+	return ""
+}
+
+func (x *Address) GetBuildingNumber() string {
+	if x != nil {
+		return x.BuildingNumber
+	}
+	return ""
+}
+
+func (x *Address) GetFlatNumber() string {
+	if x != nil {
+		return x.FlatNumber
+	}
+	return ""
+}
+
+func (x *Address) GetPostOfficeBox() string {
+	if x != nil {
+		return x.PostOfficeBox
+	}
+	return ""
+}
+
+func (x *Address) GetPostCode() string {
+	if x != nil {
+		return x.PostCode
+	}
+	return ""
+}
+
+func (x *Address) GetStateProvinceCode() string {
+	if x != nil {
+		return x.StateProvinceCode
+	}
+	return ""
+}
+
+func (x *Address) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *Address) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type Location struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  string `protobuf:"bytes,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude string `protobuf:"bytes,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Location) GetLatitude() string {
+	if x != nil {
+		return x.Latitude
+	}
+	return ""
+}
+
+func (x *Location) GetLongitude() string {
+	if x != nil {
+		return x.Longitude
+	}
+	return ""
+}
+
+type Business struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RegistrationNumber        string                 `protobuf:"bytes,2,opt,name=registration_number,json=registrationNumber,proto3" json:"registration_number,omitempty"`
+	IncorporationDate         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=incorporation_date,json=incorporationDate,proto3" json:"incorporation_date,omitempty"`
+	IncorporationJurisdiction string                 `protobuf:"bytes,4,opt,name=incorporation_jurisdiction,json=incorporationJurisdiction,proto3" json:"incorporation_jurisdiction,omitempty"`
+}
+
+func (x *Business) Reset() {
+	*x = Business{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Business) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Business) ProtoMessage() {}
+
+func (x *Business) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Business.ProtoReflect.Descriptor instead.
+func (*Business) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Business) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Business) GetRegistrationNumber() string {
+	if x != nil {
+		return x.RegistrationNumber
+	}
+	return ""
+}
+
+func (x *Business) GetIncorporationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IncorporationDate
+	}
+	return nil
+}
+
+func (x *Business) GetIncorporationJurisdiction() string {
+	if x != nil {
+		return x.IncorporationJurisdiction
+	}
+	return ""
+}
+
+type Passport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number        string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Mrz1          string                 `protobuf:"bytes,2,opt,name=mrz1,proto3" json:"mrz1,omitempty"`
+	Mrz2          string                 `protobuf:"bytes,3,opt,name=mrz2,proto3" json:"mrz2,omitempty"`
+	CountryAlpha2 string                 `protobuf:"bytes,4,opt,name=country_alpha2,json=countryAlpha2,proto3" json:"country_alpha2,omitempty"`
+	State         string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	IssuedDate    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=issued_date,json=issuedDate,proto3" json:"issued_date,omitempty"`
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	Image         *DocumentFile          `protobuf:"bytes,8,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *Passport) Reset() {
+	*x = Passport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Passport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Passport) ProtoMessage() {}
+
+func (x *Passport) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Passport.ProtoReflect.Descriptor instead.
+func (*Passport) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Passport) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Passport) GetMrz1() string {
+	if x != nil {
+		return x.Mrz1
+	}
+	return ""
+}
+
+func (x *Passport) GetMrz2() string {
+	if x != nil {
+		return x.Mrz2
+	}
+	return ""
+}
+
+func (x *Passport) GetCountryAlpha2() string {
+	if x != nil {
+		return x.CountryAlpha2
+	}
+	return ""
+}
+
+func (x *Passport) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Passport) GetIssuedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedDate
+	}
+	return nil
+}
+
+func (x *Passport) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *Passport) GetImage() *DocumentFile {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type DriverLicense struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number        string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	CountryAlpha2 string                 `protobuf:"bytes,3,opt,name=country_alpha2,json=countryAlpha2,proto3" json:"country_alpha2,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	IssuedDate    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=issued_date,json=issuedDate,proto3" json:"issued_date,omitempty"`
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	FrontImage    *DocumentFile          `protobuf:"bytes,7,opt,name=front_image,json=frontImage,proto3" json:"front_image,omitempty"`
+	BackImage     *DocumentFile          `protobuf:"bytes,8,opt,name=back_image,json=backImage,proto3" json:"back_image,omitempty"`
+}
+
+func (x *DriverLicense) Reset() {
+	*x = DriverLicense{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DriverLicense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverLicense) ProtoMessage() {}
+
+func (x *DriverLicense) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverLicense.ProtoReflect.Descriptor instead.
+func (*DriverLicense) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DriverLicense) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *DriverLicense) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *DriverLicense) GetCountryAlpha2() string {
+	if x != nil {
+		return x.CountryAlpha2
+	}
+	return ""
+}
+
+func (x *DriverLicense) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *DriverLicense) GetIssuedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedDate
+	}
+	return nil
+}
+
+func (x *DriverLicense) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *DriverLicense) GetFrontImage() *DocumentFile {
+	if x != nil {
+		return x.FrontImage
+	}
+	return nil
+}
+
+func (x *DriverLicense) GetBackImage() *DocumentFile {
+	if x != nil {
+		return x.BackImage
+	}
+	return nil
+}
+
+// IdentityDocument holds the fields of the documents having the same shape,
+// the fields the particular document doesn't have are ignored.
+type IdentityDocument struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of the generic document, e.g. "passport" or "idcard".
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Number        string                 `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	CountryAlpha2 string                 `protobuf:"bytes,3,opt,name=country_alpha2,json=countryAlpha2,proto3" json:"country_alpha2,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	IssuedDate    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=issued_date,json=issuedDate,proto3" json:"issued_date,omitempty"`
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	Image         *DocumentFile          `protobuf:"bytes,7,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *IdentityDocument) Reset() {
+	*x = IdentityDocument{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdentityDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityDocument) ProtoMessage() {}
+
+func (x *IdentityDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityDocument.ProtoReflect.Descriptor instead.
+func (*IdentityDocument) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *IdentityDocument) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *IdentityDocument) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *IdentityDocument) GetCountryAlpha2() string {
+	if x != nil {
+		return x.CountryAlpha2
+	}
+	return ""
+}
+
+func (x *IdentityDocument) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *IdentityDocument) GetIssuedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedDate
+	}
+	return nil
+}
+
+func (x *IdentityDocument) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *IdentityDocument) GetImage() *DocumentFile {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type DocumentFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filename    string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data        []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *DocumentFile) Reset() {
+	*x = DocumentFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocumentFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentFile) ProtoMessage() {}
+
+func (x *DocumentFile) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentFile.ProtoReflect.Descriptor instead.
+func (*DocumentFile) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DocumentFile) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *DocumentFile) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DocumentFile) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CryptoAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chain          string                `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address        string                `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	OwnershipProof *WalletOwnershipProof `protobuf:"bytes,3,opt,name=ownership_proof,json=ownershipProof,proto3" json:"ownership_proof,omitempty"`
+}
+
+func (x *CryptoAddress) Reset() {
+	*x = CryptoAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CryptoAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CryptoAddress) ProtoMessage() {}
+
+func (x *CryptoAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CryptoAddress.ProtoReflect.Descriptor instead.
+func (*CryptoAddress) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CryptoAddress) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *CryptoAddress) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CryptoAddress) GetOwnershipProof() *WalletOwnershipProof {
+	if x != nil {
+		return x.OwnershipProof
+	}
+	return nil
+}
+
+type WalletOwnershipProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chain       string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address     string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Scheme      string                 `protobuf:"bytes,3,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Message     string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Signature   string                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	ChallengeId string                 `protobuf:"bytes,6,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	VerifiedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+}
+
+func (x *WalletOwnershipProof) Reset() {
+	*x = WalletOwnershipProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletOwnershipProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletOwnershipProof) ProtoMessage() {}
+
+func (x *WalletOwnershipProof) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletOwnershipProof.ProtoReflect.Descriptor instead.
+func (*WalletOwnershipProof) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WalletOwnershipProof) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *WalletOwnershipProof) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WalletOwnershipProof) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *WalletOwnershipProof) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WalletOwnershipProof) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *WalletOwnershipProof) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *WalletOwnershipProof) GetVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+type FundsDeclaration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sources     []string `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *FundsDeclaration) Reset() {
+	*x = FundsDeclaration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FundsDeclaration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundsDeclaration) ProtoMessage() {}
+
+func (x *FundsDeclaration) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundsDeclaration.ProtoReflect.Descriptor instead.
+func (*FundsDeclaration) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FundsDeclaration) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *FundsDeclaration) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type BeneficialOwner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FullName           string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	DateOfBirth        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	Nationality        string                 `protobuf:"bytes,3,opt,name=nationality,proto3" json:"nationality,omitempty"`
+	Address            *Address               `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	OwnershipPercent   int32                  `protobuf:"varint,5,opt,name=ownership_percent,json=ownershipPercent,proto3" json:"ownership_percent,omitempty"`
+	PoliticallyExposed bool                   `protobuf:"varint,6,opt,name=politically_exposed,json=politicallyExposed,proto3" json:"politically_exposed,omitempty"`
+	SourceOfWealth     *FundsDeclaration      `protobuf:"bytes,7,opt,name=source_of_wealth,json=sourceOfWealth,proto3" json:"source_of_wealth,omitempty"`
+}
+
+func (x *BeneficialOwner) Reset() {
+	*x = BeneficialOwner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeneficialOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeneficialOwner) ProtoMessage() {}
+
+func (x *BeneficialOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeneficialOwner.ProtoReflect.Descriptor instead.
+func (*BeneficialOwner) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BeneficialOwner) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *BeneficialOwner) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *BeneficialOwner) GetNationality() string {
+	if x != nil {
+		return x.Nationality
+	}
+	return ""
+}
+
+func (x *BeneficialOwner) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *BeneficialOwner) GetOwnershipPercent() int32 {
+	if x != nil {
+		return x.OwnershipPercent
+	}
+	return 0
+}
+
+func (x *BeneficialOwner) GetPoliticallyExposed() bool {
+	if x != nil {
+		return x.PoliticallyExposed
+	}
+	return false
+}
+
+func (x *BeneficialOwner) GetSourceOfWealth() *FundsDeclaration {
+	if x != nil {
+		return x.SourceOfWealth
+	}
+	return nil
+}
+
+var File_kyc_proto protoreflect.FileDescriptor
+
+var file_kyc_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x6b, 0x79, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6b, 0x79, 0x63,
+	0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7d, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x22, 0x6f, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x22, 0x7a, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6b, 0x79, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x32, 0x0a, 0x15,
+	0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x70, 0x6f, 0x6c,
+	0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0x37, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x26, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xc1, 0x01, 0x0a, 0x06, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x69, 0x73, 0x6b, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x69, 0x73, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x07, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b,
+	0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6b, 0x79,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x3f, 0x0a,
+	0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0xa3,
+	0x01, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x28, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x40, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x65,
+	0x64, 0x22, 0x47, 0x0a, 0x18, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x69,
+	0x6e, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0xb9, 0x02, 0x0a, 0x0f, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x69, 0x73, 0x6b, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x69, 0x73, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x69, 0x73, 0x6b, 0x12, 0x4e, 0x0a, 0x0d, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x69, 0x73, 0x6b, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x69, 0x73, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x1a, 0x54, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x69, 0x73, 0x6b, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x69, 0x73, 0x6b, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xaa, 0x01, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x69, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x69, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x69, 0x73, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0xbd, 0x15, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12,
+	0x6d, 0x61, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66,
+	0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x67, 0x61,
+	0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65,
+	0x67, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x74, 0x69, 0x6e,
+	0x5f, 0x69, 0x73, 0x6f, 0x31, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x49, 0x73, 0x6f, 0x31, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x3e, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x12,
+	0x24, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72, 0x74,
+	0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x66,
+	0x42, 0x69, 0x72, 0x74, 0x68, 0x12, 0x35, 0x0a, 0x17, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x32,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4f,
+	0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x12, 0x24, 0x0a, 0x0e,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72,
+	0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x32, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x50,
+	0x68, 0x6f, 0x6e, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x62, 0x61, 0x6e, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x1a, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61,
+	0x74, 0x65, 0x12, 0x38, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b, 0x79,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x16,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b,
+	0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x15, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x08, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x12, 0x2c, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x31,
+	0x0a, 0x07, 0x69, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x69, 0x64, 0x43, 0x61, 0x72,
+	0x64, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x6e, 0x69, 0x6c, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x6e, 0x69, 0x6c,
+	0x73, 0x12, 0x35, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x1d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x11, 0x73, 0x6f, 0x63, 0x69,
+	0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x1e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x73,
+	0x6f, 0x63, 0x69, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2f,
+	0x0a, 0x06, 0x74, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x74, 0x61, 0x78, 0x49, 0x64, 0x12,
+	0x3c, 0x0a, 0x0e, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73,
+	0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x0d,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x1a, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x21, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x18, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x61, 0x72,
+	0x64, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x43, 0x61, 0x72, 0x64, 0x12, 0x37, 0x0a,
+	0x0a, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x18, 0x23, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x64, 0x65, 0x62,
+	0x69, 0x74, 0x43, 0x61, 0x72, 0x64, 0x12, 0x3b, 0x0a, 0x0c, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b,
+	0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42,
+	0x69, 0x6c, 0x6c, 0x12, 0x43, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x79,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x16,
+	0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b,
+	0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x15, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a,
+	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12,
+	0x3b, 0x0a, 0x0e, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x0d, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x2c, 0x0a, 0x06,
+	0x73, 0x65, 0x6c, 0x66, 0x69, 0x65, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b,
+	0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x66, 0x69, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x79, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x74, 0x68, 0x65,
+	0x72, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b,
+	0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x68, 0x12, 0x34, 0x0a,
+	0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x6f, 0x6c, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c,
+	0x6c, 0x79, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x70, 0x6f, 0x6c, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x6c, 0x79, 0x45, 0x78, 0x70,
+	0x6f, 0x73, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x10, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x30, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x44,
+	0x65, 0x63, 0x6c, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4f, 0x66, 0x57, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x40, 0x0a, 0x0f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x32, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e,
+	0x64, 0x73, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x66, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x44, 0x0a, 0x11,
+	0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x73, 0x18, 0x33, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x52, 0x10, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
+	0x18, 0x35, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12,
+	0x39, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x18, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x0c, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x47, 0x0a, 0x14, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x37, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x13,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xb8, 0x04, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x41, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x77, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x77, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x75,
+	0x72, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x62, 0x75, 0x72, 0x62,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x65,
+	0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x74, 0x72, 0x65, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x75, 0x62, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6c, 0x61, 0x74, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6c, 0x61,
+	0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x6f, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x70, 0x6f, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x42, 0x6f, 0x78, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x13,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x44,
+	0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x22, 0xd9, 0x01, 0x0a, 0x08, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6f, 0x72, 0x70,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11,
+	0x69, 0x6e, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x3d, 0x0a, 0x1a, 0x69, 0x6e, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x69, 0x6e, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xad, 0x02, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x72, 0x7a, 0x31, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x72, 0x7a, 0x31, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x72, 0x7a,
+	0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x72, 0x7a, 0x32, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x41, 0x6c,
+	0x70, 0x68, 0x61, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55,
+	0x6e, 0x74, 0x69, 0x6c, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x22, 0xe4, 0x02, 0x0a, 0x0d, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x4c, 0x69, 0x63, 0x65, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3b,
+	0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x35, 0x0a, 0x0b, 0x66,
+	0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x09, 0x62, 0x61,
+	0x63, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x22, 0xa1, 0x02, 0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12,
+	0x2a, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x61, 0x0a, 0x0c, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x86,
+	0x01, 0x0a, 0x0d, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x45, 0x0a, 0x0f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x79, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x0e, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xf6, 0x01, 0x0a, 0x14, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x4e, 0x0a, 0x10, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xdd, 0x02, 0x0a, 0x0f, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74,
+	0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x70,
+	0x6f, 0x6c, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x73,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x70, 0x6f, 0x6c, 0x69, 0x74, 0x69,
+	0x63, 0x61, 0x6c, 0x6c, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x10,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x75, 0x6e, 0x64, 0x73, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x66, 0x57, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x2a, 0x36, 0x0a, 0x06, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x45,
+	0x4e, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x41, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
+	0x46, 0x45, 0x4d, 0x41, 0x4c, 0x45, 0x10, 0x02, 0x32, 0xac, 0x03, 0x0a, 0x03, 0x4b, 0x59, 0x43,
+	0x12, 0x44, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x12, 0x1c, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x2e,
+	0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x79,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x6b, 0x79, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x11, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x6b,
+	0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x6b, 0x79, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x69, 0x73, 0x6b, 0x42, 0x13, 0x5a, 0x11, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x75, 0x73, 0x2f, 0x6b, 0x79, 0x63, 0x2f, 0x6b, 0x79, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kyc_proto_rawDescOnce sync.Once
+	file_kyc_proto_rawDescData = file_kyc_proto_rawDesc
+)
+
+func file_kyc_proto_rawDescGZIP() []byte {
+	file_kyc_proto_rawDescOnce.Do(func() {
+		file_kyc_proto_rawDescData = protoimpl.X.CompressGZIP(file_kyc_proto_rawDescData)
+	})
+	return file_kyc_proto_rawDescData
+}
+
+var file_kyc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_kyc_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_kyc_proto_goTypes = []any{
+	(Gender)(0),                      // 0: kyc.v1.Gender
+	(*CheckCustomerRequest)(nil),     // 1: kyc.v1.CheckCustomerRequest
+	(*CheckStatusRequest)(nil),       // 2: kyc.v1.CheckStatusRequest
+	(*WatchStatusRequest)(nil),       // 3: kyc.v1.WatchStatusRequest
+	(*CheckResponse)(nil),            // 4: kyc.v1.CheckResponse
+	(*Result)(nil),                   // 5: kyc.v1.Result
+	(*Details)(nil),                  // 6: kyc.v1.Details
+	(*StatusCheck)(nil),              // 7: kyc.v1.StatusCheck
+	(*ListProvidersRequest)(nil),     // 8: kyc.v1.ListProvidersRequest
+	(*ListProvidersResponse)(nil),    // 9: kyc.v1.ListProvidersResponse
+	(*GetProviderRequest)(nil),       // 10: kyc.v1.GetProviderRequest
+	(*Provider)(nil),                 // 11: kyc.v1.Provider
+	(*ScreenTransactionRequest)(nil), // 12: kyc.v1.ScreenTransactionRequest
+	(*TransactionRisk)(nil),          // 13: kyc.v1.TransactionRisk
+	(*AddressRisk)(nil),              // 14: kyc.v1.AddressRisk
+	(*UserData)(nil),                 // 15: kyc.v1.UserData
+	(*Address)(nil),                  // 16: kyc.v1.Address
+	(*Location)(nil),                 // 17: kyc.v1.Location
+	(*Business)(nil),                 // 18: kyc.v1.Business
+	(*Passport)(nil),                 // 19: kyc.v1.Passport
+	(*DriverLicense)(nil),            // 20: kyc.v1.DriverLicense
+	(*IdentityDocument)(nil),         // 21: kyc.v1.IdentityDocument
+	(*DocumentFile)(nil),             // 22: kyc.v1.DocumentFile
+	(*CryptoAddress)(nil),            // 23: kyc.v1.CryptoAddress
+	(*WalletOwnershipProof)(nil),     // 24: kyc.v1.WalletOwnershipProof
+	(*FundsDeclaration)(nil),         // 25: kyc.v1.FundsDeclaration
+	(*BeneficialOwner)(nil),          // 26: kyc.v1.BeneficialOwner
+	nil,                              // 27: kyc.v1.TransactionRisk.AddressRisksEntry
+	(*timestamppb.Timestamp)(nil),    // 28: google.protobuf.Timestamp
+}
+var file_kyc_proto_depIdxs = []int32{
+	15, // 0: kyc.v1.CheckCustomerRequest.user_data:type_name -> kyc.v1.UserData
+	2,  // 1: kyc.v1.WatchStatusRequest.check:type_name -> kyc.v1.CheckStatusRequest
+	5,  // 2: kyc.v1.CheckResponse.result:type_name -> kyc.v1.Result
+	6,  // 3: kyc.v1.Result.details:type_name -> kyc.v1.Details
+	7,  // 4: kyc.v1.Result.status_check:type_name -> kyc.v1.StatusCheck
+	28, // 5: kyc.v1.StatusCheck.last_check:type_name -> google.protobuf.Timestamp
+	11, // 6: kyc.v1.ListProvidersResponse.providers:type_name -> kyc.v1.Provider
+	27, // 7: kyc.v1.TransactionRisk.address_risks:type_name -> kyc.v1.TransactionRisk.AddressRisksEntry
+	0,  // 8: kyc.v1.UserData.gender:type_name -> kyc.v1.Gender
+	28, // 9: kyc.v1.UserData.date_of_birth:type_name -> google.protobuf.Timestamp
+	16, // 10: kyc.v1.UserData.current_address:type_name -> kyc.v1.Address
+	16, // 11: kyc.v1.UserData.supplemental_addresses:type_name -> kyc.v1.Address
+	17, // 12: kyc.v1.UserData.location:type_name -> kyc.v1.Location
+	18, // 13: kyc.v1.UserData.business:type_name -> kyc.v1.Business
+	19, // 14: kyc.v1.UserData.passport:type_name -> kyc.v1.Passport
+	21, // 15: kyc.v1.UserData.id_card:type_name -> kyc.v1.IdentityDocument
+	21, // 16: kyc.v1.UserData.snils:type_name -> kyc.v1.IdentityDocument
+	21, // 17: kyc.v1.UserData.health_id:type_name -> kyc.v1.IdentityDocument
+	21, // 18: kyc.v1.UserData.social_service_id:type_name -> kyc.v1.IdentityDocument
+	21, // 19: kyc.v1.UserData.tax_id:type_name -> kyc.v1.IdentityDocument
+	20, // 20: kyc.v1.UserData.driver_license:type_name -> kyc.v1.DriverLicense
+	20, // 21: kyc.v1.UserData.driver_license_translation:type_name -> kyc.v1.DriverLicense
+	21, // 22: kyc.v1.UserData.credit_card:type_name -> kyc.v1.IdentityDocument
+	21, // 23: kyc.v1.UserData.debit_card:type_name -> kyc.v1.IdentityDocument
+	21, // 24: kyc.v1.UserData.utility_bill:type_name -> kyc.v1.IdentityDocument
+	21, // 25: kyc.v1.UserData.residence_permit:type_name -> kyc.v1.IdentityDocument
+	22, // 26: kyc.v1.UserData.agreement:type_name -> kyc.v1.DocumentFile
+	21, // 27: kyc.v1.UserData.employment_certificate:type_name -> kyc.v1.IdentityDocument
+	22, // 28: kyc.v1.UserData.contract:type_name -> kyc.v1.DocumentFile
+	22, // 29: kyc.v1.UserData.document_photo:type_name -> kyc.v1.DocumentFile
+	22, // 30: kyc.v1.UserData.selfie:type_name -> kyc.v1.DocumentFile
+	22, // 31: kyc.v1.UserData.avatar:type_name -> kyc.v1.DocumentFile
+	21, // 32: kyc.v1.UserData.other:type_name -> kyc.v1.IdentityDocument
+	22, // 33: kyc.v1.UserData.video_auth:type_name -> kyc.v1.DocumentFile
+	21, // 34: kyc.v1.UserData.document:type_name -> kyc.v1.IdentityDocument
+	23, // 35: kyc.v1.UserData.crypto_addresses:type_name -> kyc.v1.CryptoAddress
+	25, // 36: kyc.v1.UserData.source_of_wealth:type_name -> kyc.v1.FundsDeclaration
+	25, // 37: kyc.v1.UserData.source_of_funds:type_name -> kyc.v1.FundsDeclaration
+	26, // 38: kyc.v1.UserData.beneficial_owners:type_name -> kyc.v1.BeneficialOwner
+	22, // 39: kyc.v1.UserData.company_board:type_name -> kyc.v1.DocumentFile
+	22, // 40: kyc.v1.UserData.company_registration:type_name -> kyc.v1.DocumentFile
+	28, // 41: kyc.v1.Address.start_date:type_name -> google.protobuf.Timestamp
+	28, // 42: kyc.v1.Address.end_date:type_name -> google.protobuf.Timestamp
+	28, // 43: kyc.v1.Business.incorporation_date:type_name -> google.protobuf.Timestamp
+	28, // 44: kyc.v1.Passport.issued_date:type_name -> google.protobuf.Timestamp
+	28, // 45: kyc.v1.Passport.valid_until:type_name -> google.protobuf.Timestamp
+	22, // 46: kyc.v1.Passport.image:type_name -> kyc.v1.DocumentFile
+	28, // 47: kyc.v1.DriverLicense.issued_date:type_name -> google.protobuf.Timestamp
+	28, // 48: kyc.v1.DriverLicense.valid_until:type_name -> google.protobuf.Timestamp
+	22, // 49: kyc.v1.DriverLicense.front_image:type_name -> kyc.v1.DocumentFile
+	22, // 50: kyc.v1.DriverLicense.back_image:type_name -> kyc.v1.DocumentFile
+	28, // 51: kyc.v1.IdentityDocument.issued_date:type_name -> google.protobuf.Timestamp
+	28, // 52: kyc.v1.IdentityDocument.valid_until:type_name -> google.protobuf.Timestamp
+	22, // 53: kyc.v1.IdentityDocument.image:type_name -> kyc.v1.DocumentFile
+	24, // 54: kyc.v1.CryptoAddress.ownership_proof:type_name -> kyc.v1.WalletOwnershipProof
+	28, // 55: kyc.v1.WalletOwnershipProof.verified_at:type_name -> google.protobuf.Timestamp
+	28, // 56: kyc.v1.BeneficialOwner.date_of_birth:type_name -> google.protobuf.Timestamp
+	16, // 57: kyc.v1.BeneficialOwner.address:type_name -> kyc.v1.Address
+	25, // 58: kyc.v1.BeneficialOwner.source_of_wealth:type_name -> kyc.v1.FundsDeclaration
+	14, // 59: kyc.v1.TransactionRisk.AddressRisksEntry.value:type_name -> kyc.v1.AddressRisk
+	1,  // 60: kyc.v1.KYC.CheckCustomer:input_type -> kyc.v1.CheckCustomerRequest
+	2,  // 61: kyc.v1.KYC.CheckStatus:input_type -> kyc.v1.CheckStatusRequest
+	3,  // 62: kyc.v1.KYC.WatchStatus:input_type -> kyc.v1.WatchStatusRequest
+	8,  // 63: kyc.v1.KYC.ListProviders:input_type -> kyc.v1.ListProvidersRequest
+	10, // 64: kyc.v1.KYC.GetProvider:input_type -> kyc.v1.GetProviderRequest
+	12, // 65: kyc.v1.KYC.ScreenTransaction:input_type -> kyc.v1.ScreenTransactionRequest
+	4,  // 66: kyc.v1.KYC.CheckCustomer:output_type -> kyc.v1.CheckResponse
+	4,  // 67: kyc.v1.KYC.CheckStatus:output_type -> kyc.v1.CheckResponse
+	4,  // 68: kyc.v1.KYC.WatchStatus:output_type -> kyc.v1.CheckResponse
+	9,  // 69: kyc.v1.KYC.ListProviders:output_type -> kyc.v1.ListProvidersResponse
+	11, // 70: kyc.v1.KYC.GetProvider:output_type -> kyc.v1.Provider
+	13, // 71: kyc.v1.KYC.ScreenTransaction:output_type -> kyc.v1.TransactionRisk
+	66, // [66:72] is the sub-list for method output_type
+	60, // [60:66] is the sub-list for method input_type
+	60, // [60:60] is the sub-list for extension type_name
+	60, // [60:60] is the sub-list for extension extendee
+	0,  // [0:60] is the sub-list for field type_name
+}
+
+func init() { file_kyc_proto_init() }
+func file_kyc_proto_init() {
+	if File_kyc_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_kyc_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckCustomerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Details); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*StatusCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ListProvidersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ListProvidersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*GetProviderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*Provider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ScreenTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionRisk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*AddressRisk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*UserData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*Address); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*Business); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*Passport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*DriverLicense); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*IdentityDocument); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*DocumentFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*CryptoAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*WalletOwnershipProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*FundsDeclaration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*BeneficialOwner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_kyc_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   27,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_kyc_proto_goTypes,
+		DependencyIndexes: file_kyc_proto_depIdxs,
+		EnumInfos:         file_kyc_proto_enumTypes,
+		MessageInfos:      file_kyc_proto_msgTypes,
+	}.Build()
+	File_kyc_proto = out.File
+	file_kyc_proto_rawDesc = nil
+	file_kyc_proto_goTypes = nil
+	file_kyc_proto_depIdxs = nil
+}
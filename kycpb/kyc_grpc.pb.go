@@ -0,0 +1,336 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: kyc.proto
+
+package kycpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	KYC_CheckCustomer_FullMethodName     = "/kyc.v1.KYC/CheckCustomer"
+	KYC_CheckStatus_FullMethodName       = "/kyc.v1.KYC/CheckStatus"
+	KYC_WatchStatus_FullMethodName       = "/kyc.v1.KYC/WatchStatus"
+	KYC_ListProviders_FullMethodName     = "/kyc.v1.KYC/ListProviders"
+	KYC_GetProvider_FullMethodName       = "/kyc.v1.KYC/GetProvider"
+	KYC_ScreenTransaction_FullMethodName = "/kyc.v1.KYC/ScreenTransaction"
+)
+
+// KYCClient is the client API for KYC service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type KYCClient interface {
+	// CheckCustomer verifies the customer by the KYC provider.
+	CheckCustomer(ctx context.Context, in *CheckCustomerRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	// CheckStatus checks the status of the verification once.
+	CheckStatus(ctx context.Context, in *CheckStatusRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	// WatchStatus polls the status of the verification streaming every change
+	// until the verification is completed or the client cancels the call.
+	WatchStatus(ctx context.Context, in *WatchStatusRequest, opts ...grpc.CallOption) (KYC_WatchStatusClient, error)
+	// ListProviders lists the implemented KYC providers.
+	ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error)
+	// GetProvider retrieves the KYC provider.
+	GetProvider(ctx context.Context, in *GetProviderRequest, opts ...grpc.CallOption) (*Provider, error)
+	// ScreenTransaction checks the risk of the crypto transaction by CipherTrace.
+	ScreenTransaction(ctx context.Context, in *ScreenTransactionRequest, opts ...grpc.CallOption) (*TransactionRisk, error)
+}
+
+type kYCClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKYCClient(cc grpc.ClientConnInterface) KYCClient {
+	return &kYCClient{cc}
+}
+
+func (c *kYCClient) CheckCustomer(ctx context.Context, in *CheckCustomerRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, KYC_CheckCustomer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kYCClient) CheckStatus(ctx context.Context, in *CheckStatusRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, KYC_CheckStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kYCClient) WatchStatus(ctx context.Context, in *WatchStatusRequest, opts ...grpc.CallOption) (KYC_WatchStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &KYC_ServiceDesc.Streams[0], KYC_WatchStatus_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &kYCWatchStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type KYC_WatchStatusClient interface {
+	Recv() (*CheckResponse, error)
+	grpc.ClientStream
+}
+
+type kYCWatchStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *kYCWatchStatusClient) Recv() (*CheckResponse, error) {
+	m := new(CheckResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *kYCClient) ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error) {
+	out := new(ListProvidersResponse)
+	err := c.cc.Invoke(ctx, KYC_ListProviders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kYCClient) GetProvider(ctx context.Context, in *GetProviderRequest, opts ...grpc.CallOption) (*Provider, error) {
+	out := new(Provider)
+	err := c.cc.Invoke(ctx, KYC_GetProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kYCClient) ScreenTransaction(ctx context.Context, in *ScreenTransactionRequest, opts ...grpc.CallOption) (*TransactionRisk, error) {
+	out := new(TransactionRisk)
+	err := c.cc.Invoke(ctx, KYC_ScreenTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KYCServer is the server API for KYC service.
+// All implementations must embed UnimplementedKYCServer
+// for forward compatibility
+type KYCServer interface {
+	// CheckCustomer verifies the customer by the KYC provider.
+	CheckCustomer(context.Context, *CheckCustomerRequest) (*CheckResponse, error)
+	// CheckStatus checks the status of the verification once.
+	CheckStatus(context.Context, *CheckStatusRequest) (*CheckResponse, error)
+	// WatchStatus polls the status of the verification streaming every change
+	// until the verification is completed or the client cancels the call.
+	WatchStatus(*WatchStatusRequest, KYC_WatchStatusServer) error
+	// ListProviders lists the implemented KYC providers.
+	ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error)
+	// GetProvider retrieves the KYC provider.
+	GetProvider(context.Context, *GetProviderRequest) (*Provider, error)
+	// ScreenTransaction checks the risk of the crypto transaction by CipherTrace.
+	ScreenTransaction(context.Context, *ScreenTransactionRequest) (*TransactionRisk, error)
+	mustEmbedUnimplementedKYCServer()
+}
+
+// UnimplementedKYCServer must be embedded to have forward compatible implementations.
+type UnimplementedKYCServer struct {
+}
+
+func (UnimplementedKYCServer) CheckCustomer(context.Context, *CheckCustomerRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCustomer not implemented")
+}
+func (UnimplementedKYCServer) CheckStatus(context.Context, *CheckStatusRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckStatus not implemented")
+}
+func (UnimplementedKYCServer) WatchStatus(*WatchStatusRequest, KYC_WatchStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStatus not implemented")
+}
+func (UnimplementedKYCServer) ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProviders not implemented")
+}
+func (UnimplementedKYCServer) GetProvider(context.Context, *GetProviderRequest) (*Provider, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProvider not implemented")
+}
+func (UnimplementedKYCServer) ScreenTransaction(context.Context, *ScreenTransactionRequest) (*TransactionRisk, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScreenTransaction not implemented")
+}
+func (UnimplementedKYCServer) mustEmbedUnimplementedKYCServer() {}
+
+// UnsafeKYCServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KYCServer will
+// result in compilation errors.
+type UnsafeKYCServer interface {
+	mustEmbedUnimplementedKYCServer()
+}
+
+func RegisterKYCServer(s grpc.ServiceRegistrar, srv KYCServer) {
+	s.RegisterService(&KYC_ServiceDesc, srv)
+}
+
+func _KYC_CheckCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KYCServer).CheckCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KYC_CheckCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KYCServer).CheckCustomer(ctx, req.(*CheckCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KYC_CheckStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KYCServer).CheckStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KYC_CheckStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KYCServer).CheckStatus(ctx, req.(*CheckStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KYC_WatchStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KYCServer).WatchStatus(m, &kYCWatchStatusServer{stream})
+}
+
+type KYC_WatchStatusServer interface {
+	Send(*CheckResponse) error
+	grpc.ServerStream
+}
+
+type kYCWatchStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *kYCWatchStatusServer) Send(m *CheckResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _KYC_ListProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KYCServer).ListProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KYC_ListProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KYCServer).ListProviders(ctx, req.(*ListProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KYC_GetProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KYCServer).GetProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KYC_GetProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KYCServer).GetProvider(ctx, req.(*GetProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KYC_ScreenTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScreenTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KYCServer).ScreenTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KYC_ScreenTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KYCServer).ScreenTransaction(ctx, req.(*ScreenTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// KYC_ServiceDesc is the grpc.ServiceDesc for KYC service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var KYC_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kyc.v1.KYC",
+	HandlerType: (*KYCServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckCustomer",
+			Handler:    _KYC_CheckCustomer_Handler,
+		},
+		{
+			MethodName: "CheckStatus",
+			Handler:    _KYC_CheckStatus_Handler,
+		},
+		{
+			MethodName: "ListProviders",
+			Handler:    _KYC_ListProviders_Handler,
+		},
+		{
+			MethodName: "GetProvider",
+			Handler:    _KYC_GetProvider_Handler,
+		},
+		{
+			MethodName: "ScreenTransaction",
+			Handler:    _KYC_ScreenTransaction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStatus",
+			Handler:       _KYC_WatchStatus_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "kyc.proto",
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"modulus/kyc"
+	"modulus/kyc/batch"
+	"modulus/kyc/common"
+	"modulus/kyc/main/config"
+)
+
+// Exit codes of the CLI commands.
+const (
+	exitOK      = 0
+	exitFailure = 1
+	exitUsage   = 2
+)
+
+const usage = `Usage:
+  kyc [-config file] [-port port]  start the KYC service
+  kyc config check [file]          validate the config and report all the problems found
+  kyc config show [file]           print the effective config with the secrets masked
+  kyc config diff a b              print the differences between two configs
+  kyc batch [flags] file           verify the customers from the CSV or NDJSON file
+
+Flags of the batch command:
+  -config file       the config file with the provider settings
+  -provider name     the KYC provider (required)
+  -instance name     the named config instance of the provider
+  -concurrency n     the number of the customers verified at once
+  -rate n            the maximum number of the verifications started per second, up to 1000
+  -format csv|ndjson the format of the file and the results, taken from the file names by default
+  -o file            the results file, the standard output by default
+`
+
+// runCommand runs the CLI command specified by the arguments.
+// It returns false if the arguments don't contain a command, so the service should be started.
+func runCommand(args []string, stdout, stderr io.Writer) (code int, ok bool) {
+	if len(args) == 0 || (args[0] != "config" && args[0] != "batch") {
+		return
+	}
+	ok = true
+
+	if args[0] == "batch" {
+		code = runBatch(args[1:], stdout, stderr)
+		return
+	}
+
+	if len(args) < 2 {
+		fmt.Fprint(stderr, usage)
+		code = exitUsage
+		return
+	}
+
+	switch cmd, files := args[1], args[2:]; {
+	case cmd == "check" && len(files) <= 1:
+		code = checkConfig(configFile(files), stdout, stderr)
+	case cmd == "show" && len(files) <= 1:
+		code = showConfig(configFile(files), stdout, stderr)
+	case cmd == "diff" && len(files) == 2:
+		code = diffConfigs(files[0], files[1], stdout, stderr)
+	default:
+		fmt.Fprint(stderr, usage)
+		code = exitUsage
+	}
+
+	return
+}
+
+// configFile returns the config file from the command arguments or the default one.
+func configFile(files []string) string {
+	if len(files) > 0 {
+		return files[0]
+	}
+	return defaultConfigFile()
+}
+
+// checkConfig validates the config file and prints all the problems found.
+func checkConfig(filename string, stdout, stderr io.Writer) int {
+	cfg, err := config.ReadFile(filename)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %s\n", filename, err)
+		return exitFailure
+	}
+
+	errs := config.Validate(cfg)
+	for _, err := range errs {
+		fmt.Fprintf(stderr, "%s: %s\n", filename, err)
+	}
+	if len(errs) > 0 {
+		fmt.Fprintf(stderr, "%s: %d problem(s) found\n", filename, len(errs))
+		return exitFailure
+	}
+
+	fmt.Fprintf(stdout, "%s: OK\n", filename)
+
+	return exitOK
+}
+
+// showConfig prints the config file in the INI-like format with the secrets masked.
+func showConfig(filename string, stdout, stderr io.Writer) int {
+	cfg, err := config.ReadFile(filename)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %s\n", filename, err)
+		return exitFailure
+	}
+
+	fmt.Fprint(stdout, cfg.Redacted())
+
+	return exitOK
+}
+
+// diffConfigs prints the differences between the config files.
+// Like diff(1) it exits with 1 if the configs differ.
+func diffConfigs(a, b string, stdout, stderr io.Writer) int {
+	cfgA, err := config.ReadFile(a)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %s\n", a, err)
+		return exitUsage
+	}
+	cfgB, err := config.ReadFile(b)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %s\n", b, err)
+		return exitUsage
+	}
+
+	changes := config.Diff(cfgA, cfgB)
+	for _, change := range changes {
+		fmt.Fprintln(stdout, change)
+	}
+	if len(changes) > 0 {
+		return exitFailure
+	}
+
+	return exitOK
+}
+
+// runBatch verifies the customers from the file like the /batches endpoint does and writes the results.
+// It exits with 1 if any customer couldn't be verified.
+func runBatch(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("batch", flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+	configFilename := flags.String("config", defaultConfigFile(), "")
+	provider := flags.String("provider", "", "")
+	instance := flags.String("instance", "", "")
+	concurrency := flags.Int("concurrency", batch.DefaultConcurrency, "")
+	rate := flags.Float64("rate", 0, "")
+	formatName := flags.String("format", "", "")
+	output := flags.String("o", "", "")
+
+	if err := flags.Parse(args); err != nil || flags.NArg() != 1 || len(*provider) == 0 || *concurrency < 1 || (*rate != 0 && !batch.ValidRate(*rate)) {
+		fmt.Fprint(stderr, usage)
+		return exitUsage
+	}
+	filename := flags.Arg(0)
+
+	format, ok := batch.FormatFromFilename(filename)
+	if len(*formatName) > 0 {
+		format, ok = batch.ParseFormat(*formatName)
+	}
+	if !ok {
+		fmt.Fprintf(stderr, "%s: unknown batch format, use -format csv or -format ndjson\n", filename)
+		return exitUsage
+	}
+
+	service, err := batchService(*configFilename, common.KYCProvider(*provider), *instance)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitFailure
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitFailure
+	}
+	customers, err := batch.ReadCustomers(file, format)
+	file.Close()
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %s\n", filename, err)
+		return exitFailure
+	}
+
+	b := batch.Start(context.Background(), common.Batch{Provider: common.KYCProvider(*provider), Instance: *instance}, customers,
+		func(ctx context.Context, customer *common.UserData) common.KYCResponse {
+			return common.NewKYCResponse(service.CheckCustomer(ctx, common.KYCProvider(*provider), customer, kyc.WithInstance(*instance)))
+		}, batch.Options{Concurrency: *concurrency, Rate: *rate})
+	<-b.Done()
+
+	out := stdout
+	if len(*output) > 0 {
+		if outputFormat, ok := batch.FormatFromFilename(*output); ok && len(*formatName) == 0 {
+			format = outputFormat
+		}
+
+		file, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitFailure
+		}
+		defer file.Close()
+		out = file
+	}
+	if err := batch.WriteResults(out, b.Rows(), format); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitFailure
+	}
+
+	info := b.Info()
+	fmt.Fprintf(stderr, "%s: %d customer(s) processed, %d succeeded, %d failed\n", filename, info.Processed, info.Succeeded, info.Failed)
+	if info.Failed > 0 {
+		return exitFailure
+	}
+
+	return exitOK
+}
+
+// batchService builds the KYC library from the config file and checks the provider instance can verify customers.
+func batchService(filename string, provider common.KYCProvider, instance string) (service *kyc.Service, err error) {
+	// The example provider needs no config.
+	cfg := config.Config{}
+	if provider != common.Example {
+		if cfg, err = config.ReadFile(filename); err != nil {
+			err = fmt.Errorf("%s: %s", filename, err)
+			return
+		}
+	}
+
+	library, errs := cfg.Library()
+	if err = errs[config.SectionName(provider, instance)]; err != nil {
+		err = fmt.Errorf("%s: %s", filename, err)
+		return
+	}
+
+	service = kyc.New(library)
+	_, err = service.CustomerChecker(provider, kyc.WithInstance(instance))
+
+	return
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "kyc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	valid := filepath.Join(dir, "valid.cfg")
+	require.NoError(t, ioutil.WriteFile(valid, []byte("[Jumio]\nBaseURL=https://netverify.com\nToken=token\nSecret=secret\n"), 0644))
+
+	invalid := filepath.Join(dir, "invalid.yaml")
+	require.NoError(t, ioutil.WriteFile(invalid, []byte("Jumio:\n  BaseURL: https://lon.netverify.com\nSum&Substance:\n  Host: host\n"), 0644))
+
+	run := func(args ...string) (code int, ok bool, stdout, stderr string) {
+		out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+		code, ok = runCommand(args, out, errOut)
+		return code, ok, out.String(), errOut.String()
+	}
+
+	// Testing service arguments.
+	_, ok, _, _ := run("-port", "8080")
+
+	assert.False(ok)
+
+	// Testing usage errors.
+	code, ok, _, stderr := run("config")
+
+	assert.True(ok)
+	assert.Equal(exitUsage, code)
+	assert.Contains(stderr, "Usage:")
+
+	code, _, _, _ = run("config", "diff", valid)
+
+	assert.Equal(exitUsage, code)
+
+	// Testing check.
+	code, _, stdout, _ := run("config", "check", valid)
+
+	assert.Equal(exitOK, code)
+	assert.Equal(valid+": OK\n", stdout)
+
+	code, _, _, stderr = run("config", "check", invalid)
+
+	assert.Equal(exitFailure, code)
+	assert.Equal(invalid+": Jumio configuration error: missing or empty option 'Token'\n"+
+		invalid+": Jumio configuration error: missing or empty option 'Secret'\n"+
+		invalid+": Sum&Substance configuration error: missing or empty option 'APIKey'\n"+
+		invalid+": 3 problem(s) found\n", stderr)
+
+	code, _, _, stderr = run("config", "check", filepath.Join(dir, "missing.cfg"))
+
+	assert.Equal(exitFailure, code)
+	assert.Contains(stderr, "no such file or directory")
+
+	// Testing show.
+	code, _, stdout, _ = run("config", "show", valid)
+
+	assert.Equal(exitOK, code)
+	assert.Equal("[Jumio]\nBaseURL=https://netverify.com\nSecret=********\nToken=********\n", stdout)
+
+	// Testing diff.
+	code, _, stdout, _ = run("config", "diff", valid, valid)
+
+	assert.Equal(exitOK, code)
+	assert.Empty(stdout)
+
+	code, _, stdout, _ = run("config", "diff", valid, invalid)
+
+	assert.Equal(exitFailure, code)
+	assert.Equal("~ [Jumio] BaseURL: https://netverify.com -> https://lon.netverify.com\n"+
+		"- [Jumio] Secret=********\n"+
+		"- [Jumio] Token=********\n"+
+		"+ [Sum&Substance] Host=host\n", stdout)
+}
+
+func TestRunBatch(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "kyc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "customers.csv")
+	require.NoError(t, ioutil.WriteFile(input, []byte("FirstName,CurrentAddress.Town\nAbby,London\nUrbi,Paris\n"), 0644))
+
+	run := func(args ...string) (code int, stdout, stderr string) {
+		out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+		code, _ = runCommand(append([]string{"batch"}, args...), out, errOut)
+		return code, out.String(), errOut.String()
+	}
+
+	// Testing usage errors.
+	code, _, stderr := run(input)
+
+	assert.Equal(exitUsage, code)
+	assert.Contains(stderr, "Usage:")
+
+	code, _, stderr = run("-provider", "Example", filepath.Join(dir, "customers.txt"))
+
+	assert.Equal(exitUsage, code)
+	assert.Contains(stderr, "unknown batch format")
+
+	code, _, stderr = run("-provider", "Foo", input)
+
+	assert.Equal(exitFailure, code)
+	assert.Contains(stderr, "unknown KYC provider: Foo")
+
+	// Testing the results.
+	code, stdout, stderr := run("-provider", "Example", "-format", "csv", input)
+
+	assert.Equal(exitOK, code)
+	assert.Equal("Row,Status,KYCStatus,RiskLevel,ErrorCode,ReferenceID,ErrorCategory,Retryable,Error\n"+
+		"1,Succeeded,Approved,Unknown,,,,,\n"+
+		"2,Succeeded,Unclear,Unknown,,lily_was_here,,,\n", stdout)
+	assert.Equal(input+": 2 customer(s) processed, 2 succeeded, 0 failed\n", stderr)
+
+	output := filepath.Join(dir, "results.ndjson")
+	require.NoError(t, ioutil.WriteFile(input, []byte("FirstName\nErika\n"), 0644))
+
+	code, stdout, _ = run("-provider", "Example", "-concurrency", "1", "-o", output, input)
+
+	assert.Equal(exitFailure, code)
+	assert.Empty(stdout)
+
+	results, err := ioutil.ReadFile(output)
+	require.NoError(t, err)
+	assert.Contains(string(results), `"Row":1,"Status":"Failed"`)
+}
@@ -0,0 +1,42 @@
+package config
+
+import (
+	"time"
+
+	"modulus/kyc/common"
+)
+
+// cachingProviders lists the providers the verification results can be cached for. They are the sanctions screening
+// ones, their results depend on the fingerprinted customer data only, and the Example one simulating them.
+// The results of the providers checking the document images and the selfies mustn't be reused for another person.
+var cachingProviders = map[common.KYCProvider]bool{
+	common.ComplyAdvantage: true,
+	common.ThomsonReuters:  true,
+	common.Example:         true,
+}
+
+// Caching represents the result caching options of the KYC provider config section.
+type Caching struct {
+	// CacheTTL turns the caching of the verification results on for the time specified.
+	// The results are cached by the fingerprints of the customer data.
+	CacheTTL time.Duration
+}
+
+// Caching returns the result caching options of the config section. The caching is off if the option is missing
+// or the options are invalid, e.g. the provider isn't a screening one.
+func (c Config) Caching(section string) (caching Caching, err error) {
+	if err = c.Decode(section, &caching); err != nil {
+		return Caching{}, err
+	}
+
+	if provider, _ := SplitSectionName(section); caching.CacheTTL != 0 && !cachingProviders[provider] {
+		return Caching{}, ErrInvalidOption{
+			provider: section,
+			option:   "CacheTTL",
+			value:    caching.CacheTTL.String(),
+			err:      "the results of the sanctions screening providers only can be cached",
+		}
+	}
+
+	return
+}
@@ -1,5 +1,7 @@
 package config
 
+import "sort"
+
 const (
 	// ServiceSection is the hardcoded value of the KYC service config section name.
 	ServiceSection = "Config"
@@ -8,10 +10,6 @@ const (
 	DefaultPort = "8080"
 )
 
-// Cfg holds the current config for the KYC service.
-// Beware that it isn't concurrent writes safe.
-var Cfg Config
-
 // Options represents the configuration options for the KYC provider.
 type Options map[string]string
 
@@ -31,6 +29,20 @@ func (c Config) Option(section, option string) (opt string) {
 	return
 }
 
+// Clone returns the deep copy of the config.
+func (c Config) Clone() Config {
+	clone := make(Config, len(c))
+	for section, options := range c {
+		opts := make(Options, len(options))
+		for key, value := range options {
+			opts[key] = value
+		}
+		clone[section] = opts
+	}
+
+	return clone
+}
+
 // ServicePort returns the KYC service port.
 func (c Config) ServicePort() (port string) {
 	if port = c.Option(ServiceSection, "Port"); len(port) == 0 {
@@ -38,3 +50,31 @@ func (c Config) ServicePort() (port string) {
 	}
 	return
 }
+
+// AdminToken returns the token authorizing requests to the admin API.
+// The admin API is disabled if the token is empty.
+func (c Config) AdminToken() string {
+	return c.Option(ServiceSection, "AdminToken")
+}
+
+// sectionNames returns the sorted names of the config sections.
+func (c Config) sectionNames() []string {
+	names := make([]string, 0, len(c))
+	for name := range c {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// keys returns the sorted names of the options.
+func (o Options) keys() []string {
+	keys := make([]string, 0, len(o))
+	for key := range o {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
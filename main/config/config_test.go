@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"modulus/kyc/main/config"
 
@@ -46,3 +47,50 @@ func TestServicePort(t *testing.T) {
 	port = cfg.ServicePort()
 	assert.Equal("8999", port)
 }
+
+func TestServer(t *testing.T) {
+	assert := assert.New(t)
+
+	server, err := config.Config{}.Server()
+
+	assert.NoError(err)
+	assert.Equal(config.DefaultReadTimeout, server.ReadTimeout)
+	assert.Equal(config.DefaultWriteTimeout, server.WriteTimeout)
+	assert.Equal(config.DefaultIdleTimeout, server.IdleTimeout)
+	assert.Equal(config.DefaultShutdownTimeout, server.ShutdownTimeout)
+	assert.Zero(server.ShutdownDelay)
+	assert.False(server.TLS())
+
+	server, err = config.Config{
+		config.ServiceSection: config.Options{
+			"ReadTimeout":   "5s",
+			"ShutdownDelay": "10s",
+			"TLSCertFile":   "tls.crt",
+			"TLSKeyFile":    "tls.key",
+		},
+	}.Server()
+
+	assert.NoError(err)
+	assert.Equal(5*time.Second, server.ReadTimeout)
+	assert.Equal(config.DefaultWriteTimeout, server.WriteTimeout)
+	assert.Equal(10*time.Second, server.ShutdownDelay)
+	assert.True(server.TLS())
+
+	_, err = config.Config{
+		config.ServiceSection: config.Options{
+			"IdleTimeout": "forever",
+		},
+	}.Server()
+
+	assert.Error(err)
+	assert.Equal(`Config configuration error: invalid value 'forever' of option 'IdleTimeout': time: invalid duration "forever"`, err.Error())
+
+	_, err = config.Config{
+		config.ServiceSection: config.Options{
+			"TLSCertFile": "tls.crt",
+		},
+	}.Server()
+
+	assert.Error(err)
+	assert.Equal("Config configuration error: missing or empty option 'TLSKeyFile'", err.Error())
+}
@@ -0,0 +1,41 @@
+package config
+
+import "encoding/hex"
+
+// CustomersSection is the name of the customer profiles config section.
+const CustomersSection = "Customers"
+
+// Customers represents the options of the customer profiles config section.
+type Customers struct {
+	// Dir is the directory keeping the customer profiles. The profiles are kept in memory only if it's empty.
+	Dir string
+	// Key is the hex-encoded AES-256 key encrypting the profiles at rest.
+	Key string
+}
+
+// Persistent tells whether the customer profiles are kept on disk.
+func (c Customers) Persistent() bool {
+	return len(c.Dir) > 0
+}
+
+// KeyBytes returns the decoded encryption key.
+func (c Customers) KeyBytes() []byte {
+	key, _ := hex.DecodeString(c.Key)
+	return key
+}
+
+// Customers returns the customer profiles options.
+func (c Config) Customers() (customers Customers, err error) {
+	if err = c.Decode(CustomersSection, &customers); err != nil || !customers.Persistent() {
+		return
+	}
+
+	switch key, err1 := hex.DecodeString(customers.Key); {
+	case len(customers.Key) == 0:
+		err = ErrMissingOption{provider: CustomersSection, option: "Key"}
+	case err1 != nil || len(key) != encryptionKeySize:
+		err = ErrInvalidOption{provider: CustomersSection, option: "Key", value: "***", err: "64 hex digits expected"}
+	}
+
+	return
+}
@@ -0,0 +1,125 @@
+package config
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// listSep separates the items of list options.
+const listSep = ","
+
+// durationType is used to tell time.Duration options from the plain integer ones.
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Decode fills the struct pointed by v with the options of the specified config section.
+// Every exported field of the struct is filled from the option of the same name
+// unless the name is overridden by the "config" field tag. The tag value "-" skips the field.
+// Missing or empty options leave the fields intact.
+//
+// Supported field types are string, bool, integers, floats, time.Duration and []string.
+// Lists are the comma separated option values.
+func (c Config) Decode(section string, v interface{}) (err error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		err = errors.New("config decoding target must be a non-nil pointer to a struct")
+		return
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+
+	options := c[section]
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if len(field.PkgPath) > 0 {
+			// Skip unexported fields.
+			continue
+		}
+
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("config"); ok {
+			if tag == "-" {
+				continue
+			}
+			if len(tag) > 0 {
+				name = tag
+			}
+		}
+
+		value := strings.TrimSpace(options[name])
+		if len(value) == 0 {
+			continue
+		}
+
+		if err1 := setValue(rv.Field(i), value); err1 != nil {
+			err = ErrInvalidOption{
+				provider: section,
+				option:   name,
+				value:    value,
+				err:      err1.Error(),
+			}
+			return
+		}
+	}
+
+	return
+}
+
+// setValue converts the option value into the type of the field and sets it.
+func setValue(field reflect.Value, value string) (err error) {
+	if field.Type() == durationType {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return errors.New("boolean expected")
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return errors.New("integer expected")
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return errors.New("unsigned integer expected")
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return errors.New("number expected")
+		}
+		field.SetFloat(f)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return errors.New("unsupported option type: " + field.Type().String())
+		}
+		items := strings.Split(value, listSep)
+		list := reflect.MakeSlice(field.Type(), 0, len(items))
+		for _, item := range items {
+			if item = strings.TrimSpace(item); len(item) > 0 {
+				list = reflect.Append(list, reflect.ValueOf(item).Convert(field.Type().Elem()))
+			}
+		}
+		field.Set(list)
+	default:
+		err = errors.New("unsupported option type: " + field.Type().String())
+	}
+
+	return
+}
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"modulus/kyc/common"
+	"modulus/kyc/integrations/complyadvantage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testSection struct {
+	Name     string
+	Enabled  bool
+	Retries  int
+	Limit    uint16
+	Ratio    float64
+	Timeout  time.Duration
+	Hosts    []string
+	Renamed  string `config:"Alias"`
+	Skipped  string `config:"-"`
+	internal string
+}
+
+func TestDecode(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg := Config{
+		"Test": Options{
+			"Name":     "name",
+			"Enabled":  "true",
+			"Retries":  "-3",
+			"Limit":    "65535",
+			"Ratio":    "0.25",
+			"Timeout":  "1m30s",
+			"Hosts":    "alpha, beta,,gamma",
+			"Alias":    "renamed",
+			"Skipped":  "skipped",
+			"internal": "internal",
+		},
+	}
+
+	section := testSection{Skipped: "default"}
+
+	err := cfg.Decode("Test", &section)
+
+	assert.NoError(err)
+	assert.Equal(testSection{
+		Name:    "name",
+		Enabled: true,
+		Retries: -3,
+		Limit:   65535,
+		Ratio:   0.25,
+		Timeout: 90 * time.Second,
+		Hosts:   []string{"alpha", "beta", "gamma"},
+		Renamed: "renamed",
+		Skipped: "default",
+	}, section)
+
+	section = testSection{Name: "default"}
+
+	err = cfg.Decode("Missing", &section)
+
+	assert.NoError(err)
+	assert.Equal(testSection{Name: "default"}, section)
+
+	ca := complyadvantage.Config{}
+
+	err = Config{
+		string(common.ComplyAdvantage): Options{
+			"Host":      "host",
+			"APIkey":    "key",
+			"Fuzziness": "0.5",
+		},
+	}.Decode(string(common.ComplyAdvantage), &ca)
+
+	assert.NoError(err)
+	assert.Equal(complyadvantage.Config{Host: "host", APIkey: "key", Fuzziness: 0.5}, ca)
+}
+
+func TestDecodeErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	err := Config{}.Decode("Test", testSection{})
+
+	assert.Error(err)
+	assert.Equal("config decoding target must be a non-nil pointer to a struct", err.Error())
+
+	for option, value := range map[string]string{
+		"Enabled": "yes please",
+		"Retries": "1.5",
+		"Limit":   "65536",
+		"Ratio":   "half",
+		"Timeout": "90",
+	} {
+		err = Config{"Test": Options{option: value}}.Decode("Test", &testSection{})
+
+		assert.Error(err)
+		assert.IsType(ErrInvalidOption{}, err)
+		assert.Contains(err.Error(), "Test configuration error: invalid value '"+value+"' of option '"+option+"'")
+	}
+
+	var unsupported struct {
+		Ports []int
+	}
+
+	err = Config{"Test": Options{"Ports": "1,2"}}.Decode("Test", &unsupported)
+
+	assert.Error(err)
+	assert.Equal("Test configuration error: invalid value '1,2' of option 'Ports': unsupported option type: []int", err.Error())
+}
@@ -1,9 +1,13 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 )
 
+// ErrNoSource is returned when the config is reloaded but it hasn't been loaded from a file.
+var ErrNoSource = errors.New("the current config hasn't been loaded from a file")
+
 // ErrMissingOption defines an error of the missing config option.
 type ErrMissingOption struct {
 	provider string
@@ -15,6 +19,19 @@ func (e ErrMissingOption) Error() string {
 	return fmt.Sprintf("%s configuration error: missing or empty option '%s'", e.provider, e.option)
 }
 
+// ErrInvalidOption defines an error of the config option value which doesn't fit the option type.
+type ErrInvalidOption struct {
+	provider string
+	option   string
+	value    string
+	err      string
+}
+
+// Error implements error interface for ErrInvalidOption.
+func (e ErrInvalidOption) Error() string {
+	return fmt.Sprintf("%s configuration error: invalid value '%s' of option '%s': %s", e.provider, e.value, e.option, e.err)
+}
+
 // ParseError represents a config parser error.
 type ParseError struct {
 	strnum  int
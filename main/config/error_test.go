@@ -28,3 +28,16 @@ func TestParseError(t *testing.T) {
 
 	assert.Equal(t, text, err.Error())
 }
+
+func TestErrInvalidOption(t *testing.T) {
+	err := ErrInvalidOption{
+		provider: "Foobar",
+		option:   "Barbaz",
+		value:    "yes please",
+		err:      "boolean expected",
+	}
+
+	text := "Foobar configuration error: invalid value 'yes please' of option 'Barbaz': boolean expected"
+
+	assert.Equal(t, text, err.Error())
+}
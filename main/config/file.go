@@ -3,31 +3,85 @@ package config
 import (
 	"fmt"
 	"os"
+	"sync"
 )
 
-// FromFile loads the configuration from the specified file.
+// reloadMu serializes loading of configs from files.
+var reloadMu sync.Mutex
+
+// FromFile loads the configuration from the specified file and activates it.
+// The format of the file is detected by its extension, see FormatOf.
+// The config is validated before the activation, so the current config stays active if loading fails.
 func FromFile(filename string) (err error) {
-	file, err := os.Open(filename)
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	snapshot, err := Load(filename)
 	if err != nil {
 		return
 	}
-	defer file.Close()
 
-	info, err := file.Stat()
+	current.Store(snapshot)
+
+	return
+}
+
+// Reload loads the configuration from the file the current config has been loaded from and activates it.
+// It returns the active snapshot which is the previous one if loading fails or the config hasn't changed.
+func Reload() (snapshot *Snapshot, err error) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	active := Current()
+	if len(active.Source) == 0 {
+		return active, ErrNoSource
+	}
+
+	snapshot, err = Load(active.Source)
+	if err != nil {
+		return active, err
+	}
+	if snapshot.Version == active.Version {
+		return active, nil
+	}
+
+	current.Store(snapshot)
+
+	return
+}
+
+// Load reads and validates the configuration from the specified file without activating it.
+func Load(filename string) (snapshot *Snapshot, err error) {
+	cfg, err := ReadFile(filename)
 	if err != nil {
 		return
 	}
-	if info.Size() == 0 {
-		err = fmt.Errorf("empty %s", filename)
+
+	if err = validate(cfg); err != nil {
 		return
 	}
 
-	Cfg, err = parseConfig(file)
+	snapshot = newSnapshot(cfg, filename)
+
+	return
+}
+
+// ReadFile parses the configuration from the specified file without validating it.
+func ReadFile(filename string) (cfg Config, err error) {
+	file, err := os.Open(filename)
 	if err != nil {
 		return
 	}
+	defer file.Close()
 
-	err = validate(Cfg)
+	info, err := file.Stat()
+	if err != nil {
+		return
+	}
+	if info.Size() == 0 {
+		err = fmt.Errorf("empty %s", filename)
+		return
+	}
 
-	return
+	return Parse(file, FormatOf(filename))
 }
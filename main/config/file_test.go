@@ -16,7 +16,11 @@ func TestFromFile(t *testing.T) {
 	err := config.FromFile("../kyc_dev.cfg")
 
 	assert.NoError(err)
-	assert.NotEmpty(config.Cfg)
+	assert.NotEmpty(config.Current().Config)
+	assert.Equal("../kyc_dev.cfg", config.Current().Source)
+	assert.NotEmpty(config.Current().Version)
+
+	loaded := config.Current()
 
 	err = config.FromFile("fake")
 
@@ -40,4 +44,7 @@ func TestFromFile(t *testing.T) {
 
 	assert.Error(err)
 	assert.Equal("parsing failed at line 1 'package config_test': not proper config string", err.Error())
+
+	// The previous config stays active when loading fails.
+	assert.Equal(loaded, config.Current())
 }
@@ -0,0 +1,152 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Format represents the format of a config file.
+type Format string
+
+// Supported config formats.
+const (
+	INI  Format = "ini"
+	YAML Format = "yaml"
+	JSON Format = "json"
+	TOML Format = "toml"
+)
+
+// FormatOf detects the config format by the file extension.
+// Files with unknown extensions like ".cfg" are considered as the INI-like ones.
+func FormatOf(filename string) Format {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".yaml", ".yml":
+		return YAML
+	case ".json":
+		return JSON
+	case ".toml":
+		return TOML
+	}
+
+	return INI
+}
+
+// Parse reads the config of the specified format from the input.
+func Parse(r io.Reader, format Format) (Config, error) {
+	if format == INI {
+		return parseConfig(r)
+	}
+	if r == nil {
+		return nil, errors.New("the config source is nil")
+	}
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := map[string]interface{}{}
+	switch format {
+	case YAML:
+		err = yaml.Unmarshal(data, &raw)
+	case JSON:
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		err = decoder.Decode(&raw)
+	case TOML:
+		_, err = toml.Decode(string(data), &raw)
+	default:
+		err = fmt.Errorf("unsupported config format: %s", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s config failed: %s", format, err)
+	}
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("parsing %s config failed: config is empty", format)
+	}
+
+	return flatten(raw)
+}
+
+// flatten converts the decoded sections of a structured config into the plain options.
+func flatten(raw map[string]interface{}) (Config, error) {
+	cfg := Config{}
+	for name, section := range raw {
+		if err := validateName(name); err != nil {
+			return nil, fmt.Errorf("parsing failed at section '%s': %s", name, err)
+		}
+
+		values, ok := section.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("parsing failed at section '%s': options table expected", name)
+		}
+
+		opts := Options{}
+		for key, value := range values {
+			s, err := optionString(value)
+			if err != nil {
+				return nil, fmt.Errorf("parsing failed at option '%s.%s': %s", name, key, err)
+			}
+			opts[key] = s
+		}
+
+		// We will omit empty sections the same way as the INI-like parser does.
+		if len(opts) > 0 {
+			cfg[name] = opts
+		}
+	}
+
+	return cfg, nil
+}
+
+// optionString represents the decoded scalar or list value as the option string.
+func optionString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case uint64:
+		return strconv.FormatUint(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case json.Number:
+		return v.String(), nil
+	case time.Time:
+		return v.Format(time.RFC3339), nil
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			s, err := optionString(item)
+			if err != nil {
+				return "", err
+			}
+			if strings.Contains(s, listSep) {
+				return "", fmt.Errorf("list items can't contain '%s'", listSep)
+			}
+			items = append(items, s)
+		}
+		return strings.Join(items, listSep), nil
+	case map[string]interface{}:
+		return "", errors.New("nested tables aren't supported")
+	}
+
+	return "", fmt.Errorf("unsupported value type: %T", value)
+}
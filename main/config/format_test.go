@@ -0,0 +1,177 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"modulus/kyc/common"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var yamlConfig = `
+# This is a sample of the valid YAML config.
+ComplyAdvantage:
+  Host: https://api.complyadvantage.com
+  APIkey: bBF7TsnK2xCIMMNNbgUNcDwvSRSIbLT9
+  Fuzziness: 0.3
+
+IDology:
+  Host: https://web.idologylive.com/api/idiq.svc
+  Username: modulus.dev2
+  Password: "}$tRPfT1sZQmU@uh8@"
+  UseSummaryResult: false
+
+Config:
+  Port: 8080
+  Hosts:
+    - alpha
+    - beta
+`
+
+var jsonConfig = `{
+	"ComplyAdvantage": {
+		"Host": "https://api.complyadvantage.com",
+		"APIkey": "bBF7TsnK2xCIMMNNbgUNcDwvSRSIbLT9",
+		"Fuzziness": 0.3
+	},
+	"IDology": {
+		"Host": "https://web.idologylive.com/api/idiq.svc",
+		"Username": "modulus.dev2",
+		"Password": "}$tRPfT1sZQmU@uh8@",
+		"UseSummaryResult": false
+	},
+	"Config": {
+		"Port": 8080,
+		"Hosts": ["alpha", "beta"]
+	}
+}`
+
+var tomlConfig = `
+# This is a sample of the valid TOML config.
+[ComplyAdvantage]
+Host = "https://api.complyadvantage.com"
+APIkey = "bBF7TsnK2xCIMMNNbgUNcDwvSRSIbLT9"
+Fuzziness = 0.3
+
+[IDology]
+Host = "https://web.idologylive.com/api/idiq.svc"
+Username = "modulus.dev2"
+Password = "}$tRPfT1sZQmU@uh8@"
+UseSummaryResult = false
+
+[Config]
+Port = 8080
+Hosts = ["alpha", "beta"]
+`
+
+func TestFormatOf(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(YAML, FormatOf("kyc.yaml"))
+	assert.Equal(YAML, FormatOf("/etc/kyc/kyc.YML"))
+	assert.Equal(JSON, FormatOf("kyc.json"))
+	assert.Equal(TOML, FormatOf("kyc.toml"))
+	assert.Equal(INI, FormatOf("kyc.cfg"))
+	assert.Equal(INI, FormatOf("kyc"))
+}
+
+func TestParseStructured(t *testing.T) {
+	expected := Config{
+		string(common.ComplyAdvantage): Options{
+			"Host":      "https://api.complyadvantage.com",
+			"APIkey":    "bBF7TsnK2xCIMMNNbgUNcDwvSRSIbLT9",
+			"Fuzziness": "0.3",
+		},
+		string(common.IDology): Options{
+			"Host":             "https://web.idologylive.com/api/idiq.svc",
+			"Username":         "modulus.dev2",
+			"Password":         "}$tRPfT1sZQmU@uh8@",
+			"UseSummaryResult": "false",
+		},
+		ServiceSection: Options{
+			"Port":  "8080",
+			"Hosts": "alpha,beta",
+		},
+	}
+
+	for format, raw := range map[Format]string{
+		YAML: yamlConfig,
+		JSON: jsonConfig,
+		TOML: tomlConfig,
+	} {
+		cfg, err := Parse(strings.NewReader(raw), format)
+
+		assert.NoError(t, err, format)
+		assert.Equal(t, expected, cfg, format)
+	}
+}
+
+func TestParseStructuredErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg, err := Parse(strings.NewReader(`{"Foobar": {"Host": "host"}}`), JSON)
+
+	assert.Error(err)
+	assert.Equal("parsing failed at section 'Foobar': unknown KYC provider name in the config", err.Error())
+	assert.Nil(cfg)
+
+	cfg, err = Parse(strings.NewReader(`{"IDology": "host"}`), JSON)
+
+	assert.Error(err)
+	assert.Equal("parsing failed at section 'IDology': options table expected", err.Error())
+	assert.Nil(cfg)
+
+	cfg, err = Parse(strings.NewReader("[IDology.Auth]\nUsername = \"user\"\n"), TOML)
+
+	assert.Error(err)
+	assert.Equal("parsing failed at option 'IDology.Auth': nested tables aren't supported", err.Error())
+	assert.Nil(cfg)
+
+	cfg, err = Parse(strings.NewReader("Config:\n  Hosts: [\"a,b\", c]\n"), YAML)
+
+	assert.Error(err)
+	assert.Equal("parsing failed at option 'Config.Hosts': list items can't contain ','", err.Error())
+	assert.Nil(cfg)
+
+	cfg, err = Parse(strings.NewReader("{}"), JSON)
+
+	assert.Error(err)
+	assert.Equal("parsing json config failed: config is empty", err.Error())
+	assert.Nil(cfg)
+
+	cfg, err = Parse(strings.NewReader("Config: [\n"), YAML)
+
+	assert.Error(err)
+	assert.True(strings.HasPrefix(err.Error(), "parsing yaml config failed: "))
+	assert.Nil(cfg)
+
+	cfg, err = Parse(nil, TOML)
+
+	assert.Error(err)
+	assert.Equal("the config source is nil", err.Error())
+	assert.Nil(cfg)
+}
+
+func TestParseInstances(t *testing.T) {
+	assert := assert.New(t)
+
+	raw := `
+["Jumio:eu"]
+BaseURL = "https://lon.netverify.com"
+
+["Jumio:us"]
+BaseURL = "https://netverify.com"
+`
+
+	cfg, err := Parse(strings.NewReader(raw), TOML)
+
+	assert.NoError(err)
+	assert.Equal("https://lon.netverify.com", cfg.Option("Jumio:eu", "BaseURL"))
+	assert.Equal("https://netverify.com", cfg.Option("Jumio:us", "BaseURL"))
+
+	cfg, err = Parse(strings.NewReader("[Jumio:eu]\nBaseURL=https://lon.netverify.com\n"), INI)
+
+	assert.NoError(err)
+	assert.Equal("https://lon.netverify.com", cfg.Option("Jumio:eu", "BaseURL"))
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"modulus/kyc/common"
+)
+
+// InstanceSep separates the KYC provider name and the instance name in config section names, e.g. "Jumio:eu".
+const InstanceSep = ":"
+
+// instanceRe describes the allowed format of provider instance names.
+var instanceRe = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// SectionName returns the config section name of the provider instance.
+// The empty instance stands for the default provider section.
+func SectionName(provider common.KYCProvider, instance string) string {
+	if len(instance) == 0 {
+		return string(provider)
+	}
+	return string(provider) + InstanceSep + instance
+}
+
+// SplitSectionName splits the config section name into the KYC provider and the instance names.
+func SplitSectionName(name string) (provider common.KYCProvider, instance string) {
+	if i := strings.Index(name, InstanceSep); i >= 0 {
+		return common.KYCProvider(name[:i]), name[i+1:]
+	}
+	return common.KYCProvider(name), ""
+}
+
+// validateInstance validates the provider instance name.
+func validateInstance(instance string) error {
+	if !instanceRe.MatchString(instance) {
+		return errors.New("invalid KYC provider instance name in the config")
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"testing"
+
+	"modulus/kyc/common"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSectionName(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("Jumio", SectionName(common.Jumio, ""))
+	assert.Equal("Jumio:eu", SectionName(common.Jumio, "eu"))
+
+	provider, instance := SplitSectionName("Jumio:eu")
+
+	assert.Equal(common.Jumio, provider)
+	assert.Equal("eu", instance)
+
+	provider, instance = SplitSectionName("Jumio")
+
+	assert.Equal(common.Jumio, provider)
+	assert.Empty(instance)
+}
+
+func TestValidateInstanceName(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(validateName("Jumio:eu"))
+	assert.NoError(validateName("Jumio:us-east_1"))
+
+	err := validateName("Jumio:")
+
+	assert.Error(err)
+	assert.Equal("invalid KYC provider instance name in the config", err.Error())
+
+	err = validateName("Jumio:e u")
+
+	assert.Error(err)
+	assert.Equal("invalid KYC provider instance name in the config", err.Error())
+
+	err = validateName("Foobar:eu")
+
+	assert.Error(err)
+	assert.Equal("unknown KYC provider name in the config", err.Error())
+}
+
+func TestValidateInstances(t *testing.T) {
+	assert := assert.New(t)
+
+	config := Config{
+		"Jumio:eu": Options{
+			"BaseURL": "https://netverify.com",
+			"Token":   "token",
+			"Secret":  "secret",
+		},
+		"Jumio:us": Options{
+			"BaseURL": "https://netverify.com",
+			"Token":   "token",
+		},
+	}
+
+	err := validate(config)
+
+	assert.Error(err)
+	assert.Equal("Jumio:us configuration error: missing or empty option 'Secret'", err.Error())
+}
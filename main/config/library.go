@@ -0,0 +1,100 @@
+package config
+
+import (
+	"modulus/kyc"
+	"modulus/kyc/common"
+	"modulus/kyc/integrations/coinfirm"
+	"modulus/kyc/integrations/complyadvantage"
+	"modulus/kyc/integrations/identitymind"
+	"modulus/kyc/integrations/idology"
+	"modulus/kyc/integrations/jumio"
+	"modulus/kyc/integrations/shuftipro"
+	"modulus/kyc/integrations/sumsub"
+	"modulus/kyc/integrations/synapsefi"
+	"modulus/kyc/integrations/thomsonreuters"
+	"modulus/kyc/integrations/trulioo"
+)
+
+// Library converts the config into the typed config of the KYC library.
+// The provider sections failing to decode are left out, their errors are returned keyed by the section names.
+func (c Config) Library() (cfg kyc.Config, errs map[string]error) {
+	errs = map[string]error{}
+
+	for _, section := range c.sectionNames() {
+		provider, instance := SplitSectionName(section)
+
+		if provider == common.CipherTrace && len(instance) == 0 {
+			cfg.CipherTrace = &kyc.CipherTraceConfig{
+				URL:      c[section]["URL"],
+				Key:      c[section]["Key"],
+				Username: c[section]["Username"],
+			}
+			continue
+		}
+
+		newSection, ok := sections[provider]
+		if !ok {
+			continue
+		}
+		typed := newSection()
+		if err := c.Decode(section, typed); err != nil {
+			errs[section] = err
+			continue
+		}
+
+		switch typed := typed.(type) {
+		case *coinfirm.Config:
+			if cfg.Coinfirm == nil {
+				cfg.Coinfirm = map[string]coinfirm.Config{}
+			}
+			cfg.Coinfirm[instance] = *typed
+		case *complyadvantage.Config:
+			if cfg.ComplyAdvantage == nil {
+				cfg.ComplyAdvantage = map[string]complyadvantage.Config{}
+			}
+			cfg.ComplyAdvantage[instance] = *typed
+		case *identitymind.Config:
+			if cfg.IdentityMind == nil {
+				cfg.IdentityMind = map[string]identitymind.Config{}
+			}
+			cfg.IdentityMind[instance] = *typed
+		case *idology.Config:
+			if cfg.IDology == nil {
+				cfg.IDology = map[string]idology.Config{}
+			}
+			cfg.IDology[instance] = *typed
+		case *jumio.Config:
+			if cfg.Jumio == nil {
+				cfg.Jumio = map[string]jumio.Config{}
+			}
+			cfg.Jumio[instance] = *typed
+		case *shuftipro.Config:
+			if cfg.ShuftiPro == nil {
+				cfg.ShuftiPro = map[string]shuftipro.Config{}
+			}
+			cfg.ShuftiPro[instance] = *typed
+		case *sumsub.Config:
+			if cfg.SumSub == nil {
+				cfg.SumSub = map[string]sumsub.Config{}
+			}
+			cfg.SumSub[instance] = *typed
+		case *synapsefi.Config:
+			if cfg.SynapseFI == nil {
+				cfg.SynapseFI = map[string]synapsefi.Config{}
+			}
+			cfg.SynapseFI[instance] = *typed
+		case *thomsonreuters.Config:
+			if cfg.ThomsonReuters == nil {
+				cfg.ThomsonReuters = map[string]thomsonreuters.Config{}
+			}
+			cfg.ThomsonReuters[instance] = *typed
+		case *trulioo.Config:
+			if cfg.Trulioo == nil {
+				cfg.Trulioo = map[string]trulioo.Config{}
+			}
+			cfg.Trulioo[instance] = *typed
+		}
+	}
+
+	return
+}
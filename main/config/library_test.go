@@ -0,0 +1,70 @@
+package config
+
+import (
+	"testing"
+
+	"modulus/kyc"
+	"modulus/kyc/integrations/idology"
+	"modulus/kyc/integrations/jumio"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLibrary(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg := Config{
+		"Config": Options{
+			"Port": "8080",
+		},
+		"CipherTrace": Options{
+			"URL":      "https://rest.ciphertrace.com",
+			"Key":      "key",
+			"Username": "user",
+		},
+		"IDology": Options{
+			"Host":             "https://web.idologylive.com/api/idiq.svc",
+			"Username":         "username",
+			"Password":         "password",
+			"UseSummaryResult": "true",
+		},
+		"IDology:eu": Options{
+			"Host":             "https://web.idologylive.com/api/idiq.svc",
+			"UseSummaryResult": "maybe",
+		},
+		"Jumio:eu": Options{
+			"BaseURL": "https://lon.netverify.com/api/netverify/v2",
+			"Token":   "token",
+			"Secret":  "secret",
+		},
+	}
+
+	library, errs := cfg.Library()
+
+	assert.Equal(&kyc.CipherTraceConfig{
+		URL:      "https://rest.ciphertrace.com",
+		Key:      "key",
+		Username: "user",
+	}, library.CipherTrace)
+	assert.Equal(map[string]idology.Config{
+		"": {
+			Host:             "https://web.idologylive.com/api/idiq.svc",
+			Username:         "username",
+			Password:         "password",
+			UseSummaryResult: true,
+		},
+	}, library.IDology)
+	assert.Equal(map[string]jumio.Config{
+		"eu": {
+			BaseURL: "https://lon.netverify.com/api/netverify/v2",
+			Token:   "token",
+			Secret:  "secret",
+		},
+	}, library.Jumio)
+	assert.Nil(library.Coinfirm)
+
+	assert.Len(errs, 1)
+	if assert.Error(errs["IDology:eu"]) {
+		assert.Equal("IDology:eu configuration error: invalid value 'maybe' of option 'UseSummaryResult': boolean expected", errs["IDology:eu"].Error())
+	}
+}
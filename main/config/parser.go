@@ -131,15 +131,24 @@ func kindOf(s string) kind {
 }
 
 // validateName validates KYC provider name from a config.
+// The name might contain the provider instance name like "Jumio:eu".
 func validateName(name string) (err error) {
 	if len(name) == 0 {
 		err = errors.New("empty section name")
 		return err
 	}
-	if name != ServiceSection && !common.KYCProviders[common.KYCProvider(name)] {
+	if name == ServiceSection {
+		return
+	}
+
+	provider, instance := SplitSectionName(name)
+	if !common.KYCProviders[provider] {
 		err = errors.New("unknown KYC provider name in the config")
 		return err
 	}
+	if provider != common.KYCProvider(name) {
+		err = validateInstance(instance)
+	}
 
 	return
 }
@@ -0,0 +1,123 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Mask replaces the values of secret options in the printed configs.
+const Mask = "********"
+
+// secretWords are the parts of the option names telling that the option value is a secret.
+var secretWords = []string{"password", "secret", "key", "token"}
+
+// IsSecret reports whether the value of the option is a secret that mustn't be printed.
+func IsSecret(option string) bool {
+	option = strings.ToLower(option)
+	for _, word := range secretWords {
+		if strings.Contains(option, word) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Redacted returns the copy of the config with the values of the secret options masked.
+func (c Config) Redacted() Config {
+	redacted := c.Clone()
+	for _, options := range redacted {
+		for key, value := range options {
+			if len(value) > 0 && IsSecret(key) {
+				options[key] = Mask
+			}
+		}
+	}
+
+	return redacted
+}
+
+// String represents the config in the INI-like format with the sections and the options sorted by name.
+func (c Config) String() string {
+	b := strings.Builder{}
+	for i, section := range c.sectionNames() {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		fmt.Fprintf(&b, "%c%s%c\n", namestart, section, namestop)
+		for _, key := range c[section].keys() {
+			fmt.Fprintf(&b, "%s%c%s\n", key, sep, c[section][key])
+		}
+	}
+
+	return b.String()
+}
+
+// Change represents a difference of the option between two configs.
+// Old is empty for added options and New is empty for removed ones.
+type Change struct {
+	Section string
+	Option  string
+	Old     string
+	New     string
+}
+
+// String represents the change in the diff-like form with the secret values masked.
+func (ch Change) String() string {
+	before, after := ch.Old, ch.New
+	if IsSecret(ch.Option) {
+		if len(before) > 0 {
+			before = Mask
+		}
+		if len(after) > 0 {
+			after = Mask
+		}
+	}
+
+	switch {
+	case len(ch.Old) == 0:
+		return fmt.Sprintf("+ [%s] %s=%s", ch.Section, ch.Option, after)
+	case len(ch.New) == 0:
+		return fmt.Sprintf("- [%s] %s=%s", ch.Section, ch.Option, before)
+	}
+
+	return fmt.Sprintf("~ [%s] %s: %s -> %s", ch.Section, ch.Option, before, after)
+}
+
+// Diff returns the changes of the options turning the config a into b ordered by the section and the option names.
+func Diff(a, b Config) (changes []Change) {
+	sections := map[string]bool{}
+	for section := range a {
+		sections[section] = true
+	}
+	for section := range b {
+		sections[section] = true
+	}
+
+	merged := Config{}
+	for section := range sections {
+		options := Options{}
+		for key := range a[section] {
+			options[key] = ""
+		}
+		for key := range b[section] {
+			options[key] = ""
+		}
+		merged[section] = options
+	}
+
+	for _, section := range merged.sectionNames() {
+		for _, key := range merged[section].keys() {
+			if a[section][key] != b[section][key] {
+				changes = append(changes, Change{
+					Section: section,
+					Option:  key,
+					Old:     a[section][key],
+					New:     b[section][key],
+				})
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var printedConfig = Config{
+	"Jumio": Options{
+		"BaseURL": "https://netverify.com",
+		"Token":   "token",
+		"Secret":  "secret",
+	},
+	ServiceSection: Options{
+		"Port":       "8080",
+		"AdminToken": "",
+	},
+}
+
+func TestIsSecret(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, option := range []string{"Password", "NAPIPassword", "APIkey", "APIsecret", "SecretKey", "ClientSecret", "Key", "Token", "AdminToken"} {
+		assert.True(IsSecret(option), option)
+	}
+	for _, option := range []string{"Host", "BaseURL", "Username", "ClientID", "Fuzziness", "Port"} {
+		assert.False(IsSecret(option), option)
+	}
+}
+
+func TestRedacted(t *testing.T) {
+	assert := assert.New(t)
+
+	redacted := printedConfig.Redacted()
+
+	assert.Equal(Config{
+		"Jumio": Options{
+			"BaseURL": "https://netverify.com",
+			"Token":   Mask,
+			"Secret":  Mask,
+		},
+		ServiceSection: Options{
+			"Port":       "8080",
+			"AdminToken": "",
+		},
+	}, redacted)
+	assert.Equal("token", printedConfig["Jumio"]["Token"])
+}
+
+func TestString(t *testing.T) {
+	text := `[Config]
+AdminToken=
+Port=8080
+
+[Jumio]
+BaseURL=https://netverify.com
+Secret=secret
+Token=token
+`
+
+	assert.Equal(t, text, printedConfig.String())
+
+	cfg, err := parseConfig(strings.NewReader(text))
+
+	assert.NoError(t, err)
+	assert.Equal(t, printedConfig["Jumio"], cfg["Jumio"])
+}
+
+func TestDiff(t *testing.T) {
+	assert := assert.New(t)
+
+	changed := printedConfig.Clone()
+	changed["Jumio"]["Token"] = "new token"
+	changed["Jumio"]["BaseURL"] = "https://lon.netverify.com"
+	delete(changed[ServiceSection], "Port")
+	changed["Jumio:eu"] = Options{"Secret": "eu secret"}
+
+	changes := Diff(printedConfig, changed)
+
+	assert.Equal([]Change{
+		{Section: ServiceSection, Option: "Port", Old: "8080"},
+		{Section: "Jumio", Option: "BaseURL", Old: "https://netverify.com", New: "https://lon.netverify.com"},
+		{Section: "Jumio", Option: "Token", Old: "token", New: "new token"},
+		{Section: "Jumio:eu", Option: "Secret", New: "eu secret"},
+	}, changes)
+
+	lines := []string{}
+	for _, change := range changes {
+		lines = append(lines, change.String())
+	}
+
+	assert.Equal([]string{
+		"- [Config] Port=8080",
+		"~ [Jumio] BaseURL: https://netverify.com -> https://lon.netverify.com",
+		"~ [Jumio] Token: ******** -> ********",
+		"+ [Jumio:eu] Secret=********",
+	}, lines)
+
+	assert.Empty(Diff(printedConfig, printedConfig.Clone()))
+}
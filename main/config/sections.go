@@ -0,0 +1,30 @@
+package config
+
+import (
+	"modulus/kyc/common"
+	"modulus/kyc/integrations/coinfirm"
+	"modulus/kyc/integrations/complyadvantage"
+	"modulus/kyc/integrations/identitymind"
+	"modulus/kyc/integrations/idology"
+	"modulus/kyc/integrations/jumio"
+	"modulus/kyc/integrations/shuftipro"
+	"modulus/kyc/integrations/sumsub"
+	"modulus/kyc/integrations/synapsefi"
+	"modulus/kyc/integrations/thomsonreuters"
+	"modulus/kyc/integrations/trulioo"
+)
+
+// sections holds the constructors of the typed config sections of the KYC providers.
+// The sections are decoded on loading so the options of wrong types are reported at once.
+var sections = map[common.KYCProvider]func() interface{}{
+	common.Coinfirm:        func() interface{} { return &coinfirm.Config{} },
+	common.ComplyAdvantage: func() interface{} { return &complyadvantage.Config{} },
+	common.IdentityMind:    func() interface{} { return &identitymind.Config{} },
+	common.IDology:         func() interface{} { return &idology.Config{} },
+	common.Jumio:           func() interface{} { return &jumio.Config{} },
+	common.ShuftiPro:       func() interface{} { return &shuftipro.Config{} },
+	common.SumSub:          func() interface{} { return &sumsub.Config{} },
+	common.SynapseFI:       func() interface{} { return &synapsefi.Config{} },
+	common.ThomsonReuters:  func() interface{} { return &thomsonreuters.Config{} },
+	common.Trulioo:         func() interface{} { return &trulioo.Config{} },
+}
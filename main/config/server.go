@@ -0,0 +1,76 @@
+package config
+
+import "time"
+
+// Default values of the HTTP server options.
+const (
+	DefaultReadTimeout = 30 * time.Second
+	// DefaultWriteTimeout exceeds the longest provider call the service waits for.
+	DefaultWriteTimeout    = 90 * time.Second
+	DefaultIdleTimeout     = 2 * time.Minute
+	DefaultShutdownTimeout = 90 * time.Second
+)
+
+// Server represents the HTTP server options of the service config section.
+type Server struct {
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	IdleTimeout  time.Duration
+	// ShutdownDelay is the time the service keeps serving requests after the readiness probe starts failing
+	// on shutdown. It allows load balancers to stop routing new requests to the service before it stops listening.
+	ShutdownDelay time.Duration
+	// ShutdownTimeout limits the time of waiting for the in-flight requests to complete on shutdown.
+	ShutdownTimeout time.Duration
+	// TLSCertFile and TLSKeyFile turn HTTPS on when both are set.
+	// The files are read again whenever they change so the certificate may be renewed without a restart.
+	TLSCertFile string
+	TLSKeyFile  string
+	// GRPCPort turns the gRPC API on the port specified when it's set.
+	GRPCPort string
+	// JobWorkers, JobQueueSize and JobTTL configure the queue of the asynchronous jobs,
+	// the zero values take the defaults of the jobs package.
+	JobWorkers   int
+	JobQueueSize int
+	JobTTL       time.Duration
+	// JobCallbackKey signs the callbacks of the asynchronous jobs by HMAC-SHA256, they aren't signed if it's empty.
+	JobCallbackKey string
+	// JobCallbackHosts are the hosts the job callback URLs may point to, any public host is allowed if it's empty.
+	// The callbacks to the loopback, private and link-local addresses are always rejected.
+	JobCallbackHosts []string
+	// IdempotencyWindow is the time the responses to the requests with the Idempotency-Key header are replayed
+	// for the repeats, the zero value takes the default of the idempotency package.
+	IdempotencyWindow time.Duration
+	// MaxRequestSize and MaxFileSize limit the size in bytes of the verification requests and of the single files
+	// of the multipart requests, the zero values take the defaults of the handlers.
+	MaxRequestSize int64
+	MaxFileSize    int64
+}
+
+// TLS tells whether the service serves HTTPS.
+func (s Server) TLS() bool {
+	return len(s.TLSCertFile) > 0 && len(s.TLSKeyFile) > 0
+}
+
+// Server returns the HTTP server options of the service.
+// Missing options take the default values.
+func (c Config) Server() (server Server, err error) {
+	server = Server{
+		ReadTimeout:     DefaultReadTimeout,
+		WriteTimeout:    DefaultWriteTimeout,
+		IdleTimeout:     DefaultIdleTimeout,
+		ShutdownTimeout: DefaultShutdownTimeout,
+	}
+
+	if err = c.Decode(ServiceSection, &server); err != nil {
+		return
+	}
+
+	switch {
+	case len(server.TLSCertFile) > 0 && len(server.TLSKeyFile) == 0:
+		err = ErrMissingOption{provider: ServiceSection, option: "TLSKeyFile"}
+	case len(server.TLSKeyFile) > 0 && len(server.TLSCertFile) == 0:
+		err = ErrMissingOption{provider: ServiceSection, option: "TLSCertFile"}
+	}
+
+	return
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync/atomic"
+	"time"
+)
+
+// Snapshot represents the configuration activated at once.
+// Snapshots are shared between concurrent requests, so neither a snapshot nor its config may be modified.
+type Snapshot struct {
+	Config Config
+	// Version identifies the content of the config. Equal configs have equal versions.
+	Version string
+	// Source is the name of the file the config has been loaded from.
+	Source   string
+	LoadedAt time.Time
+}
+
+// current holds the active config snapshot.
+var current atomic.Value
+
+func init() {
+	current.Store(newSnapshot(Config{}, ""))
+}
+
+// Current returns the active config snapshot.
+// Callers should retrieve it once per request to get the consistent view of the config.
+func Current() *Snapshot {
+	return current.Load().(*Snapshot)
+}
+
+// Set activates the config replacing the current one.
+// The config is activated as is without validation, use FromFile to load a config safely.
+func Set(cfg Config) *Snapshot {
+	snapshot := newSnapshot(cfg, "")
+	current.Store(snapshot)
+
+	return snapshot
+}
+
+// newSnapshot constructs a new snapshot of the config.
+func newSnapshot(cfg Config, source string) *Snapshot {
+	return &Snapshot{
+		Config:   cfg,
+		Version:  cfg.version(),
+		Source:   source,
+		LoadedAt: time.Now(),
+	}
+}
+
+// version calculates the digest of the config content.
+func (c Config) version() string {
+	h := sha256.New()
+	for _, section := range c.sectionNames() {
+		h.Write([]byte(section))
+		h.Write([]byte{0})
+		for _, key := range c[section].keys() {
+			h.Write([]byte(key))
+			h.Write([]byte{0})
+			h.Write([]byte(c[section][key]))
+			h.Write([]byte{0})
+		}
+		h.Write([]byte{'\n'})
+	}
+
+	return hex.EncodeToString(h.Sum(nil)[:6])
+}
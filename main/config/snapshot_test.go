@@ -0,0 +1,65 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotVersion(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg := Config{
+		"Jumio": Options{
+			"BaseURL": "https://netverify.com",
+			"Token":   "token",
+		},
+		ServiceSection: Options{
+			"Port": "8080",
+		},
+	}
+
+	version := cfg.version()
+
+	assert.Len(version, 12)
+	assert.Equal(version, cfg.Clone().version())
+	assert.NotEqual(version, Config{}.version())
+
+	clone := cfg.Clone()
+	clone["Jumio"]["Token"] = "another token"
+
+	assert.NotEqual(version, clone.version())
+	assert.Equal("token", cfg["Jumio"]["Token"])
+
+	// Moving the value between options changes the version.
+	assert.NotEqual(
+		Config{"Jumio": Options{"Token": "ab"}}.version(),
+		Config{"Jumio": Options{"Tokena": "b"}}.version(),
+	)
+}
+
+func TestSet(t *testing.T) {
+	assert := assert.New(t)
+
+	previous := Current()
+	defer current.Store(previous)
+
+	cfg := Config{
+		ServiceSection: Options{
+			"Port": "8999",
+		},
+	}
+
+	snapshot := Set(cfg)
+
+	assert.Equal(snapshot, Current())
+	assert.Equal(cfg, snapshot.Config)
+	assert.Equal(cfg.version(), snapshot.Version)
+	assert.Empty(snapshot.Source)
+	assert.Equal("8999", Current().Config.ServicePort())
+
+	_, err := Reload()
+
+	assert.Equal(ErrNoSource, err)
+	assert.Equal(snapshot, Current())
+}
@@ -2,116 +2,76 @@ package config
 
 import "modulus/kyc/common"
 
+// requiredOptions lists the options every KYC provider config section must contain.
+var requiredOptions = map[common.KYCProvider][]string{
+	common.Coinfirm:        {"Host", "Email", "Password", "Company"},
+	common.ComplyAdvantage: {"Host", "APIkey", "Fuzziness"},
+	common.IdentityMind:    {"Host", "Username", "Password"},
+	common.IDology:         {"Host", "Username", "Password", "UseSummaryResult"},
+	common.Jumio:           {"BaseURL", "Token", "Secret"},
+	common.ShuftiPro:       {"Host", "SecretKey", "ClientID", "CallbackURL"},
+	common.SumSub:          {"Host", "APIKey"},
+	common.SynapseFI:       {"Host", "ClientID", "ClientSecret"},
+	common.ThomsonReuters:  {"Host", "APIkey", "APIsecret"},
+	common.Trulioo:         {"Host", "NAPILogin", "NAPIPassword"},
+}
+
+// Validate checks the config correctness for all KYC providers containing in the given config.
+// Every named instance of a provider is validated the same way as the default one.
+// It returns all the problems found ordered by the section names.
+func Validate(config Config) (errs []error) {
+	for _, section := range config.sectionNames() {
+		errs = append(errs, validateSection(config, section)...)
+	}
+
+	return
+}
+
 // validate ensures the config correctness for all KYC providers containing in the given config.
+// It returns the first problem found.
 func validate(config Config) (err error) {
-	for provider, options := range config {
-		switch common.KYCProvider(provider) {
-		case common.Coinfirm:
-			if len(options["Host"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Host"}
-			}
-			if len(options["Email"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Email"}
-			}
-			if len(options["Password"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Password"}
-			}
-			if len(options["Company"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Company"}
-			}
-		case common.ComplyAdvantage:
-			if len(options["Host"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Host"}
-			}
-			if len(options["APIkey"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "APIkey"}
-			}
-			if len(options["Fuzziness"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Fuzziness"}
-			}
-		case common.IdentityMind:
-			if len(options["Host"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Host"}
-			}
-			if len(options["Username"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Username"}
-			}
-			if len(options["Password"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Password"}
-			}
-		case common.IDology:
-			if len(options["Host"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Host"}
-			}
-			if len(options["Username"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Username"}
-			}
-			if len(options["Password"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Password"}
-			}
-			if len(options["UseSummaryResult"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "UseSummaryResult"}
-			}
-		case common.Jumio:
-			if len(options["BaseURL"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "BaseURL"}
-			}
-			if len(options["Token"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Token"}
-			}
-			if len(options["Secret"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Secret"}
-			}
-		case common.ShuftiPro:
-			if len(options["Host"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Host"}
-			}
-			if len(options["SecretKey"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "SecretKey"}
-			}
-			if len(options["ClientID"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "ClientID"}
-			}
-			if len(options["CallbackURL"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "CallbackURL"}
-			}
-		case common.SumSub:
-			if len(options["Host"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Host"}
-			}
-			if len(options["APIKey"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "APIKey"}
-			}
-		case common.SynapseFI:
-			if len(options["Host"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Host"}
-			}
-			if len(options["ClientID"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "ClientID"}
-			}
-			if len(options["ClientSecret"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "ClientSecret"}
-			}
-		case common.ThomsonReuters:
-			if len(options["Host"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Host"}
-			}
-			if len(options["APIkey"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "APIkey"}
-			}
-			if len(options["APIsecret"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "APIsecret"}
-			}
-		case common.Trulioo:
-			if len(options["Host"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "Host"}
-			}
-			if len(options["NAPILogin"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "NAPILogin"}
-			}
-			if len(options["NAPIPassword"]) == 0 {
-				return ErrMissingOption{provider: provider, option: "NAPIPassword"}
-			}
+	if errs := Validate(config); len(errs) > 0 {
+		err = errs[0]
+	}
+
+	return
+}
+
+// validateSection checks the presence and the types of the options of the config section.
+func validateSection(config Config, section string) (errs []error) {
+	if section == ServiceSection {
+		if _, err := config.Server(); err != nil {
+			errs = append(errs, err)
+		}
+		return
+	}
+	if section == VaultSection {
+		if _, err := config.Vault(); err != nil {
+			errs = append(errs, err)
+		}
+		return
+	}
+	if section == CustomersSection {
+		if _, err := config.Customers(); err != nil {
+			errs = append(errs, err)
+		}
+		return
+	}
+
+	provider, _ := SplitSectionName(section)
+
+	for _, option := range requiredOptions[provider] {
+		if len(config[section][option]) == 0 {
+			errs = append(errs, ErrMissingOption{provider: section, option: option})
+		}
+	}
+
+	if newSection, ok := sections[provider]; ok {
+		if err := config.Decode(section, newSection()); err != nil {
+			errs = append(errs, err)
+		}
+		if _, err := config.Caching(section); err != nil {
+			errs = append(errs, err)
 		}
 	}
 
@@ -2,7 +2,9 @@ package config
 
 import (
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"modulus/kyc/common"
 
@@ -393,3 +395,192 @@ func TestVerifyTrulioo(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, `Trulioo configuration error: missing or empty option 'NAPIPassword'`, err.Error())
 }
+
+func TestVerifyOptionTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	config := Config{
+		string(common.ComplyAdvantage): Options{
+			"Host":      "host",
+			"APIkey":    "key",
+			"Fuzziness": "fuzzy",
+		},
+	}
+
+	err := validate(config)
+
+	assert.Error(err)
+	assert.Equal(reflect.TypeOf(ErrInvalidOption{}), reflect.TypeOf(err))
+	assert.Equal("ComplyAdvantage configuration error: invalid value 'fuzzy' of option 'Fuzziness': number expected", err.Error())
+}
+
+func TestCaching(t *testing.T) {
+	assert := assert.New(t)
+
+	config := Config{
+		string(common.ComplyAdvantage): Options{
+			"Host":      "host",
+			"APIkey":    "key",
+			"Fuzziness": "0.5",
+			"CacheTTL":  "72h",
+		},
+		"ComplyAdvantage:eu": Options{
+			"Host":      "host",
+			"APIkey":    "key",
+			"Fuzziness": "0.5",
+			"CacheTTL":  "3 days",
+		},
+		string(common.Jumio): Options{
+			"BaseURL":  "https://netverify.com",
+			"Token":    "token",
+			"Secret":   "secret",
+			"CacheTTL": "72h",
+		},
+	}
+
+	caching, err := config.Caching(string(common.ComplyAdvantage))
+
+	assert.NoError(err)
+	assert.Equal(72*time.Hour, caching.CacheTTL)
+
+	caching, err = config.Caching(string(common.Trulioo))
+
+	assert.NoError(err)
+	assert.Zero(caching.CacheTTL)
+
+	// The providers checking the images can't reuse the results for another face with the same document number.
+	caching, err = config.Caching(string(common.Jumio))
+
+	assert.Error(err)
+	assert.Zero(caching.CacheTTL)
+
+	errs := Validate(config)
+
+	if assert.Len(errs, 2) {
+		assert.Contains(errs[0].Error(), "invalid value '3 days' of option 'CacheTTL'")
+		assert.Equal("Jumio configuration error: invalid value '72h0m0s' of option 'CacheTTL': "+
+			"the results of the sanctions screening providers only can be cached", errs[1].Error())
+	}
+}
+
+func TestValidateAll(t *testing.T) {
+	assert := assert.New(t)
+
+	config := Config{
+		string(common.Jumio): Options{
+			"BaseURL": "base_url",
+		},
+		string(common.ComplyAdvantage): Options{
+			"Host":      "host",
+			"Fuzziness": "fuzzy",
+		},
+		string(common.SumSub): Options{
+			"Host":   "host",
+			"APIKey": "key",
+		},
+	}
+
+	errs := Validate(config)
+
+	messages := []string{}
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+
+	assert.Equal([]string{
+		"ComplyAdvantage configuration error: missing or empty option 'APIkey'",
+		"ComplyAdvantage configuration error: invalid value 'fuzzy' of option 'Fuzziness': number expected",
+		"Jumio configuration error: missing or empty option 'Token'",
+		"Jumio configuration error: missing or empty option 'Secret'",
+	}, messages)
+
+	assert.Empty(Validate(validConfig))
+}
+
+func TestVault(t *testing.T) {
+	assert := assert.New(t)
+
+	vault, err := Config{}.Vault()
+
+	assert.NoError(err)
+	assert.False(vault.Enabled())
+	assert.Equal(DefaultVaultRetention, vault.Retention)
+
+	key := strings.Repeat("0f", 32)
+	config := Config{
+		VaultSection: Options{
+			"Dir":        "/var/lib/kyc/vault",
+			"Key":        key,
+			"Retention":  "720h",
+			"ReadTokens": "auditor, support",
+		},
+	}
+
+	vault, err = config.Vault()
+
+	if assert.NoError(err) {
+		assert.True(vault.Enabled())
+		assert.Len(vault.KeyBytes(), 32)
+		assert.Equal(720*time.Hour, vault.Retention)
+		assert.Equal(DefaultVaultMaxRetention, vault.MaxRetention)
+		assert.Equal([]string{"auditor", "support"}, vault.ReadTokens)
+		assert.Empty(vault.WriteTokens)
+	}
+	assert.Empty(Validate(config))
+
+	config[VaultSection]["Key"] = "secret"
+	errs := Validate(config)
+
+	if assert.Len(errs, 1) {
+		assert.Equal("Vault configuration error: invalid value '***' of option 'Key': 64 hex digits expected", errs[0].Error())
+	}
+
+	config[VaultSection]["Key"] = key
+	config[VaultSection]["Retention"] = "9000h"
+	errs = Validate(config)
+
+	if assert.Len(errs, 1) {
+		assert.Contains(errs[0].Error(), "option 'Retention'")
+	}
+
+	delete(config[VaultSection], "Key")
+	_, err = config.Vault()
+
+	assert.Equal(ErrMissingOption{provider: VaultSection, option: "Key"}, err)
+}
+
+func TestCustomers(t *testing.T) {
+	assert := assert.New(t)
+
+	customers, err := Config{}.Customers()
+
+	assert.NoError(err)
+	assert.False(customers.Persistent())
+
+	config := Config{
+		CustomersSection: Options{
+			"Dir": "/var/lib/kyc/customers",
+			"Key": strings.Repeat("0f", 32),
+		},
+	}
+
+	customers, err = config.Customers()
+
+	if assert.NoError(err) {
+		assert.True(customers.Persistent())
+		assert.Len(customers.KeyBytes(), 32)
+	}
+	assert.Empty(Validate(config))
+
+	config[CustomersSection]["Key"] = "secret"
+	errs := Validate(config)
+
+	if assert.Len(errs, 1) {
+		assert.Equal("Customers configuration error: invalid value '***' of option 'Key': 64 hex digits expected", errs[0].Error())
+	}
+
+	delete(config[CustomersSection], "Key")
+	_, err = config.Customers()
+
+	assert.Equal(ErrMissingOption{provider: CustomersSection, option: "Key"}, err)
+}
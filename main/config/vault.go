@@ -0,0 +1,68 @@
+package config
+
+import (
+	"encoding/hex"
+	"time"
+)
+
+// VaultSection is the name of the document vault config section.
+const VaultSection = "Vault"
+
+// Default retention of the documents kept in the vault.
+const (
+	DefaultVaultRetention    = 30 * 24 * time.Hour
+	DefaultVaultMaxRetention = 365 * 24 * time.Hour
+)
+
+// encryptionKeySize is the size of the AES-256 keys encrypting the documents and the customer profiles at rest.
+const encryptionKeySize = 32
+
+// Vault represents the options of the document vault config section.
+type Vault struct {
+	// Dir is the directory keeping the documents. The vault is off if it's empty.
+	Dir string
+	// Key is the hex-encoded AES-256 key encrypting the documents at rest.
+	Key string
+	// Retention is the time the uploaded documents are kept for unless the upload requests another one
+	// not exceeding MaxRetention.
+	Retention    time.Duration
+	MaxRetention time.Duration
+	// ReadTokens and WriteTokens are the bearer tokens granting the scopes of downloading and uploading the documents.
+	// The admin token grants both scopes.
+	ReadTokens  []string
+	WriteTokens []string
+}
+
+// Enabled tells whether the document vault is on.
+func (v Vault) Enabled() bool {
+	return len(v.Dir) > 0
+}
+
+// KeyBytes returns the decoded encryption key.
+func (v Vault) KeyBytes() []byte {
+	key, _ := hex.DecodeString(v.Key)
+	return key
+}
+
+// Vault returns the document vault options. Missing options take the default values.
+func (c Config) Vault() (vault Vault, err error) {
+	vault = Vault{
+		Retention:    DefaultVaultRetention,
+		MaxRetention: DefaultVaultMaxRetention,
+	}
+
+	if err = c.Decode(VaultSection, &vault); err != nil || !vault.Enabled() {
+		return
+	}
+
+	switch key, err1 := hex.DecodeString(vault.Key); {
+	case len(vault.Key) == 0:
+		err = ErrMissingOption{provider: VaultSection, option: "Key"}
+	case err1 != nil || len(key) != encryptionKeySize:
+		err = ErrInvalidOption{provider: VaultSection, option: "Key", value: "***", err: "64 hex digits expected"}
+	case vault.Retention <= 0 || vault.Retention > vault.MaxRetention:
+		err = ErrInvalidOption{provider: VaultSection, option: "Retention", value: vault.Retention.String(), err: "positive duration not exceeding MaxRetention expected"}
+	}
+
+	return
+}
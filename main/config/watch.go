@@ -0,0 +1,62 @@
+package config
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// reloadDelay is the time to wait for the file changes to settle before reloading the config.
+// Editors and deployment tools usually produce a series of events for a single update.
+var reloadDelay = 200 * time.Millisecond
+
+// Watch reloads the config whenever the file it has been loaded from changes until done is closed.
+// The directory of the file is watched rather than the file itself, so replacing the file by renaming
+// and swapping symlinks like Kubernetes does for ConfigMap volumes are noticed as well.
+// The notify callback is called with the active snapshot for every activated config and every failure.
+func Watch(done <-chan struct{}, notify func(snapshot *Snapshot, err error)) (err error) {
+	source := Current().Source
+	if len(source) == 0 {
+		return ErrNoSource
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return
+	}
+	defer watcher.Close()
+
+	if err = watcher.Add(filepath.Dir(source)); err != nil {
+		return
+	}
+
+	var settled <-chan time.Time
+	for {
+		select {
+		case <-done:
+			return
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op == fsnotify.Chmod {
+				continue
+			}
+			settled = time.After(reloadDelay)
+		case err1, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			notify(Current(), err1)
+		case <-settled:
+			settled = nil
+
+			version := Current().Version
+			snapshot, err1 := Reload()
+			if err1 != nil || snapshot.Version != version {
+				notify(snapshot, err1)
+			}
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const watchedConfig = `
+[Jumio]
+BaseURL=https://netverify.com
+Token=token
+Secret=secret
+`
+
+// writeFile replaces the file by renaming a temporary one the way many editors do.
+func writeFile(t *testing.T, filename, content string) {
+	tmp := filename + ".tmp"
+	require.NoError(t, ioutil.WriteFile(tmp, []byte(content), 0644))
+	require.NoError(t, os.Rename(tmp, filename))
+}
+
+// nextReload waits for the next notification of the watcher.
+func nextReload(t *testing.T, reloads <-chan error) error {
+	select {
+	case err := <-reloads:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("config hasn't been reloaded")
+	}
+	return nil
+}
+
+func TestReload(t *testing.T) {
+	assert := assert.New(t)
+
+	previous := Current()
+	defer current.Store(previous)
+
+	dir, err := ioutil.TempDir("", "kyc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "kyc.cfg")
+	writeFile(t, filename, watchedConfig)
+
+	require.NoError(t, FromFile(filename))
+
+	loaded := Current()
+
+	// Unchanged config isn't activated again.
+	snapshot, err := Reload()
+
+	assert.NoError(err)
+	assert.Equal(loaded, snapshot)
+
+	writeFile(t, filename, watchedConfig+"[Config]\nPort=8999\n")
+
+	snapshot, err = Reload()
+
+	assert.NoError(err)
+	assert.Equal(snapshot, Current())
+	assert.NotEqual(loaded.Version, snapshot.Version)
+	assert.Equal("8999", snapshot.Config.ServicePort())
+
+	// Invalid config is rejected and the previous one stays active.
+	writeFile(t, filename, "[Jumio]\nBaseURL=https://netverify.com\n")
+
+	active, err := Reload()
+
+	assert.Error(err)
+	assert.Equal("Jumio configuration error: missing or empty option 'Token'", err.Error())
+	assert.Equal(snapshot, active)
+	assert.Equal(snapshot, Current())
+}
+
+func TestWatch(t *testing.T) {
+	previous := Current()
+	defer current.Store(previous)
+
+	delay := reloadDelay
+	reloadDelay = 10 * time.Millisecond
+	defer func() { reloadDelay = delay }()
+
+	dir, err := ioutil.TempDir("", "kyc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// The config is mounted the way Kubernetes does for ConfigMap volumes:
+	// kyc.cfg -> ..data/kyc.cfg, ..data -> ..v1
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "..v1"), 0755))
+	writeFile(t, filepath.Join(dir, "..v1", "kyc.cfg"), watchedConfig)
+	require.NoError(t, os.Symlink("..v1", filepath.Join(dir, "..data")))
+	filename := filepath.Join(dir, "kyc.cfg")
+	require.NoError(t, os.Symlink(filepath.Join("..data", "kyc.cfg"), filename))
+
+	require.NoError(t, FromFile(filename))
+
+	loaded := Current()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	reloads := make(chan error, 10)
+	watching := make(chan error, 1)
+	go func() {
+		watching <- Watch(done, func(snapshot *Snapshot, err error) {
+			reloads <- err
+		})
+	}()
+
+	// Give the watcher a moment to start.
+	time.Sleep(50 * time.Millisecond)
+
+	// Swap the symlink to the new version of the config.
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "..v2"), 0755))
+	writeFile(t, filepath.Join(dir, "..v2", "kyc.cfg"), watchedConfig+"[Config]\nPort=8999\n")
+	require.NoError(t, os.Symlink("..v2", filepath.Join(dir, "..data_tmp")))
+	require.NoError(t, os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data")))
+
+	assert.NoError(t, nextReload(t, reloads))
+	assert.NotEqual(t, loaded.Version, Current().Version)
+	assert.Equal(t, "8999", Current().Config.ServicePort())
+
+	// Broken config is reported and the active one is retained.
+	active := Current()
+	require.NoError(t, os.Remove(filename))
+	writeFile(t, filename, "[Jumio")
+
+	assert.Error(t, nextReload(t, reloads))
+	assert.Equal(t, active, Current())
+
+	select {
+	case err := <-watching:
+		t.Fatal("watcher stopped:", err)
+	default:
+	}
+}
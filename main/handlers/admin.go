@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"modulus/kyc/common"
+	"modulus/kyc/main/config"
+)
+
+// ConfigVersionHeader is the response header reporting the version of the config active when the request came.
+const ConfigVersionHeader = "X-Config-Version"
+
+// WithConfigVersion adds the version of the active config to every response of the handler.
+func WithConfigVersion(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(ConfigVersionHeader, config.Current().Version)
+		h.ServeHTTP(w, r)
+	})
+}
+
+// ReloadConfig handles requests for reloading the config from its file.
+// The request must be authorized with the admin token from the service config as the bearer token.
+// If the new config is invalid the previous one stays active and the response contains the error.
+func ReloadConfig(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeErrorResponse(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+	if err := authorizeAdmin(r); err != nil {
+		writeErrorResponse(w, err.status, err)
+		return
+	}
+
+	status := http.StatusOK
+	snapshot, err := config.Reload()
+
+	resp := common.ConfigResponse{
+		Version:  snapshot.Version,
+		Source:   snapshot.Source,
+		LoadedAt: snapshot.LoadedAt,
+	}
+	if err != nil {
+		log.Printf("Reloading configuration from %s: %s\n", snapshot.Source, err)
+		resp.Error = err.Error()
+		status = http.StatusUnprocessableEntity
+	} else {
+		log.Printf("Configuration version %s is active\n", snapshot.Version)
+	}
+
+	w.Header().Set(ConfigVersionHeader, snapshot.Version)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// authorizeAdmin checks the admin token of the request.
+func authorizeAdmin(r *http.Request) *serviceError {
+	token := config.Current().Config.AdminToken()
+	if len(token) == 0 {
+		return &serviceError{
+			status:  http.StatusForbidden,
+			message: "admin API is disabled",
+		}
+	}
+
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") ||
+		subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, "Bearer ")), []byte(token)) != 1 {
+		return &serviceError{
+			status:  http.StatusUnauthorized,
+			message: "invalid admin token",
+		}
+	}
+
+	return nil
+}
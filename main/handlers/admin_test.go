@@ -0,0 +1,109 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"modulus/kyc/main/config"
+	"modulus/kyc/main/handlers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const adminConfig = `
+[Config]
+AdminToken=secret
+`
+
+func TestReloadConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	previous := config.Current().Config
+	defer config.Set(previous)
+
+	dir, err := ioutil.TempDir("", "kyc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "kyc.cfg")
+	require.NoError(t, ioutil.WriteFile(filename, []byte(adminConfig), 0644))
+	require.NoError(t, config.FromFile(filename))
+
+	loaded := config.Current()
+
+	reload := func(method, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/admin/reload", nil)
+		if len(token) > 0 {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		handlers.WithConfigVersion(http.HandlerFunc(handlers.ReloadConfig)).ServeHTTP(w, req)
+		return w
+	}
+
+	// Testing wrong method.
+	w := reload(http.MethodGet, "secret")
+
+	assert.Equal(http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(http.MethodPost, w.Header().Get("Allow"))
+
+	// Testing missing and wrong tokens.
+	w = reload(http.MethodPost, "")
+
+	assert.Equal(http.StatusUnauthorized, w.Code)
+	assert.Equal(`{"Error":"invalid admin token"}`, w.Body.String())
+
+	w = reload(http.MethodPost, "wrong")
+
+	assert.Equal(http.StatusUnauthorized, w.Code)
+
+	// Testing valid reload.
+	require.NoError(t, ioutil.WriteFile(filename, []byte(adminConfig+"Port=8999\n"), 0644))
+
+	w = reload(http.MethodPost, "secret")
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("application/json; charset=utf-8", w.Header().Get("Content-Type"))
+
+	resp := map[string]interface{}{}
+	err = json.Unmarshal(w.Body.Bytes(), &resp)
+
+	assert.NoError(err)
+	assert.Equal(config.Current().Version, resp["Version"])
+	assert.Equal(filename, resp["Source"])
+	assert.NotContains(resp, "Error")
+	assert.NotEqual(loaded.Version, config.Current().Version)
+	assert.Equal(config.Current().Version, w.Header().Get(handlers.ConfigVersionHeader))
+	assert.Equal("8999", config.Current().Config.ServicePort())
+
+	// Testing invalid config, the previous one stays active.
+	active := config.Current()
+	require.NoError(t, ioutil.WriteFile(filename, []byte("[Foobar]\nHost=host\n"), 0644))
+
+	w = reload(http.MethodPost, "secret")
+
+	assert.Equal(http.StatusUnprocessableEntity, w.Code)
+
+	resp = map[string]interface{}{}
+	err = json.Unmarshal(w.Body.Bytes(), &resp)
+
+	assert.NoError(err)
+	assert.Equal(active.Version, resp["Version"])
+	assert.Equal("parsing failed at line 1 '[Foobar]': unknown KYC provider name in the config", resp["Error"])
+	assert.Equal(active, config.Current())
+
+	// Testing disabled admin API.
+	config.Set(previous)
+
+	w = reload(http.MethodPost, "secret")
+
+	assert.Equal(http.StatusForbidden, w.Code)
+	assert.Equal(`{"Error":"admin API is disabled"}`, w.Body.String())
+	assert.Equal(config.Current().Version, w.Header().Get(handlers.ConfigVersionHeader))
+}
@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"modulus/kyc/batch"
+	"modulus/kyc/common"
+	"modulus/kyc/main/config"
+)
+
+// BatchesPath is the path of the bulk verification API.
+const BatchesPath = "/batches"
+
+// Limits of the batch processing.
+const (
+	maxBatchSize        = 64 << 20
+	maxBatchConcurrency = 16
+	maxRunningBatches   = 4
+)
+
+// batches holds the batches started by the service.
+var batches = batch.NewStore(batch.DefaultTTL)
+
+// batchContext is the context the batches run with, it's cancelled on the shutdown.
+var batchContext, cancelBatches = context.WithCancel(context.Background())
+
+// StopBatches stops starting the verifications of the running batches and waits for the ones in progress to finish
+// until the context is done. The batches are marked cancelled then, the new ones are rejected.
+func StopBatches(ctx context.Context) error {
+	if running := batches.Running(); running > 0 {
+		log.Printf("StopBatches: cancelling %d running batch(es)\n", running)
+	}
+	cancelBatches()
+
+	return batches.Wait(ctx)
+}
+
+// CreateBatch handles requests for the bulk verifications of the customers from the CSV or NDJSON upload.
+// The format is taken from the Content-Type header. The provider is selected by the "provider" and "instance"
+// query parameters, the optional "concurrency" and "rate" ones control the processing.
+// It responds with 202 status and the running batch at once.
+func CreateBatch(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeErrorResponse(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	format, ok := batch.ParseFormat(r.Header.Get("Content-Type"))
+	if !ok {
+		writeErrorResponse(w, http.StatusUnsupportedMediaType, errors.New("unsupported batch format: text/csv or application/x-ndjson expected"))
+		return
+	}
+
+	query := r.URL.Query()
+	provider, instance := common.KYCProvider(query.Get("provider")), query.Get("instance")
+	if len(provider) == 0 {
+		writeErrorResponse(w, http.StatusBadRequest, errors.New("missing KYC provider id in the request"))
+		return
+	}
+
+	opts, err := batchOptions(query.Get("concurrency"), query.Get("rate"))
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	service, err1 := createCustomerChecker(provider, instance)
+	if err1 != nil {
+		log.Println("CreateBatch Error: ", err1)
+		writeErrorResponse(w, err1.status, err1)
+		return
+	}
+
+	customers, err := batch.ReadCustomers(http.MaxBytesReader(w, r.Body, maxBatchSize), format)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	if len(customers) == 0 {
+		writeErrorResponse(w, http.StatusBadRequest, errors.New("no customers in the batch"))
+		return
+	}
+
+	if batchContext.Err() != nil {
+		writeErrorResponse(w, http.StatusServiceUnavailable, errors.New("the service is shutting down"))
+		return
+	}
+	if batches.Running() >= maxRunningBatches {
+		w.Header().Set("Retry-After", "60")
+		writeErrorResponse(w, http.StatusServiceUnavailable, errors.New("too many batches running"))
+		return
+	}
+
+	control := requestCacheControl(r)
+	b := batch.Start(batchContext, common.Batch{Provider: provider, Instance: instance}, customers,
+		func(ctx context.Context, customer *common.UserData) common.KYCResponse {
+			return checkCustomer(service, common.CheckCustomerRequest{
+				Provider: provider,
+				Instance: instance,
+				UserData: customer,
+			}, control)
+		}, opts)
+	batches.Add(b)
+
+	info := b.Info()
+	log.Printf("CreateBatch: batch %s of %d customers started for %s\n", info.ID, info.Total, config.SectionName(provider, instance))
+
+	w.Header().Set("Location", BatchesPath+"/"+info.ID)
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(info)
+}
+
+// GetBatch handles requests for the batches:
+//
+//	GET /batches/{id}          the state of the batch
+//	GET /batches/{id}/results  the rows of the batch as CSV or NDJSON
+//
+// The results format is taken from the "format" query parameter or the Accept header, it's NDJSON by default.
+// The rows not verified yet are reported with their current status.
+func GetBatch(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeErrorResponse(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, BatchesPath+"/"), "/")
+	if len(parts[0]) == 0 || len(parts) > 2 || (len(parts) == 2 && parts[1] != "results") {
+		writeErrorResponse(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	b, err := batches.Get(parts[0])
+	if err != nil {
+		writeErrorResponse(w, http.StatusNotFound, err)
+		return
+	}
+
+	if len(parts) == 1 {
+		json.NewEncoder(w).Encode(b.Info())
+		return
+	}
+
+	format, ok := batch.ParseFormat(r.URL.Query().Get("format"))
+	if !ok {
+		if format, ok = batch.ParseFormat(r.Header.Get("Accept")); !ok {
+			format = batch.NDJSON
+		}
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="batch-%s.%s"`, parts[0], format))
+	if err := batch.WriteResults(w, b.Rows(), format); err != nil {
+		log.Println("GetBatch Error: ", err)
+	}
+}
+
+// batchOptions parses the processing options of the batch request.
+func batchOptions(concurrency, rate string) (opts batch.Options, err error) {
+	if len(concurrency) > 0 {
+		opts.Concurrency, err = strconv.Atoi(concurrency)
+		if err != nil || opts.Concurrency < 1 || opts.Concurrency > maxBatchConcurrency {
+			err = fmt.Errorf("invalid concurrency: %s, 1 to %d expected", concurrency, maxBatchConcurrency)
+			return
+		}
+	}
+	if len(rate) > 0 {
+		opts.Rate, err = strconv.ParseFloat(rate, 64)
+		if err != nil || !batch.ValidRate(opts.Rate) {
+			err = fmt.Errorf("invalid rate: %s, positive number of verifications per second up to %d expected", rate, batch.MaxRate)
+			return
+		}
+	}
+
+	return
+}
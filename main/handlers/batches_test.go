@@ -0,0 +1,119 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"modulus/kyc/common"
+	"modulus/kyc/main/handlers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatches(t *testing.T) {
+	assert := assert.New(t)
+
+	create := func(query, contentType, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, handlers.BatchesPath+"?"+query, strings.NewReader(body))
+		r.Header.Set("Content-Type", contentType)
+		handlers.CreateBatch(w, r)
+		return w
+	}
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handlers.GetBatch(w, httptest.NewRequest(http.MethodGet, handlers.BatchesPath+"/"+path, nil))
+		return w
+	}
+
+	w := create("provider=Example", "application/json", `{}`)
+	assert.Equal(http.StatusUnsupportedMediaType, w.Code)
+
+	w = create("", "text/csv", "FirstName\nAbby\n")
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal(`{"Error":"missing KYC provider id in the request"}`, w.Body.String())
+
+	w = create("provider=Example&concurrency=100", "text/csv", "FirstName\nAbby\n")
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal(`{"Error":"invalid concurrency: 100, 1 to 16 expected"}`, w.Body.String())
+
+	for _, rate := range []string{"0", "-1", "1e10", "Inf", "NaN"} {
+		w = create("provider=Example&rate="+rate, "text/csv", "FirstName\nAbby\n")
+		assert.Equal(http.StatusBadRequest, w.Code, rate)
+		assert.Equal(`{"Error":"invalid rate: `+rate+`, positive number of verifications per second up to 1000 expected"}`, w.Body.String())
+	}
+
+	w = create("provider=Foo", "text/csv", "FirstName\nAbby\n")
+	assert.Equal(http.StatusNotFound, w.Code)
+	assert.Equal(`{"Error":"unknown KYC provider in the request: Foo"}`, w.Body.String())
+
+	w = create("provider=Example", "text/csv", "Foo\nAbby\n")
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal(`{"Error":"header: unsupported column 'Foo'"}`, w.Body.String())
+
+	w = create("provider=Example", "text/csv", "FirstName\n")
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal(`{"Error":"no customers in the batch"}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	handlers.CreateBatch(w, httptest.NewRequest(http.MethodGet, handlers.BatchesPath, nil))
+	assert.Equal(http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(http.MethodPost, w.Header().Get("Allow"))
+
+	w = create("provider=Example&concurrency=2&rate=100", "application/x-ndjson",
+		`{"FirstName":"Abby"}`+"\n"+`{"FirstName":"Urbi"}`+"\n"+`{"FirstName":"Erika"}`+"\n")
+	require.Equal(t, http.StatusAccepted, w.Code)
+
+	info := common.Batch{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &info))
+	assert.Equal(handlers.BatchesPath+"/"+info.ID, w.Header().Get("Location"))
+	assert.Equal(common.Example, info.Provider)
+	assert.Equal(3, info.Total)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for info.CompletedAt == nil && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+
+		w = get(info.ID)
+		require.Equal(t, http.StatusOK, w.Code)
+		info = common.Batch{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &info))
+	}
+
+	assert.Equal(common.BatchCompleted, info.Status)
+	assert.Equal(3, info.Processed)
+	assert.Equal(2, info.Succeeded)
+	assert.Equal(1, info.Failed)
+
+	w = get(info.ID + "/results?format=csv")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal("text/csv", w.Header().Get("Content-Type"))
+	assert.Equal(`attachment; filename="batch-`+info.ID+`.csv"`, w.Header().Get("Content-Disposition"))
+
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	if assert.Len(lines, 4) {
+		assert.Equal("1,Succeeded,Approved,Unknown,,,,,", lines[1])
+		assert.True(strings.HasPrefix(lines[3], "3,Failed,"))
+	}
+
+	w = get(info.ID + "/results")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal("application/x-ndjson", w.Header().Get("Content-Type"))
+
+	row := common.BatchRow{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&row))
+	assert.Equal(1, row.Row)
+	assert.Equal(common.JobSucceeded, row.Status)
+
+	w = get("foo")
+	assert.Equal(http.StatusNotFound, w.Code)
+	assert.Equal(`{"Error":"batch not found"}`, w.Body.String())
+
+	w = get(info.ID + "/foo")
+	assert.Equal(http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"modulus/kyc/cache"
+	"modulus/kyc/common"
+	"modulus/kyc/main/config"
+)
+
+// results caches the verification results of the provider instances with the CacheTTL option configured.
+var results = cache.New()
+
+// cacheControl represents the Cache-Control directives of the verification request.
+type cacheControl struct {
+	// noCache means the cached result isn't used, the fresh one replaces it.
+	noCache bool
+	// noStore means the cached result isn't used and the fresh one isn't cached.
+	noStore bool
+}
+
+// requestCacheControl parses the Cache-Control header of the request.
+func requestCacheControl(r *http.Request) (control cacheControl) {
+	for _, directive := range strings.Split(r.Header.Get("Cache-Control"), ",") {
+		switch strings.ToLower(strings.TrimSpace(directive)) {
+		case "no-cache":
+			control.noCache = true
+		case "no-store":
+			control.noCache, control.noStore = true, true
+		}
+	}
+
+	return
+}
+
+// checkCustomerCached verifies the customer or returns the result cached for the provider instance
+// if the caching is configured for it. Only the results without errors are cached.
+// The results are cached for the options of the instance, so they aren't used once the reload changes them.
+// The cached result is described by the returned CacheInfo, it's nil for the fresh results.
+func checkCustomerCached(service common.KYCPlatform, provider common.KYCProvider, instance string, customer *common.UserData, control cacheControl) (result common.KYCResult, cached *common.CacheInfo, err error) {
+	section := config.SectionName(provider, instance)
+	cfg := config.Current().Config
+
+	caching, _ := cfg.Caching(section)
+	if caching.CacheTTL <= 0 {
+		result, err = service.CheckCustomer(customer)
+		return
+	}
+
+	key := section + "/" + optionsHash(cfg[section]) + "/" + cache.Fingerprint(customer)
+	if !control.noCache {
+		if entry, ok := results.Get(key); ok {
+			result, cached = entry.Result, &common.CacheInfo{CachedAt: entry.CachedAt, ExpiresAt: entry.ExpiresAt}
+			return
+		}
+	}
+
+	result, err = service.CheckCustomer(customer)
+	if err == nil && result.Status != common.Error && !control.noStore {
+		results.Put(key, result, caching.CacheTTL)
+	}
+
+	return
+}
+
+// optionsHash returns the hex-encoded SHA-256 of the options of the config section.
+func optionsHash(options config.Options) string {
+	names := make([]string, 0, len(options))
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(h, "%q=%q\n", name, options[name])
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// setAge sets the Age header of the response with the cached result.
+func setAge(w http.ResponseWriter, cached *common.CacheInfo) {
+	if cached != nil {
+		w.Header().Set("Age", strconv.Itoa(int(time.Since(cached.CachedAt)/time.Second)))
+	}
+}
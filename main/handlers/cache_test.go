@@ -0,0 +1,112 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"modulus/kyc/common"
+	"modulus/kyc/main/config"
+	"modulus/kyc/main/handlers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultCache(t *testing.T) {
+	assert := assert.New(t)
+
+	previous := config.Current().Config
+	defer config.Set(previous)
+
+	cfg := previous.Clone()
+	cfg["Example:cached"] = config.Options{"CacheTTL": "1h"}
+	config.Set(cfg)
+
+	check := func(firstName, cacheControl string) (response common.KYCResponse, age string) {
+		body, _ := json.Marshal(common.CheckCustomerRequest{
+			Provider: common.Example,
+			Instance: "cached",
+			UserData: &common.UserData{FirstName: firstName, LastName: "Cached"},
+		})
+		r := httptest.NewRequest(http.MethodPost, "/CheckCustomer", bytes.NewReader(body))
+		r.Header.Set("Cache-Control", cacheControl)
+		w := httptest.NewRecorder()
+		handlers.CheckCustomer(w, r)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		return response, w.Header().Get("Age")
+	}
+
+	response, age := check("Abby", "")
+
+	assert.Nil(response.Cache)
+	assert.Empty(age)
+
+	response, age = check("Abby", "")
+
+	if assert.NotNil(response.Cache) {
+		assert.True(response.Cache.ExpiresAt.After(response.Cache.CachedAt))
+	}
+	assert.Equal("0", age)
+	if assert.NotNil(response.Result) {
+		assert.Equal("Approved", response.Result.Status)
+	}
+
+	response, _ = check("Abby", "no-cache")
+
+	assert.Nil(response.Cache)
+
+	// The results cached before the options of the instance are changed by the reload aren't used.
+	cfg = cfg.Clone()
+	cfg["Example:cached"]["Host"] = "https://example.com"
+	config.Set(cfg)
+
+	response, _ = check("Abby", "")
+
+	assert.Nil(response.Cache)
+
+	response, _ = check("Abby", "")
+
+	assert.NotNil(response.Cache)
+
+	// The results with the errors aren't cached.
+	check("Erika", "")
+	response, _ = check("Erika", "")
+
+	assert.Nil(response.Cache)
+	assert.NotEmpty(response.Error)
+
+	// The default instance has no caching configured.
+	body, _ := json.Marshal(common.VerificationRequest{
+		Provider: common.Example,
+		Customer: &common.CustomerData{FirstName: "Abby", LastName: "Cached"},
+	})
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		handlers.V2(w, httptest.NewRequest(http.MethodPost, handlers.V2Prefix+"verifications", bytes.NewReader(body)))
+
+		assert.Equal(http.StatusOK, w.Code)
+		assert.NotContains(w.Body.String(), `"Cache"`)
+	}
+
+	body, _ = json.Marshal(common.VerificationRequest{
+		Provider: common.Example,
+		Instance: "cached",
+		Customer: &common.CustomerData{FirstName: "Urbi", LastName: "Cached"},
+	})
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		handlers.V2(w, httptest.NewRequest(http.MethodPost, handlers.V2Prefix+"verifications", bytes.NewReader(body)))
+
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		verification := common.Verification{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &verification))
+		assert.Equal("Unclear", verification.Status)
+		assert.Equal(i == 1, verification.Cache != nil)
+	}
+}
@@ -1,9 +1,14 @@
 package handlers
 
 import (
+	"bufio"
 	"encoding/json"
+	"log"
 	"net/http"
+	"strconv"
+	"strings"
 
+	"modulus/kyc/common"
 	"modulus/kyc/integrations/ciphertrace"
 	"modulus/kyc/main/config"
 
@@ -12,10 +17,7 @@ import (
 
 // CipherTraceCheck checks txHash for BTC and ETH.
 func CipherTraceCheck(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		Coin   string `json:"coin"`
-		TxHash string `json:"txHash"`
-	}
+	req := common.CipherTraceRequest{}
 	decoder := json.NewDecoder(r.Body)
 	err := decoder.Decode(&req)
 	if err != nil {
@@ -25,7 +27,7 @@ func CipherTraceCheck(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
-	cfg, ok := config.Cfg["CipherTrace"]
+	service, ok := newCipherTrace()
 	if !ok {
 		err = &serviceError{
 			status:  http.StatusInternalServerError,
@@ -35,7 +37,6 @@ func CipherTraceCheck(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	service := ciphertrace.NewCipherService(cfg["URL"], cfg["Key"], cfg["Username"])
 	switch req.Coin {
 	case "BTC":
 		res, err, code := service.GtAddressRiskInfo(req.TxHash)
@@ -57,3 +58,144 @@ func CipherTraceCheck(w http.ResponseWriter, r *http.Request) {
 		writeErrorResponse(w, http.StatusBadRequest, errors.New("not supported coin"))
 	}
 }
+
+// newCipherTrace creates the CipherTrace service from the active config.
+// It returns false if CipherTrace isn't configured.
+func newCipherTrace() (service *ciphertrace.CipherService, ok bool) {
+	library, _ := libraryService(config.Current())
+	return library.CipherTrace()
+}
+
+// walletAddressesFlushSize is the number of NDJSON lines buffered before flushing them to the client.
+const walletAddressesFlushSize = 100
+
+// Limits of the page size of the wallet addresses requested from CipherTrace.
+const (
+	minWalletPageSize = 100
+	maxWalletPageSize = 1000
+)
+
+// WalletAddresses streams all addresses of the CipherTrace wallet as NDJSON.
+// The wallet id is taken from the path "/crypto/wallet/{id}/addresses".
+// Optional "pageSize" and "concurrency" query parameters control the walk through the wallet.
+func WalletAddresses(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	path := strings.TrimPrefix(r.URL.Path, "/crypto/wallet/")
+	if !strings.HasSuffix(path, "/addresses") {
+		writeErrorResponse(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+	walletID := strings.TrimSuffix(path, "/addresses")
+	if len(walletID) == 0 || strings.Contains(walletID, "/") {
+		writeErrorResponse(w, http.StatusBadRequest, errors.New("missing wallet id in the request"))
+		return
+	}
+
+	opts := ciphertrace.StreamOptions{}
+	params := []struct {
+		name     string
+		value    *int
+		min, max int
+	}{
+		{"pageSize", &opts.PageSize, minWalletPageSize, maxWalletPageSize},
+		{"concurrency", &opts.Concurrency, 1, ciphertrace.MaxConcurrency},
+	}
+	for _, param := range params {
+		if v := r.URL.Query().Get(param.name); len(v) > 0 {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				writeErrorResponse(w, http.StatusBadRequest, errors.Errorf("invalid %s: %s", param.name, v))
+				return
+			}
+			if n < param.min || n > param.max {
+				writeErrorResponse(w, http.StatusBadRequest, errors.Errorf("invalid %s: %s, %d to %d expected", param.name, v, param.min, param.max))
+				return
+			}
+			*param.value = n
+		}
+	}
+
+	service, ok := newCipherTrace()
+	if !ok {
+		writeErrorResponse(w, http.StatusInternalServerError, errors.New("missing config for CipherTrace"))
+		return
+	}
+
+	it := service.WalletAddresses(walletID, opts)
+	defer it.Close()
+
+	// The first page is awaited before responding in order to report a failed request with a proper status.
+	next := it.Next()
+	if err := it.Err(); !next && err != nil {
+		status := http.StatusInternalServerError
+		if serr, ok := err.(ciphertrace.StreamError); ok {
+			status = serr.Status
+		}
+		writeErrorResponse(w, status, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+
+	nw := newNDJSONWriter(w)
+
+	for ; next; next = it.Next() {
+		select {
+		case <-r.Context().Done():
+			return
+		default:
+		}
+		if err := nw.write(common.WalletAddress{Address: it.Address()}); err != nil {
+			log.Println("WalletAddresses: writing response:", err)
+			return
+		}
+	}
+	if err := it.Err(); err != nil {
+		nw.write(common.ErrorResponse{Error: err.Error()})
+	}
+	nw.flush()
+}
+
+// ndjsonWriter writes newline-delimited JSON values flushing them to the client in batches.
+// Writes block while the client isn't reading, so the producer is slowed down to the client's pace.
+type ndjsonWriter struct {
+	w       http.ResponseWriter
+	buf     *bufio.Writer
+	enc     *json.Encoder
+	pending int
+}
+
+// newNDJSONWriter constructs a new NDJSON writer of the response.
+func newNDJSONWriter(w http.ResponseWriter) *ndjsonWriter {
+	buf := bufio.NewWriter(w)
+	return &ndjsonWriter{
+		w:   w,
+		buf: buf,
+		enc: json.NewEncoder(buf),
+	}
+}
+
+// write encodes the value as a single line.
+func (nw *ndjsonWriter) write(v interface{}) error {
+	if err := nw.enc.Encode(v); err != nil {
+		return err
+	}
+	nw.pending++
+	if nw.pending >= walletAddressesFlushSize {
+		return nw.flush()
+	}
+	return nil
+}
+
+// flush sends buffered lines to the client.
+func (nw *ndjsonWriter) flush() error {
+	nw.pending = 0
+	if err := nw.buf.Flush(); err != nil {
+		return err
+	}
+	if f, ok := nw.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package handlers_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"modulus/kyc/common"
+	"modulus/kyc/integrations/ciphertrace"
+	"modulus/kyc/main/config"
+	"modulus/kyc/main/handlers"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletAddresses(t *testing.T) {
+	assert := assert.New(t)
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("wallet_id") != "094f8d86" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("wallet not found"))
+			return
+		}
+
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		count, _ := strconv.Atoi(query.Get("count"))
+
+		wallet := ciphertrace.WalletWithAddresses{
+			AddressOffset: offset,
+			Wallet: ciphertrace.Wallet{
+				WalletID:          "094f8d86",
+				TotalAddressCount: 250,
+			},
+		}
+		for i := offset; i < offset+count && i < 250; i++ {
+			wallet.Addresses = append(wallet.Addresses, fmt.Sprintf("address-%d", i))
+		}
+		json.NewEncoder(w).Encode(wallet)
+	}))
+	defer upstream.Close()
+
+	defer setOptions(string(common.CipherTrace), config.Options{
+		"URL":      upstream.URL,
+		"Key":      "fakekey",
+		"Username": "fakeuser",
+	})()
+
+	handler := http.HandlerFunc(handlers.WalletAddresses)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/crypto/wallet/094f8d86/addresses?pageSize=100&concurrency=2", nil))
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("application/x-ndjson", w.Header().Get("Content-Type"))
+
+	scanner := bufio.NewScanner(w.Body)
+	count := 0
+	for scanner.Scan() {
+		assert.Equal(fmt.Sprintf(`{"address":"address-%d"}`, count), scanner.Text())
+		count++
+	}
+	assert.Equal(250, count)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/crypto/wallet/unknown/addresses", nil))
+
+	assert.Equal(http.StatusNotFound, w.Code)
+	assert.Equal(`{"Error":"status not 200. error: wallet not found"}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/crypto/wallet/094f8d86/addresses?pageSize=150", nil))
+
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.True(strings.Contains(w.Body.String(), "multipliers of 100"))
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/crypto/wallet/094f8d86/addresses?concurrency=many", nil))
+
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal(`{"Error":"invalid concurrency: many"}`, w.Body.String())
+
+	for query, message := range map[string]string{
+		"pageSize=-100":    "invalid pageSize: -100, 100 to 1000 expected",
+		"pageSize=100000":  "invalid pageSize: 100000, 100 to 1000 expected",
+		"concurrency=0":    "invalid concurrency: 0, 1 to 16 expected",
+		"concurrency=1000": "invalid concurrency: 1000, 1 to 16 expected",
+	} {
+		w = httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/crypto/wallet/094f8d86/addresses?"+query, nil))
+
+		assert.Equal(http.StatusBadRequest, w.Code, query)
+		assert.Equal(`{"Error":"`+message+`"}`, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/crypto/wallet//addresses", nil))
+
+	assert.Equal(http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/crypto/wallet/094f8d86", nil))
+
+	assert.Equal(http.StatusNotFound, w.Code)
+}
@@ -189,8 +189,8 @@ var truliooResponse = []byte(`
 }`)
 
 func init() {
-	if config.Cfg == nil {
-		config.Cfg = cfg
+	if len(config.Current().Config) == 0 {
+		config.Set(cfg)
 	}
 }
 
@@ -245,7 +245,7 @@ func TestCheckCustomer(t *testing.T) {
 
 	handlers.CheckCustomer(w, req)
 
-	assert.Equal(http.StatusInternalServerError, w.Code)
+	assert.Equal(http.StatusBadRequest, w.Code)
 	assert.Equal("application/json; charset=utf-8", w.Header().Get("Content-Type"))
 
 	resp = common.KYCResponse{}
@@ -255,7 +255,7 @@ func TestCheckCustomer(t *testing.T) {
 	assert.NoError(err)
 	assert.Nil(resp.Result)
 	assert.NotEmpty(resp.Error)
-	assert.Equal("Read failed", resp.Error)
+	assert.Equal("failed to read the request body: Read failed", resp.Error)
 
 	// Testing empty request.
 	req = httptest.NewRequest(http.MethodPost, "/CheckCustomer", nil)
@@ -386,7 +386,7 @@ func TestCheckCustomer(t *testing.T) {
 	if !common.KYCProviders["Not Implemented Provider"] {
 		common.KYCProviders["Not Implemented Provider"] = true
 	}
-	config.Cfg["Not Implemented Provider"] = map[string]string{"test": "test"}
+	defer setOptions("Not Implemented Provider", config.Options{"test": "test"})()
 
 	req = httptest.NewRequest(http.MethodPost, "/CheckCustomer", bytes.NewReader(request))
 	w = httptest.NewRecorder()
@@ -436,10 +436,11 @@ func TestCheckCustomer(t *testing.T) {
 	assert.NotNil(resp.Result)
 	assert.Equal(common.KYCStatus2Status[common.Error], resp.Result.Status)
 	assert.Nil(resp.Result.Details)
-	assert.Empty(resp.Result.ErrorCode)
+	assert.Equal("403", resp.Result.ErrorCode)
 	assert.Nil(resp.Result.StatusCheck)
 	assert.NotEmpty(resp.Error)
 	assert.Equal("during verification: Invalid username and password", resp.Error)
+	assert.Equal(common.AuthenticationError, resp.ErrorCategory)
 
 	// Testing IdentityMind.
 	request, err = json.Marshal(&common.CheckCustomerRequest{
@@ -632,11 +633,12 @@ func TestCheckCustomer(t *testing.T) {
 	assert.NotEmpty(request)
 	assert.NotEmpty(response)
 
-	config.Cfg[string(common.IDology)] = map[string]string{
-		"Host":     "https://web.idologylive.com/api/idiq.svc",
-		"Username": "fakeuser",
-		"Password": "fakepassword",
-	}
+	defer setOptions(string(common.IDology), config.Options{
+		"Host":             "https://web.idologylive.com/api/idiq.svc",
+		"Username":         "fakeuser",
+		"Password":         "fakepassword",
+		"UseSummaryResult": "maybe",
+	})()
 
 	req = httptest.NewRequest(http.MethodPost, "/CheckCustomer", bytes.NewReader(request))
 	w = httptest.NewRecorder()
@@ -653,5 +655,124 @@ func TestCheckCustomer(t *testing.T) {
 	assert.NoError(err)
 	assert.Nil(resp.Result)
 	assert.NotEmpty(resp.Error)
-	assert.Equal(`IDology config error: strconv.ParseBool: parsing "": invalid syntax`, resp.Error)
+	assert.Equal("IDology configuration error: invalid value 'maybe' of option 'UseSummaryResult': boolean expected", resp.Error)
+}
+
+func TestCheckCustomerInstance(t *testing.T) {
+	assert := assert.New(t)
+
+	section := config.SectionName(common.SumSub, "eu")
+	defer setOptions(section, config.Options{
+		"Host":   "https://eu.test-api.sumsub.com",
+		"APIKey": "fakeEUKey",
+	})()
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		http.MethodPost,
+		"https://eu.test-api.sumsub.com/resources/applicants?key=fakeEUKey",
+		httpmock.NewBytesResponder(http.StatusOK, sumsubResponse),
+	)
+	httpmock.RegisterResponder(
+		http.MethodPost,
+		"https://eu.test-api.sumsub.com/resources/applicants/596eb3c93a0eb985b8ade34d/info/idDoc?key=fakeEUKey",
+		httpmock.NewStringResponder(http.StatusOK, `{"ok":1}`),
+	)
+	httpmock.RegisterResponderWithQuery(
+		http.MethodPost,
+		"https://eu.test-api.sumsub.com/resources/applicants/596eb3c93a0eb985b8ade34d/status/pending",
+		map[string]string{
+			"reason": "docs_sent",
+			"key":    "fakeEUKey",
+		},
+		httpmock.NewStringResponder(http.StatusOK, `{"ok":1}`),
+	)
+
+	request, err := json.Marshal(&common.CheckCustomerRequest{
+		Provider: common.SumSub,
+		Instance: "eu",
+		UserData: &common.UserData{
+			IDCard: &common.IDCard{
+				Number: "xyz",
+			},
+		},
+	})
+
+	assert.NoError(err)
+
+	req := httptest.NewRequest(http.MethodPost, "/CheckCustomer", bytes.NewReader(request))
+	w := httptest.NewRecorder()
+
+	handlers.CheckCustomer(w, req)
+
+	assert.Equal(http.StatusOK, w.Code)
+
+	resp := common.KYCResponse{}
+
+	err = json.Unmarshal(w.Body.Bytes(), &resp)
+
+	assert.NoError(err)
+	assert.Empty(resp.Error)
+	assert.NotNil(resp.Result)
+	assert.Equal(common.KYCStatus2Status[common.Unclear], resp.Result.Status)
+	assert.NotNil(resp.Result.StatusCheck)
+	assert.Equal(common.SumSub, resp.Result.StatusCheck.Provider)
+	assert.Equal("eu", resp.Result.StatusCheck.Instance)
+	assert.Equal("Sum&Substance:eu/596eb3c93a0eb985b8ade34d", resp.Result.StatusCheck.ReferenceID)
+
+	// Testing unknown provider instance.
+	request, err = json.Marshal(&common.CheckCustomerRequest{
+		Provider: common.SumSub,
+		Instance: "us",
+		UserData: &common.UserData{},
+	})
+
+	assert.NoError(err)
+
+	req = httptest.NewRequest(http.MethodPost, "/CheckCustomer", bytes.NewReader(request))
+	w = httptest.NewRecorder()
+
+	handlers.CheckCustomer(w, req)
+
+	assert.Equal(http.StatusNotFound, w.Code)
+
+	resp = common.KYCResponse{}
+
+	err = json.Unmarshal(w.Body.Bytes(), &resp)
+
+	assert.NoError(err)
+	assert.Nil(resp.Result)
+	assert.Equal("unknown Sum&Substance instance in the request: us", resp.Error)
+}
+
+func TestCheckCustomerErrorCategory(t *testing.T) {
+	assert := assert.New(t)
+
+	request, err := json.Marshal(&common.CheckCustomerRequest{
+		Provider: common.Example,
+		UserData: &common.UserData{
+			FirstName: "Erika",
+		},
+	})
+
+	assert.NoError(err)
+
+	req := httptest.NewRequest(http.MethodPost, "/CheckCustomer", bytes.NewReader(request))
+	w := httptest.NewRecorder()
+
+	handlers.CheckCustomer(w, req)
+
+	assert.Equal(http.StatusOK, w.Code)
+
+	resp := common.KYCResponse{}
+
+	err = json.Unmarshal(w.Body.Bytes(), &resp)
+
+	assert.NoError(err)
+	assert.Equal("during sending request: http error", resp.Error)
+	assert.Equal(common.RateLimitedError, resp.ErrorCategory)
+	assert.True(resp.Retryable)
+	assert.Equal("429", resp.Result.ErrorCode)
 }
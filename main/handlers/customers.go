@@ -0,0 +1,300 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"modulus/kyc/common"
+	"modulus/kyc/customers"
+	"modulus/kyc/main/config"
+	"modulus/kyc/ownership"
+)
+
+// CustomersPath is the path of the customer profiles API.
+const CustomersPath = "/customers"
+
+// profiles holds the customer profiles.
+var profiles = customers.NewStore()
+
+// StartCustomers replaces the customer profiles store and the wallet ownership challenges store by the ones
+// configured by the options and registers the readiness check of the profiles store.
+// It must be called before the service starts serving requests.
+func StartCustomers(options config.Customers) (err error) {
+	store := customers.NewStore()
+	if options.Persistent() {
+		if store, err = customers.Open(options.Dir, options.KeyBytes()); err != nil {
+			return
+		}
+	}
+
+	profiles = store
+	challenges = ownership.NewStore(ownership.DefaultChallengeTTL, challengeKey(options))
+	RegisterReadinessCheck("customer store", store.Check)
+
+	return
+}
+
+// CreateCustomer handles requests for creating the customer profiles from the customer data.
+// The data is either JSON or multipart/form-data with the files like the ones of the verification requests.
+// The document files are moved to the document vault if it's enabled, so the profile references them by the ids.
+// It responds with 201 status and the new customer.
+func CreateCustomer(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeErrorResponse(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	userData, documents, err := decodeCustomer(r)
+	if err != nil {
+		writeErrorResponse(w, err.status, err)
+		return
+	}
+
+	customer, err2 := profiles.Create(userData, documents)
+	if err2 != nil {
+		log.Println("CreateCustomer Error: ", err2)
+		writeErrorResponse(w, http.StatusInternalServerError, err2)
+		return
+	}
+	log.Printf("CreateCustomer: customer %s created\n", customer.ID)
+
+	w.Header().Set("Location", CustomersPath+"/"+customer.ID)
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(customer)
+}
+
+// Customer handles requests for the customer profiles:
+//
+//	GET  /customers/{id}                the customer with the overall KYC status
+//	PUT  /customers/{id}                replaces the customer data
+//	POST /customers/{id}/verifications  verifies the customer by the provider
+//
+// The GET request with the "refresh" query parameter set to true checks the status of the latest verifications
+// the providers haven't come to the decision about yet.
+func Customer(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, CustomersPath+"/"), "/")
+	if len(parts[0]) == 0 || len(parts) > 2 || (len(parts) == 2 && parts[1] != "verifications") {
+		writeErrorResponse(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	switch {
+	case len(parts) == 2 && r.Method != http.MethodPost:
+		w.Header().Set("Allow", http.MethodPost)
+		writeErrorResponse(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	case len(parts) == 1 && r.Method != http.MethodGet && r.Method != http.MethodPut:
+		w.Header().Set("Allow", http.MethodGet+", "+http.MethodPut)
+		writeErrorResponse(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	customer, err := profiles.Get(parts[0])
+	if err != nil {
+		writeErrorResponse(w, http.StatusNotFound, err)
+		return
+	}
+
+	switch {
+	case len(parts) == 2:
+		verifyCustomer(w, r, customer)
+	case r.Method == http.MethodPut:
+		userData, documents, err1 := decodeCustomer(r)
+		if err1 != nil {
+			writeErrorResponse(w, err1.status, err1)
+			return
+		}
+		if customer, err = profiles.Update(customer.ID, userData, documents); err != nil {
+			writeStoreError(w, err)
+			return
+		}
+		json.NewEncoder(w).Encode(customer)
+	default:
+		if r.URL.Query().Get("refresh") == "true" {
+			customer = refreshCustomer(customer)
+		}
+		json.NewEncoder(w).Encode(customer)
+	}
+}
+
+// verifyCustomer verifies the stored customer by the provider of the request and records the verification.
+// It responds with 201 status and the customer updated, the error returned by the provider is reported
+// by the verification.
+func verifyCustomer(w http.ResponseWriter, r *http.Request, customer common.Customer) {
+	req := common.CustomerVerificationRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	if len(req.Provider) == 0 {
+		writeErrorResponse(w, http.StatusBadRequest, errors.New("missing KYC provider id in the request"))
+		return
+	}
+
+	service, err1 := createCustomerChecker(req.Provider, req.Instance)
+	if err1 != nil {
+		log.Println("VerifyCustomer Error: ", err1)
+		writeErrorResponse(w, err1.status, err1)
+		return
+	}
+
+	// The stored data is copied, so the documents taken from the vault aren't kept in the profile.
+	userData := &common.UserData{}
+	if err := copyUserData(userData, customer.UserData); err != nil {
+		writeErrorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+	if err1 = attachStoredDocuments(userData); err1 != nil {
+		writeErrorResponse(w, err1.status, err1)
+		return
+	}
+
+	response := checkCustomer(service, common.CheckCustomerRequest{
+		Provider: req.Provider,
+		Instance: req.Instance,
+		UserData: userData,
+	}, requestCacheControl(r))
+
+	now := time.Now().UTC()
+	customer, err := profiles.AddVerification(customer.ID, common.CustomerVerification{
+		Provider:  req.Provider,
+		Instance:  req.Instance,
+		Result:    response.Result,
+		Error:     response.Error,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+	log.Printf("VerifyCustomer: customer %s verified by %s\n", customer.ID, config.SectionName(req.Provider, req.Instance))
+	if response.Retryable {
+		markRetryable(w)
+	}
+
+	w.Header().Set("Location", CustomersPath+"/"+customer.ID)
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(customer)
+}
+
+// refreshCustomer checks the status of the latest verifications of the customer which are still unclear
+// and have the status check references. The failed checks leave the verifications as they are.
+func refreshCustomer(customer common.Customer) common.Customer {
+	for _, i := range customers.Latest(customer.Verifications) {
+		verification := customer.Verifications[i]
+		result := verification.Result
+		if result == nil || result.StatusCheck == nil || result.Status != common.KYCStatus2Status[common.Unclear] {
+			continue
+		}
+
+		instance, referenceID, err1 := parseStatusReference(verification.Provider, verification.Instance, result.StatusCheck.ReferenceID)
+		if err1 != nil {
+			log.Println("RefreshCustomer Error: ", err1)
+			continue
+		}
+
+		service, err1 := createStatusChecker(verification.Provider, instance)
+		if err1 != nil {
+			log.Println("RefreshCustomer Error: ", err1)
+			continue
+		}
+
+		kycResult, err := service.CheckStatus(referenceID)
+		if err != nil {
+			log.Println("RefreshCustomer Error: ", err)
+			continue
+		}
+		kycResult.StatusCheck = instanceStatusCheck(verification.Provider, instance, kycResult.StatusCheck)
+
+		if updated, err := profiles.UpdateVerification(customer.ID, i, common.ResultFromKYCResult(kycResult), ""); err == nil {
+			customer = updated
+		}
+	}
+
+	return customer
+}
+
+// decodeCustomer decodes the customer data of the request and links its document files.
+// The files are stored in the document vault, the data keeps the ids of the documents only. The files sent
+// are rejected if the vault is disabled and need the documents:write scope.
+func decodeCustomer(r *http.Request) (userData *common.UserData, documents []string, err *serviceError) {
+	userData = &common.UserData{}
+	if err = decodeRequest(r, userData, func() *common.UserData { return userData }, decodeOptions{empty: "empty request"}); err != nil {
+		return
+	}
+
+	files := documentFiles(reflect.ValueOf(userData))
+	for _, document := range files {
+		if len(document.DocumentID) > 0 || len(document.Data) == 0 {
+			continue
+		}
+
+		v, options, err1 := currentVault()
+		switch {
+		case err1 != nil && err1.status == http.StatusForbidden:
+			err = &serviceError{
+				status:  http.StatusUnprocessableEntity,
+				code:    common.ProblemInvalidRequest,
+				message: "document vault is disabled: the profiles can reference the stored documents only",
+			}
+			return
+		case err1 != nil:
+			err = err1
+			return
+		}
+		if err = authorizeScope(r, options.WriteTokens, ScopeDocumentsWrite); err != nil {
+			return
+		}
+
+		doc, _, err2 := v.Put(*document, options.Retention)
+		if err2 != nil {
+			log.Println("Customer documents Error: ", err2)
+			err = &serviceError{status: http.StatusInternalServerError, message: err2.Error()}
+			return
+		}
+		document.DocumentID = doc.ID
+	}
+
+	linked := map[string]bool{}
+	for _, document := range files {
+		document.Data = nil
+		if len(document.DocumentID) > 0 && !linked[document.DocumentID] {
+			linked[document.DocumentID] = true
+			documents = append(documents, document.DocumentID)
+		}
+	}
+
+	return
+}
+
+// writeStoreError writes the error of the customers store: 404 for the unknown customer, 500 otherwise.
+func writeStoreError(w http.ResponseWriter, err error) {
+	if err == customers.ErrNotFound {
+		writeErrorResponse(w, http.StatusNotFound, err)
+		return
+	}
+
+	log.Println("Customers store Error: ", err)
+	writeErrorResponse(w, http.StatusInternalServerError, err)
+}
+
+// copyUserData makes the deep copy of the customer data.
+func copyUserData(dst, src *common.UserData) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, dst)
+}
@@ -0,0 +1,193 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"modulus/kyc/common"
+	"modulus/kyc/main/config"
+	"modulus/kyc/main/handlers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomers(t *testing.T) {
+	assert := assert.New(t)
+
+	serve := func(method, path, body string) (w *httptest.ResponseRecorder, customer common.Customer) {
+		w = httptest.NewRecorder()
+		r := httptest.NewRequest(method, path, strings.NewReader(body))
+		if path == handlers.CustomersPath {
+			handlers.CreateCustomer(w, r)
+		} else {
+			handlers.Customer(w, r)
+		}
+		json.Unmarshal(w.Body.Bytes(), &customer)
+		return
+	}
+
+	w, customer := serve(http.MethodPost, handlers.CustomersPath, `{"FirstName": "Abby", "LastName": "Doe"}`)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	assert.NotEmpty(customer.ID)
+	assert.Equal(handlers.CustomersPath+"/"+customer.ID, w.Header().Get("Location"))
+	assert.Equal(common.CustomerNotVerified, customer.Status)
+	assert.Equal("Abby", customer.UserData.FirstName)
+
+	path := handlers.CustomersPath + "/" + customer.ID
+
+	w, customer = serve(http.MethodPost, path+"/verifications", `{"Provider": "Example"}`)
+
+	assert.Equal(http.StatusCreated, w.Code)
+	assert.Equal(common.CustomerApproved, customer.Status)
+	if assert.Len(customer.Verifications, 1) && assert.NotNil(customer.Verifications[0].Result) {
+		assert.Equal(common.Example, customer.Verifications[0].Provider)
+		assert.Equal("Approved", customer.Verifications[0].Result.Status)
+	}
+
+	// The data is replaced, the verifications are kept.
+	w, customer = serve(http.MethodPut, path, `{"FirstName": "Jane", "LastName": "Doe"}`)
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("Jane", customer.UserData.FirstName)
+	assert.Len(customer.Verifications, 1)
+
+	w, customer = serve(http.MethodGet, path+"?refresh=true", "")
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("Jane", customer.UserData.FirstName)
+	assert.Equal(common.CustomerApproved, customer.Status)
+
+	w, _ = serve(http.MethodPost, path+"/verifications", `{}`)
+
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal(`{"Error":"missing KYC provider id in the request"}`, w.Body.String())
+
+	w, _ = serve(http.MethodPost, path+"/verifications", `{"Provider": "Foo"}`)
+
+	assert.Equal(http.StatusNotFound, w.Code)
+
+	w, _ = serve(http.MethodDelete, path, "")
+
+	assert.Equal(http.StatusMethodNotAllowed, w.Code)
+	assert.Equal("GET, PUT", w.Header().Get("Allow"))
+
+	w, _ = serve(http.MethodGet, path+"/verifications", "")
+
+	assert.Equal(http.StatusMethodNotAllowed, w.Code)
+
+	w, _ = serve(http.MethodGet, handlers.CustomersPath+"/unknown", "")
+
+	assert.Equal(http.StatusNotFound, w.Code)
+	assert.Equal(`{"Error":"customer not found"}`, w.Body.String())
+
+	w, _ = serve(http.MethodPost, handlers.CustomersPath, "")
+
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal(`{"Error":"empty request"}`, w.Body.String())
+
+	// The files aren't kept in the profiles without the document vault.
+	w, _ = serve(http.MethodPut, path, `{"FirstName": "Jane", "Passport": {"Image": {"Filename": "passport.jpg", "Data": "cGFzc3BvcnQ="}}}`)
+
+	assert.Equal(http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(`{"Error":"document vault is disabled: the profiles can reference the stored documents only"}`, w.Body.String())
+}
+
+func TestCustomerDocuments(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "kyc-vault-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	previous := config.Current().Config
+	defer config.Set(previous)
+
+	cfg := previous.Clone()
+	cfg[config.VaultSection] = config.Options{"Dir": dir, "Key": strings.Repeat("cd", 32), "WriteTokens": "uploader"}
+	config.Set(cfg)
+
+	body, _ := json.Marshal(common.UserData{
+		FirstName: "John",
+		Passport:  &common.Passport{Image: &common.DocumentFile{Filename: "passport.jpg", Data: []byte("passport")}},
+		Selfie:    &common.Selfie{Image: &common.DocumentFile{Filename: "selfie.jpg", Data: []byte("passport")}},
+	})
+	create := func(token string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, handlers.CustomersPath, bytes.NewReader(body))
+		if len(token) > 0 {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		handlers.CreateCustomer(w, r)
+		return w
+	}
+
+	// The files are stored in the vault with the write scope only.
+	w := create("")
+
+	assert.Equal(http.StatusUnauthorized, w.Code)
+	assert.Equal(`{"Error":"token granting documents:write scope required"}`, w.Body.String())
+
+	w = create("uploader")
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	customer := common.Customer{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &customer))
+
+	// The files are moved to the vault and deduplicated.
+	if assert.Len(customer.Documents, 1) {
+		assert.Equal(customer.Documents[0], customer.UserData.Passport.Image.DocumentID)
+		assert.Equal(customer.Documents[0], customer.UserData.Selfie.Image.DocumentID)
+	}
+	assert.Empty(customer.UserData.Passport.Image.Data)
+
+	w = httptest.NewRecorder()
+	handlers.Customer(w, httptest.NewRequest(http.MethodPost, handlers.CustomersPath+"/"+customer.ID+"/verifications",
+		strings.NewReader(`{"Provider": "Example"}`)))
+
+	assert.Equal(http.StatusCreated, w.Code)
+	assert.NotContains(w.Body.String(), "cGFzc3BvcnQ")
+}
+
+func TestStartCustomers(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "kyc-customers-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	defer handlers.StartCustomers(config.Customers{})
+
+	options := config.Customers{Dir: dir, Key: strings.Repeat("ef", 32)}
+	require.NoError(t, handlers.StartCustomers(options))
+
+	w := httptest.NewRecorder()
+	handlers.CreateCustomer(w, httptest.NewRequest(http.MethodPost, handlers.CustomersPath, strings.NewReader(`{"FirstName": "Abby"}`)))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	customer := common.Customer{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &customer))
+
+	// The profiles are loaded again on the restart.
+	require.NoError(t, handlers.StartCustomers(options))
+
+	w = httptest.NewRecorder()
+	handlers.Customer(w, httptest.NewRequest(http.MethodGet, handlers.CustomersPath+"/"+customer.ID, nil))
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Contains(w.Body.String(), `"FirstName":"Abby"`)
+
+	// The readiness fails when the store directory is gone.
+	os.RemoveAll(dir)
+	w = httptest.NewRecorder()
+	handlers.Readyz(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	assert.Equal(http.StatusServiceUnavailable, w.Code)
+	assert.Contains(w.Body.String(), "customer store isn't reachable")
+}
@@ -0,0 +1,431 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"modulus/kyc/common"
+)
+
+// Default limits of the verification requests.
+const (
+	// DefaultMaxRequestSize limits the size of the whole request body.
+	DefaultMaxRequestSize = 256 << 20
+	// DefaultMaxFileSize limits the size of a single file of the multipart request.
+	DefaultMaxFileSize = 128 << 20
+)
+
+const (
+	// RequestPart is the name of the part of the multipart/form-data request holding the JSON request.
+	// The other parts are the files referenced by the Filename of the documents of the customer.
+	RequestPart = "request"
+	// maxRequestPartSize limits the size of the JSON part of the multipart request.
+	maxRequestPartSize = 16 << 20
+)
+
+// errRequestTooLarge is returned by the limitedReader when the limit has been exceeded.
+var errRequestTooLarge = errors.New("request body is too large")
+
+// requestLimits holds the limits of the verification requests.
+var requestLimits = struct {
+	maxRequestSize int64
+	maxFileSize    int64
+}{DefaultMaxRequestSize, DefaultMaxFileSize}
+
+// SetRequestLimits sets the limits of the size of the verification requests and their files.
+// The zero values take the defaults. It must be called before the service starts serving requests.
+func SetRequestLimits(maxRequestSize, maxFileSize int64) {
+	if maxRequestSize <= 0 {
+		maxRequestSize = DefaultMaxRequestSize
+	}
+	if maxFileSize <= 0 {
+		maxFileSize = DefaultMaxFileSize
+	}
+
+	requestLimits.maxRequestSize = maxRequestSize
+	requestLimits.maxFileSize = maxFileSize
+}
+
+// decodeOptions represents the options of the request decoding.
+type decodeOptions struct {
+	// strict rejects the unknown fields of the JSON request.
+	strict bool
+	// log receives the JSON request as it's read, if it's set.
+	log io.Writer
+	// empty is the error message of the empty request. The decoding error is reported if it isn't set.
+	empty string
+}
+
+// decodeRequest decodes the verification request from the body streamed.
+// The request is either JSON or multipart/form-data with the JSON request in the RequestPart and the binary files
+// in the other parts. The files are attached to the documents of the customer returned by the customer function
+// whose Filename matches the filename of the part and which have no data. The integrations take the document
+// data in memory, so the files are read into memory bounded by the file and the request size limits.
+// The files following the request part which no document references are skipped.
+// The documents referenced by the DocumentID are taken from the document vault.
+func decodeRequest(r *http.Request, v interface{}, customer func() *common.UserData, opts decodeOptions) (err *serviceError) {
+	body := &limitedReader{r: r.Body, n: requestLimits.maxRequestSize}
+
+	// The body reading fails if the client disconnects or stops sending it, so it's reported as the bad request.
+	defer func() {
+		if err != nil && body.err != nil {
+			err = &serviceError{
+				status:  http.StatusBadRequest,
+				code:    common.ProblemInvalidRequest,
+				message: fmt.Sprintf("failed to read the request body: %s", body.err),
+			}
+		}
+	}()
+
+	mediaType, params, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		if err = decodeJSON(body, v, opts); err == nil {
+			err = attachStoredDocuments(customer())
+		}
+		return
+	}
+
+	files := uploadedFiles{}
+
+	found := false
+	reader := multipart.NewReader(body, params["boundary"])
+	for {
+		part, err1 := reader.NextPart()
+		if err1 == io.EOF {
+			break
+		}
+		if err1 != nil {
+			err = decodingError(err1)
+			return
+		}
+
+		switch {
+		case part.FormName() == RequestPart:
+			if found {
+				err = &serviceError{status: http.StatusBadRequest, code: common.ProblemInvalidRequest, message: "duplicate request part"}
+				return
+			}
+			found = true
+
+			part := &limitedReader{r: part, n: maxRequestPartSize}
+			if err = decodeJSON(part, v, opts); err != nil {
+				return
+			}
+		case len(part.FileName()) > 0:
+			if found && !referenced(customer(), part.FileName()) {
+				continue
+			}
+			if err = files.add(part); err != nil {
+				return
+			}
+		}
+	}
+
+	if !found {
+		err = &serviceError{
+			status:  http.StatusBadRequest,
+			code:    common.ProblemInvalidRequest,
+			message: fmt.Sprintf("missing %s part in the multipart request", RequestPart),
+		}
+		return
+	}
+
+	if c := customer(); c != nil {
+		if err = files.attach(c); err == nil {
+			err = attachStoredDocuments(c)
+		}
+	}
+
+	return
+}
+
+// decodeJSON decodes the JSON request.
+func decodeJSON(r io.Reader, v interface{}, opts decodeOptions) (err *serviceError) {
+	if opts.log != nil {
+		r = io.TeeReader(r, opts.log)
+	}
+
+	decoder := json.NewDecoder(r)
+	if opts.strict {
+		// The member names are matched by the encoding/json case-insensitively, so they're checked beforehand.
+		raw := json.RawMessage{}
+		if err1 := decoder.Decode(&raw); err1 != nil {
+			return jsonError(err1, opts)
+		}
+		if err1 := checkMemberNames(raw, reflect.TypeOf(v)); err1 != nil {
+			return decodingError(err1)
+		}
+
+		decoder = json.NewDecoder(bytes.NewReader(raw))
+		decoder.DisallowUnknownFields()
+	}
+	if err1 := decoder.Decode(v); err1 != nil {
+		return jsonError(err1, opts)
+	}
+
+	return
+}
+
+// jsonError converts the error of the JSON request decoding into the service error.
+func jsonError(err error, opts decodeOptions) *serviceError {
+	if err == io.EOF && len(opts.empty) > 0 {
+		return &serviceError{status: http.StatusBadRequest, code: common.ProblemInvalidRequest, message: opts.empty}
+	}
+
+	return decodingError(err)
+}
+
+// jsonUnmarshalerType is the type of the values decoding themselves.
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// checkMemberNames checks that the names of the members of the JSON objects match the names of the fields
+// of the type exactly. The unknown member is reported the same way the decoder rejecting unknown fields does.
+func checkMemberNames(data []byte, t reflect.Type) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return checkValueMemberNames(value, t)
+}
+
+// checkValueMemberNames checks the names of the members of the decoded JSON value of the type.
+func checkValueMemberNames(value interface{}, t reflect.Type) error {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+		return nil
+	}
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := jsonFields(t)
+			for name, member := range value {
+				field, ok := fields[name]
+				if !ok {
+					return fmt.Errorf("json: unknown field %q", name)
+				}
+				if err := checkValueMemberNames(member, field); err != nil {
+					return err
+				}
+			}
+		case reflect.Map:
+			for _, member := range value {
+				if err := checkValueMemberNames(member, t.Elem()); err != nil {
+					return err
+				}
+			}
+		}
+	case []interface{}:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for _, element := range value {
+				if err := checkValueMemberNames(element, t.Elem()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// jsonFields returns the types of the JSON encoded fields of the struct type by their names.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := tag
+		if idx := strings.Index(tag, ","); idx >= 0 {
+			name = tag[:idx]
+		}
+
+		if field.Anonymous && len(name) == 0 {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for name, embedded := range jsonFields(ft) {
+					if _, ok := fields[name]; !ok {
+						fields[name] = embedded
+					}
+				}
+				continue
+			}
+		}
+		if len(field.PkgPath) > 0 {
+			// Unexported field.
+			continue
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+
+		fields[name] = field.Type
+	}
+
+	return fields
+}
+
+// decodingError converts the error of the request reading into the service error.
+func decodingError(err error) *serviceError {
+	if err == errRequestTooLarge {
+		return &serviceError{status: http.StatusRequestEntityTooLarge, code: common.ProblemInvalidRequest, message: err.Error()}
+	}
+
+	return &serviceError{status: http.StatusBadRequest, code: common.ProblemInvalidRequest, message: err.Error()}
+}
+
+// uploadedFile holds the file of the multipart request.
+type uploadedFile struct {
+	contentType string
+	data        []byte
+}
+
+// uploadedFiles holds the files of the multipart request by their filenames.
+type uploadedFiles map[string]*uploadedFile
+
+// add reads the file part limited by the maximum file size.
+func (files uploadedFiles) add(part *multipart.Part) (err *serviceError) {
+	filename := part.FileName()
+	if _, ok := files[filename]; ok {
+		return &serviceError{status: http.StatusBadRequest, code: common.ProblemInvalidRequest, message: fmt.Sprintf("duplicate file %s", filename)}
+	}
+
+	data, err1 := ioutil.ReadAll(&limitedReader{r: part, n: requestLimits.maxFileSize})
+	if err1 != nil {
+		return fileError(filename, err1)
+	}
+	files[filename] = &uploadedFile{
+		contentType: part.Header.Get("Content-Type"),
+		data:        data,
+	}
+
+	return
+}
+
+// attach sets the data of the documents of the customer from the files with the matching filenames.
+func (files uploadedFiles) attach(customer *common.UserData) (err *serviceError) {
+	for _, document := range documentFiles(reflect.ValueOf(customer)) {
+		file, ok := files[document.Filename]
+		if !ok || len(document.Data) > 0 {
+			continue
+		}
+
+		document.Data = file.data
+		if len(document.ContentType) == 0 {
+			document.ContentType = file.contentType
+		}
+	}
+
+	return
+}
+
+// referenced tells whether any document of the customer without data references the file.
+func referenced(customer *common.UserData, filename string) bool {
+	if customer == nil {
+		return false
+	}
+	for _, document := range documentFiles(reflect.ValueOf(customer)) {
+		if document.Filename == filename && len(document.Data) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fileError converts the error of the file part reading into the service error.
+func fileError(filename string, err error) *serviceError {
+	if err == errRequestTooLarge {
+		return &serviceError{
+			status:  http.StatusRequestEntityTooLarge,
+			code:    common.ProblemInvalidRequest,
+			message: fmt.Sprintf("file %s or the request body is too large", filename),
+		}
+	}
+
+	return decodingError(err)
+}
+
+// documentFileType is the type of the document files of the customer.
+var documentFileType = reflect.TypeOf(common.DocumentFile{})
+
+// documentFiles returns all document files of the customer data including the ones of the nested documents.
+// The files of the types defined as DocumentFile, e.g. VideoAuth, are returned too.
+func documentFiles(v reflect.Value) (files []*common.DocumentFile) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return
+		}
+		if v.Elem().Kind() == reflect.Struct && v.Elem().Type().ConvertibleTo(documentFileType) {
+			files = append(files, v.Convert(reflect.PtrTo(documentFileType)).Interface().(*common.DocumentFile))
+			return
+		}
+		files = documentFiles(v.Elem())
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if field := v.Field(i); field.CanInterface() {
+				if field.Kind() == reflect.Struct && field.Type().ConvertibleTo(documentFileType) {
+					files = append(files, field.Addr().Convert(reflect.PtrTo(documentFileType)).Interface().(*common.DocumentFile))
+					continue
+				}
+				files = append(files, documentFiles(field)...)
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			files = append(files, documentFiles(v.Index(i))...)
+		}
+	}
+
+	return
+}
+
+// limitedReader reads from r until n bytes have been read and fails with errRequestTooLarge on the excess.
+// It keeps the error of the reading from r other than io.EOF.
+type limitedReader struct {
+	r   io.Reader
+	n   int64
+	err error
+}
+
+// Read implements io.Reader interface for the limitedReader.
+func (l *limitedReader) Read(p []byte) (n int, err error) {
+	if l.n < 0 {
+		return 0, errRequestTooLarge
+	}
+	if int64(len(p)) > l.n+1 {
+		p = p[:l.n+1]
+	}
+
+	n, err = l.r.Read(p)
+	if err != nil && err != io.EOF {
+		l.err = err
+	}
+	l.n -= int64(n)
+	if l.n < 0 {
+		n += int(l.n)
+		err = errRequestTooLarge
+	}
+
+	return
+}
@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"modulus/kyc/common"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// multipartRequest builds the multipart/form-data verification request with the files given by their filenames.
+func multipartRequest(request string, files map[string][]byte) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if len(request) > 0 {
+		part, _ := writer.CreateFormField(RequestPart)
+		part.Write([]byte(request))
+	}
+	for filename, data := range files {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", `form-data; name="file"; filename="`+filename+`"`)
+		header.Set("Content-Type", "image/jpeg")
+		part, _ := writer.CreatePart(header)
+		part.Write(data)
+	}
+	writer.Close()
+
+	r := httptest.NewRequest(http.MethodPost, "/CheckCustomer", body)
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return r
+}
+
+func TestDecodeRequestMultipart(t *testing.T) {
+	assert := assert.New(t)
+
+	video := bytes.Repeat([]byte{1}, 2<<20)
+	r := multipartRequest(`{
+		"Provider": "Example",
+		"UserData": {
+			"Passport": {"Number": "1234", "Image": {"Filename": "passport.jpg"}},
+			"DriverLicense": {"FrontImage": {"Filename": "front.jpg", "ContentType": "image/png"}},
+			"VideoAuth": {"Filename": "video.mp4"},
+			"Selfie": {"Image": {"Filename": "missing.jpg"}}
+		}
+	}`, map[string][]byte{
+		"passport.jpg": []byte("passport"),
+		"front.jpg":    []byte("front"),
+		"video.mp4":    video,
+		"unused.jpg":   []byte("unused"),
+	})
+
+	req := common.CheckCustomerRequest{}
+	err := decodeRequest(r, &req, func() *common.UserData { return req.UserData }, decodeOptions{})
+
+	if assert.Nil(err) && assert.NotNil(req.UserData) {
+		assert.Equal(common.Example, req.Provider)
+		assert.Equal("1234", req.UserData.Passport.Number)
+		assert.Equal([]byte("passport"), req.UserData.Passport.Image.Data)
+		assert.Equal("image/jpeg", req.UserData.Passport.Image.ContentType)
+		assert.Equal([]byte("front"), req.UserData.DriverLicense.FrontImage.Data)
+		assert.Equal("image/png", req.UserData.DriverLicense.FrontImage.ContentType)
+		assert.Equal(video, req.UserData.VideoAuth.Data)
+		assert.Empty(req.UserData.Selfie.Image.Data)
+	}
+}
+
+func TestDecodeVerificationRequestMultipart(t *testing.T) {
+	assert := assert.New(t)
+
+	r := multipartRequest(`{
+		"provider": "Example",
+		"customer": {
+			"firstName": "John",
+			"passport": {"number": "1234", "image": {"filename": "passport.jpg"}},
+			"videoAuth": {"filename": "video.mp4", "contentType": "video/mp4"}
+		}
+	}`, map[string][]byte{
+		"passport.jpg": []byte("passport"),
+		"video.mp4":    []byte("video"),
+	})
+
+	req := common.VerificationRequest{}
+	customer := verificationCustomer(&req)
+	err := decodeRequest(r, &req, customer, decodeOptions{strict: true})
+
+	if assert.Nil(err) && assert.NotNil(customer()) {
+		userData := customer()
+		assert.Equal("John", userData.FirstName)
+		assert.Equal("1234", userData.Passport.Number)
+		assert.Equal([]byte("passport"), userData.Passport.Image.Data)
+		assert.Equal("image/jpeg", userData.Passport.Image.ContentType)
+		assert.Equal([]byte("video"), userData.VideoAuth.Data)
+		assert.Equal("video/mp4", userData.VideoAuth.ContentType)
+	}
+}
+
+func TestDecodeRequestErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	defer SetRequestLimits(0, 0)
+	SetRequestLimits(1<<20, 100)
+
+	decode := func(r *http.Request) *serviceError {
+		req := common.VerificationRequest{}
+		return decodeRequest(r, &req, verificationCustomer(&req), decodeOptions{strict: true, empty: "empty request"})
+	}
+
+	err := decode(httptest.NewRequest(http.MethodPost, "/v2/verifications", strings.NewReader("")))
+
+	if assert.NotNil(err) {
+		assert.Equal(http.StatusBadRequest, err.status)
+		assert.Equal("empty request", err.message)
+	}
+
+	err = decode(httptest.NewRequest(http.MethodPost, "/v2/verifications", strings.NewReader(`{"Unknown": 1}`)))
+
+	if assert.NotNil(err) {
+		assert.Equal(http.StatusBadRequest, err.status)
+		assert.Equal(`json: unknown field "Unknown"`, err.message)
+	}
+
+	// The member names are matched exactly.
+	err = decode(httptest.NewRequest(http.MethodPost, "/v2/verifications", strings.NewReader(`{"provider": "Example", "customer": {"FirstName": "John"}}`)))
+
+	if assert.NotNil(err) {
+		assert.Equal(http.StatusBadRequest, err.status)
+		assert.Equal(`json: unknown field "FirstName"`, err.message)
+	}
+
+	err = decode(multipartRequest("", map[string][]byte{"passport.jpg": []byte("passport")}))
+
+	if assert.NotNil(err) {
+		assert.Equal(http.StatusBadRequest, err.status)
+		assert.Equal("missing request part in the multipart request", err.message)
+	}
+
+	err = decode(multipartRequest(`{"provider": "Example", "customer": {"videoAuth": {"filename": "video.mp4"}}}`, map[string][]byte{"video.mp4": bytes.Repeat([]byte{1}, 101)}))
+
+	if assert.NotNil(err) {
+		assert.Equal(http.StatusRequestEntityTooLarge, err.status)
+		assert.Equal("file video.mp4 or the request body is too large", err.message)
+	}
+
+	// The files no document references aren't read.
+	err = decode(multipartRequest(`{"provider": "Example"}`, map[string][]byte{"video.mp4": bytes.Repeat([]byte{1}, 101)}))
+
+	assert.Nil(err)
+
+	// The client disconnecting while sending the body.
+	err = decode(httptest.NewRequest(http.MethodPost, "/v2/verifications", io.MultiReader(strings.NewReader(`{"provider": "Exa`), iotest.ErrReader(io.ErrUnexpectedEOF))))
+
+	if assert.NotNil(err) {
+		assert.Equal(http.StatusBadRequest, err.status)
+		assert.Equal(common.ProblemInvalidRequest, err.code)
+		assert.Equal("failed to read the request body: unexpected EOF", err.message)
+	}
+
+	SetRequestLimits(100, 100)
+	err = decode(httptest.NewRequest(http.MethodPost, "/v2/verifications", strings.NewReader(`{"provider": "`+strings.Repeat("a", 100)+`"}`)))
+
+	if assert.NotNil(err) {
+		assert.Equal(http.StatusRequestEntityTooLarge, err.status)
+		assert.Equal(common.ProblemInvalidRequest, err.code)
+	}
+}
+
+func TestCheckCustomerMultipart(t *testing.T) {
+	r := multipartRequest(`{"Provider": "Example", "UserData": {"FirstName": "John", "Selfie": {"Image": {"Filename": "selfie.jpg"}}}}`,
+		map[string][]byte{"selfie.jpg": []byte("selfie")})
+	w := httptest.NewRecorder()
+
+	CheckCustomer(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"Result"`)
+}
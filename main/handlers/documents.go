@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"mime"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"modulus/kyc/common"
+	"modulus/kyc/main/config"
+	"modulus/kyc/vault"
+)
+
+// DocumentsPath is the path of the document vault API.
+const DocumentsPath = "/documents"
+
+// Scopes of the document vault API granted by the tokens of the vault config.
+const (
+	ScopeDocumentsRead  = "documents:read"
+	ScopeDocumentsWrite = "documents:write"
+)
+
+// documentVault holds the document vault configured by the active config. It's rebuilt when the config version changes.
+var documentVault struct {
+	sync.Mutex
+	version string
+	vault   *vault.Vault
+	options config.Vault
+	err     *serviceError
+}
+
+// currentVault returns the document vault configured by the active config.
+func currentVault() (v *vault.Vault, options config.Vault, err *serviceError) {
+	snapshot := config.Current()
+
+	documentVault.Lock()
+	defer documentVault.Unlock()
+
+	if documentVault.version != snapshot.Version || (documentVault.vault == nil && documentVault.err == nil) {
+		documentVault.version = snapshot.Version
+		documentVault.vault, documentVault.err = nil, nil
+
+		options, err1 := snapshot.Config.Vault()
+		switch {
+		case err1 != nil:
+			documentVault.err = &serviceError{status: http.StatusInternalServerError, code: common.ProblemConfigError, message: err1.Error()}
+		case !options.Enabled():
+			documentVault.err = &serviceError{status: http.StatusForbidden, message: "document vault is disabled"}
+		default:
+			documentVault.options = options
+			if documentVault.vault, err1 = vault.New(options.Dir, options.KeyBytes()); err1 != nil {
+				log.Println("Document vault Error: ", err1)
+				documentVault.err = &serviceError{status: http.StatusInternalServerError, message: err1.Error()}
+			}
+		}
+	}
+
+	return documentVault.vault, documentVault.options, documentVault.err
+}
+
+// StartDocuments registers the readiness check of the document vault.
+// It must be called before the service starts serving requests.
+func StartDocuments() {
+	RegisterReadinessCheck("document vault", checkVault)
+}
+
+// checkVault tells whether the directory of the document vault configured by the active config is writable.
+// The disabled vault is never reported.
+func checkVault() error {
+	v, _, err := currentVault()
+	if err != nil {
+		if err.status == http.StatusForbidden {
+			return nil
+		}
+		return err
+	}
+
+	return v.Check()
+}
+
+// UploadDocument handles requests for storing the document files in the vault.
+// The body is the content of the file of the type specified by the Content-Type header.
+// The optional "filename" and "retention" query parameters name the file and set the time it's kept for.
+// The request must be authorized with a token granting the documents:write scope.
+// It responds with 201 status for the new document and with 200 status if the same content has already been stored.
+func UploadDocument(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeErrorResponse(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	v, options, err1 := currentVault()
+	if err1 == nil {
+		err1 = authorizeScope(r, options.WriteTokens, ScopeDocumentsWrite)
+	}
+	if err1 != nil {
+		writeErrorResponse(w, err1.status, err1)
+		return
+	}
+
+	query := r.URL.Query()
+	retention := options.Retention
+	if value := query.Get("retention"); len(value) > 0 {
+		var err error
+		if retention, err = time.ParseDuration(value); err != nil || retention <= 0 || retention > options.MaxRetention {
+			writeErrorResponse(w, http.StatusBadRequest, fmt.Errorf("invalid retention: %s, positive duration up to %s expected", value, options.MaxRetention))
+			return
+		}
+	}
+
+	data, err := ioutil.ReadAll(&limitedReader{r: r.Body, n: requestLimits.maxFileSize})
+	if err != nil {
+		err1 = decodingError(err)
+		writeErrorResponse(w, err1.status, err1)
+		return
+	}
+	if len(data) == 0 {
+		writeErrorResponse(w, http.StatusBadRequest, errors.New("empty document"))
+		return
+	}
+
+	file := common.DocumentFile{
+		Filename:    query.Get("filename"),
+		ContentType: r.Header.Get("Content-Type"),
+		Data:        data,
+	}
+	// The form content type is the default one of the HTTP clients like curl, it isn't the type of the file.
+	if mediaType, _, err := mime.ParseMediaType(file.ContentType); err != nil || mediaType == "application/x-www-form-urlencoded" {
+		file.ContentType = "application/octet-stream"
+	}
+
+	doc, created, err := v.Put(file, retention)
+	if err != nil {
+		log.Println("UploadDocument Error: ", err)
+		writeErrorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Location", DocumentsPath+"/"+doc.ID)
+	if created {
+		w.WriteHeader(http.StatusCreated)
+	}
+	json.NewEncoder(w).Encode(doc)
+}
+
+// GetDocument handles requests for downloading the document files from the vault:
+//
+//	GET /documents/{id}  the content of the document
+//
+// The request must be authorized with a token granting the documents:read scope.
+func GetDocument(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeErrorResponse(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	v, options, err1 := currentVault()
+	if err1 == nil {
+		err1 = authorizeScope(r, options.ReadTokens, ScopeDocumentsRead)
+	}
+	if err1 != nil {
+		writeErrorResponse(w, err1.status, err1)
+		return
+	}
+
+	id := strings.TrimPrefix(r.URL.Path, DocumentsPath+"/")
+	doc, data, err := v.Get(id)
+	switch {
+	case err == vault.ErrNotFound:
+		writeErrorResponse(w, http.StatusNotFound, err)
+		return
+	case err != nil:
+		log.Println("GetDocument Error: ", err)
+		writeErrorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	filename := doc.Filename
+	if len(filename) == 0 {
+		filename = doc.ID
+	}
+	w.Header().Set("Content-Type", doc.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write(data)
+}
+
+// authorizeScope checks the bearer token of the request grants the scope. The admin token grants all scopes.
+func authorizeScope(r *http.Request, tokens []string, scope string) *serviceError {
+	auth := r.Header.Get("Authorization")
+	if strings.HasPrefix(auth, "Bearer ") {
+		token := []byte(strings.TrimPrefix(auth, "Bearer "))
+		if admin := config.Current().Config.AdminToken(); len(admin) > 0 && subtle.ConstantTimeCompare(token, []byte(admin)) == 1 {
+			return nil
+		}
+		for _, t := range tokens {
+			if subtle.ConstantTimeCompare(token, []byte(t)) == 1 {
+				return nil
+			}
+		}
+	}
+
+	return &serviceError{
+		status:  http.StatusUnauthorized,
+		code:    common.ProblemUnauthorized,
+		message: fmt.Sprintf("token granting %s scope required", scope),
+	}
+}
+
+// attachStoredDocuments sets the data of the documents of the customer referenced by the ids of the vault documents.
+// The filename and the content type of the stored document are taken if the reference doesn't specify them.
+func attachStoredDocuments(customer *common.UserData) (err *serviceError) {
+	for _, document := range documentFiles(reflect.ValueOf(customer)) {
+		if len(document.DocumentID) == 0 || len(document.Data) > 0 {
+			continue
+		}
+
+		v, _, err1 := currentVault()
+		if err1 != nil {
+			return &serviceError{status: http.StatusUnprocessableEntity, code: common.ProblemInvalidRequest, message: err1.message}
+		}
+
+		doc, data, err2 := v.Get(document.DocumentID)
+		switch {
+		case err2 == vault.ErrNotFound:
+			return &serviceError{
+				status:  http.StatusUnprocessableEntity,
+				code:    common.ProblemInvalidRequest,
+				message: fmt.Sprintf("document %s not found", document.DocumentID),
+			}
+		case err2 != nil:
+			return &serviceError{status: http.StatusInternalServerError, message: err2.Error()}
+		}
+
+		document.Data = data
+		if len(document.Filename) == 0 {
+			document.Filename = doc.Filename
+		}
+		if len(document.ContentType) == 0 {
+			document.ContentType = doc.ContentType
+		}
+	}
+
+	return
+}
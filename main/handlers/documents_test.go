@@ -0,0 +1,147 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"modulus/kyc/common"
+	"modulus/kyc/main/config"
+	"modulus/kyc/main/handlers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocuments(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "kyc-vault-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	previous := config.Current().Config
+	defer config.Set(previous)
+
+	cfg := previous.Clone()
+	cfg[config.VaultSection] = config.Options{
+		"Dir":         dir,
+		"Key":         strings.Repeat("ab", 32),
+		"ReadTokens":  "auditor",
+		"WriteTokens": "uploader",
+	}
+	config.Set(cfg)
+
+	upload := func(token, query string, data []byte) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, handlers.DocumentsPath+query, bytes.NewReader(data))
+		r.Header.Set("Content-Type", "image/jpeg")
+		if len(token) > 0 {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		handlers.UploadDocument(w, r)
+		return w
+	}
+	download := func(token, id string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, handlers.DocumentsPath+"/"+id, nil)
+		if len(token) > 0 {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		handlers.GetDocument(w, r)
+		return w
+	}
+
+	image := []byte("passport image")
+
+	w := upload("", "", image)
+
+	assert.Equal(http.StatusUnauthorized, w.Code)
+	assert.Equal(`{"Error":"token granting documents:write scope required"}`, w.Body.String())
+
+	w = upload("uploader", "?retention=forever", image)
+
+	assert.Equal(http.StatusBadRequest, w.Code)
+
+	w = upload("uploader", "?filename=passport.jpg&retention=48h", image)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	doc := common.StoredDocument{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+	assert.Equal("passport.jpg", doc.Filename)
+	assert.Equal("image/jpeg", doc.ContentType)
+	assert.Equal(handlers.DocumentsPath+"/"+doc.ID, w.Header().Get("Location"))
+
+	// The same content is deduplicated.
+	w = upload("uploader", "", image)
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Contains(w.Body.String(), doc.ID)
+
+	// The download requires the read scope.
+	w = download("uploader", doc.ID)
+
+	assert.Equal(http.StatusUnauthorized, w.Code)
+	assert.Equal(`{"Error":"token granting documents:read scope required"}`, w.Body.String())
+
+	w = download("auditor", doc.ID)
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal(image, w.Body.Bytes())
+	assert.Equal("image/jpeg", w.Header().Get("Content-Type"))
+	assert.Equal(`attachment; filename=passport.jpg`, w.Header().Get("Content-Disposition"))
+
+	w = download("auditor", strings.Repeat("0", 64))
+
+	assert.Equal(http.StatusNotFound, w.Code)
+
+	// The stored documents are referenced by the verification requests.
+	check := func(id string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(common.CheckCustomerRequest{
+			Provider: common.Example,
+			UserData: &common.UserData{
+				FirstName: "John",
+				Passport:  &common.Passport{Image: &common.DocumentFile{DocumentID: id}},
+			},
+		})
+		w := httptest.NewRecorder()
+		handlers.CheckCustomer(w, httptest.NewRequest(http.MethodPost, "/CheckCustomer", bytes.NewReader(body)))
+		return w
+	}
+
+	w = check(doc.ID)
+
+	assert.Equal(http.StatusOK, w.Code)
+
+	w = check(strings.Repeat("0", 64))
+
+	assert.Equal(http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(`{"Error":"document `+strings.Repeat("0", 64)+` not found"}`, w.Body.String())
+
+	// The uploads aren't open without the write tokens, the admin token is required then.
+	delete(cfg[config.VaultSection], "WriteTokens")
+	cfg["Config"] = config.Options{"AdminToken": "admin"}
+	config.Set(cfg)
+
+	w = upload("", "", image)
+
+	assert.Equal(http.StatusUnauthorized, w.Code)
+
+	w = upload("admin", "", image)
+
+	assert.Equal(http.StatusOK, w.Code)
+
+	// The vault is off without the directory.
+	delete(cfg, config.VaultSection)
+	config.Set(cfg)
+
+	w = download("auditor", doc.ID)
+
+	assert.Equal(http.StatusForbidden, w.Code)
+	assert.Equal(`{"Error":"document vault is disabled"}`, w.Body.String())
+}
@@ -8,8 +8,10 @@ import (
 )
 
 // serviceError represents an error that might happen during creating the KYC provider service.
+// The code is reported by the v2 API, it's derived from the status if empty.
 type serviceError struct {
 	status  int
+	code    common.ProblemCode
 	message string
 }
 
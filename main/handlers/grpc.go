@@ -0,0 +1,307 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"modulus/kyc/common"
+	"modulus/kyc/integrations/ciphertrace"
+	"modulus/kyc/kycpb"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrorInfoDomain is the domain of the google.rpc.ErrorInfo details of the gRPC errors.
+const ErrorInfoDomain = "kyc"
+
+// Status polling intervals of the WatchStatus calls.
+var (
+	defaultWatchInterval = 30 * time.Second
+	minWatchInterval     = time.Second
+)
+
+// grpcCodes maps the HTTP statuses of the service errors to the gRPC codes.
+var grpcCodes = map[int]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusUnprocessableEntity: codes.FailedPrecondition,
+	http.StatusInternalServerError: codes.Internal,
+}
+
+// grpcCategoryCodes maps the categories of the KYC errors to the gRPC codes.
+var grpcCategoryCodes = map[common.ErrorCategory]codes.Code{
+	common.ValidationError:          codes.InvalidArgument,
+	common.AuthenticationError:      codes.Internal,
+	common.RateLimitedError:         codes.ResourceExhausted,
+	common.ProviderUnavailableError: codes.Unavailable,
+	common.ProviderRejectedError:    codes.FailedPrecondition,
+	common.TimeoutError:             codes.DeadlineExceeded,
+}
+
+// retryableCodes holds the gRPC codes of the provider errors the status polling continues after.
+var retryableCodes = map[codes.Code]bool{
+	codes.ResourceExhausted: true,
+	codes.Unavailable:       true,
+	codes.DeadlineExceeded:  true,
+}
+
+// GRPCServer implements the KYC gRPC service.
+// The providers are constructed from the active config the same way as for the HTTP handlers.
+type GRPCServer struct {
+	kycpb.UnimplementedKYCServer
+}
+
+// CheckCustomer implements the CheckCustomer method of the KYC gRPC service.
+func (GRPCServer) CheckCustomer(ctx context.Context, req *kycpb.CheckCustomerRequest) (*kycpb.CheckResponse, error) {
+	if len(req.Provider) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing KYC provider id in the request")
+	}
+
+	service, err1 := createCustomerChecker(common.KYCProvider(req.Provider), req.Instance)
+	if err1 != nil {
+		return nil, serviceStatus(err1)
+	}
+
+	result, err := service.CheckCustomer(kycpb.UserDataToCommon(req.UserData))
+	if err != nil {
+		return nil, providerStatus(result, err)
+	}
+	result.StatusCheck = instanceStatusCheck(common.KYCProvider(req.Provider), req.Instance, result.StatusCheck)
+
+	return &kycpb.CheckResponse{Result: kycpb.ResultFromCommon(common.ResultFromKYCResult(result))}, nil
+}
+
+// CheckStatus implements the CheckStatus method of the KYC gRPC service.
+func (GRPCServer) CheckStatus(ctx context.Context, req *kycpb.CheckStatusRequest) (*kycpb.CheckResponse, error) {
+	service, err := newGRPCStatusChecker(req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := checkStatus(service, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &kycpb.CheckResponse{Result: result}, nil
+}
+
+// WatchStatus implements the WatchStatus method of the KYC gRPC service.
+// The status is polled until it isn't unclear any more. Only the changed results are sent to the client.
+// The retryable provider errors don't stop the polling, the others end the call.
+func (GRPCServer) WatchStatus(req *kycpb.WatchStatusRequest, stream kycpb.KYC_WatchStatusServer) error {
+	if req.Check == nil {
+		return status.Error(codes.InvalidArgument, "missing status check in the request")
+	}
+
+	service, err := newGRPCStatusChecker(req.Check)
+	if err != nil {
+		return err
+	}
+
+	interval := defaultWatchInterval
+	if req.PollIntervalSeconds > 0 {
+		interval = time.Duration(req.PollIntervalSeconds) * time.Second
+	}
+	if interval < minWatchInterval {
+		interval = minWatchInterval
+	}
+
+	var last *kycpb.Result
+	for {
+		result, err := checkStatus(service, req.Check)
+		if err != nil {
+			if !retryableCodes[status.Code(err)] {
+				return err
+			}
+		} else {
+			if !sameResult(last, result) {
+				if err := stream.Send(&kycpb.CheckResponse{Result: result}); err != nil {
+					return err
+				}
+				last = result
+			}
+			if result.Status != common.KYCStatus2Status[common.Unclear] {
+				return nil
+			}
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// ListProviders implements the ListProviders method of the KYC gRPC service.
+func (GRPCServer) ListProviders(ctx context.Context, req *kycpb.ListProvidersRequest) (*kycpb.ListProvidersResponse, error) {
+	response := &kycpb.ListProvidersResponse{}
+	for _, provider := range providerList() {
+		response.Providers = append(response.Providers, &kycpb.Provider{
+			Name:        string(provider),
+			Implemented: true,
+		})
+	}
+
+	return response, nil
+}
+
+// GetProvider implements the GetProvider method of the KYC gRPC service.
+func (GRPCServer) GetProvider(ctx context.Context, req *kycpb.GetProviderRequest) (*kycpb.Provider, error) {
+	provider := common.KYCProvider(req.Name)
+	if provider != common.Example && !common.KYCProviders[provider] {
+		return nil, status.Errorf(codes.NotFound, "unknown KYC provider: %s", provider)
+	}
+
+	return &kycpb.Provider{Name: req.Name, Implemented: true}, nil
+}
+
+// ScreenTransaction implements the ScreenTransaction method of the KYC gRPC service.
+func (GRPCServer) ScreenTransaction(ctx context.Context, req *kycpb.ScreenTransactionRequest) (*kycpb.TransactionRisk, error) {
+	service, ok := newCipherTrace()
+	if !ok {
+		return nil, status.Error(codes.Internal, "missing config for CipherTrace")
+	}
+
+	var (
+		risk *ciphertrace.AddressRisk
+		err  error
+		code int
+	)
+	switch req.Coin {
+	case "BTC":
+		risk, err, code = service.GtAddressRiskInfo(req.TxHash)
+	case "ETH":
+		risk, err, code = service.GtAddressRiskInfoETH(req.TxHash)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "not supported coin")
+	}
+	if err != nil {
+		var statusCode *int
+		if code > 0 {
+			statusCode = &code
+		}
+		return nil, providerStatus(common.KYCResult{}, common.NewProviderError(common.CipherTrace, statusCode, err))
+	}
+
+	response := &kycpb.TransactionRisk{
+		TxHash:          risk.Txhash,
+		Risk:            strconv.FormatFloat(risk.Risk, 'f', -1, 64),
+		UpdatedToBlock:  int64(risk.UpdatedToBlock),
+		CallbackSeconds: int64(risk.CallBackSeconds),
+		AddressRisks:    map[string]*kycpb.AddressRisk{},
+	}
+	for key, addressRisk := range risk.AddressRisks {
+		response.AddressRisks[key] = &kycpb.AddressRisk{
+			Address:         addressRisk.Address,
+			Risk:            strconv.FormatFloat(addressRisk.Risk, 'f', -1, 64),
+			InputValue:      addressRisk.InputValue.String(),
+			OutputValue:     addressRisk.OutputValue.String(),
+			CallbackSeconds: int64(addressRisk.CallBackSeconds),
+		}
+	}
+
+	return response, nil
+}
+
+// newGRPCStatusChecker validates the status check request and creates the status checker for its provider.
+// The instance and the provider reference id encoded in the reference id of the request replace the request ones.
+func newGRPCStatusChecker(req *kycpb.CheckStatusRequest) (service common.KYCPlatform, err error) {
+	if len(req.Provider) == 0 {
+		err = status.Error(codes.InvalidArgument, "missing KYC provider id in the request")
+		return
+	}
+	if len(req.ReferenceId) == 0 {
+		err = status.Error(codes.InvalidArgument, "missing verification id in the request")
+		return
+	}
+
+	instance, referenceID, err1 := parseStatusReference(common.KYCProvider(req.Provider), req.Instance, req.ReferenceId)
+	if err1 != nil {
+		err = serviceStatus(err1)
+		return
+	}
+	req.Instance, req.ReferenceId = instance, referenceID
+
+	service, err1 = createStatusChecker(common.KYCProvider(req.Provider), req.Instance)
+	if err1 != nil {
+		err = serviceStatus(err1)
+	}
+
+	return
+}
+
+// checkStatus checks the status of the verification converting the result into the protobuf message.
+// The returned error is the gRPC status wrapping the provider error.
+func checkStatus(service common.KYCPlatform, req *kycpb.CheckStatusRequest) (*kycpb.Result, error) {
+	result, err := service.CheckStatus(req.ReferenceId)
+	if err != nil {
+		return nil, providerStatus(result, err)
+	}
+	result.StatusCheck = instanceStatusCheck(common.KYCProvider(req.Provider), req.Instance, result.StatusCheck)
+
+	return kycpb.ResultFromCommon(common.ResultFromKYCResult(result)), nil
+}
+
+// sameResult tells whether the results are the same apart from the time of the last check.
+func sameResult(a, b *kycpb.Result) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	a, b = proto.Clone(a).(*kycpb.Result), proto.Clone(b).(*kycpb.Result)
+	if a.StatusCheck != nil {
+		a.StatusCheck.LastCheck = nil
+	}
+	if b.StatusCheck != nil {
+		b.StatusCheck.LastCheck = nil
+	}
+
+	return proto.Equal(a, b)
+}
+
+// serviceStatus converts the service error into the gRPC status error.
+func serviceStatus(err *serviceError) error {
+	code, ok := grpcCodes[err.status]
+	if !ok {
+		code = codes.Unknown
+	}
+
+	return status.Error(code, err.message)
+}
+
+// providerStatus converts the error returned by the KYC provider into the gRPC status error.
+// The problem code, the provider error code and the retryability are reported by the ErrorInfo detail.
+func providerStatus(result common.KYCResult, err error) error {
+	code, problem, retryable := codes.Unknown, common.ProblemProviderError, false
+	if kycErr, ok := common.AsKYCError(err); ok {
+		if c, ok := grpcCategoryCodes[kycErr.Category]; ok {
+			code = c
+			problem = errorCategories[kycErr.Category].code
+		}
+		retryable = kycErr.Retryable()
+	}
+
+	st := status.New(code, err.Error())
+	info := &errdetails.ErrorInfo{
+		Reason: string(problem),
+		Domain: ErrorInfoDomain,
+		Metadata: map[string]string{
+			"retryable": strconv.FormatBool(retryable),
+		},
+	}
+	if len(result.ErrorCode) > 0 {
+		info.Metadata["providerErrorCode"] = result.ErrorCode
+	}
+	if detailed, err1 := st.WithDetails(info); err1 == nil {
+		st = detailed
+	}
+
+	return st.Err()
+}
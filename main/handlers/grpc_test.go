@@ -0,0 +1,225 @@
+package handlers_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"modulus/kyc/common"
+	"modulus/kyc/kycpb"
+	"modulus/kyc/main/handlers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newGRPCClient starts the gRPC server in memory and returns the client connected to it.
+func newGRPCClient(t *testing.T) (client kycpb.KYCClient, stop func()) {
+	listener := bufconn.Listen(1 << 20)
+
+	server := grpc.NewServer()
+	kycpb.RegisterKYCServer(server, handlers.GRPCServer{})
+	go server.Serve(listener)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	client = kycpb.NewKYCClient(conn)
+	stop = func() {
+		conn.Close()
+		server.Stop()
+	}
+
+	return
+}
+
+// assertErrorInfo checks the gRPC error has the code specified and returns its ErrorInfo detail.
+func assertErrorInfo(t *testing.T, err error, code codes.Code) (info *errdetails.ErrorInfo) {
+	st := status.Convert(err)
+
+	assert.Equal(t, code, st.Code())
+	for _, detail := range st.Details() {
+		if i, ok := detail.(*errdetails.ErrorInfo); ok {
+			info = i
+		}
+	}
+	require.NotNil(t, info)
+	assert.Equal(t, handlers.ErrorInfoDomain, info.Domain)
+
+	return
+}
+
+func TestGRPCCheckCustomer(t *testing.T) {
+	assert := assert.New(t)
+
+	client, stop := newGRPCClient(t)
+	defer stop()
+
+	ctx := context.Background()
+
+	resp, err := client.CheckCustomer(ctx, &kycpb.CheckCustomerRequest{
+		Provider: string(common.Example),
+		UserData: &kycpb.UserData{FirstName: "Abby"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal("Approved", resp.Result.Status)
+
+	// Testing the error returned by the provider.
+	_, err = client.CheckCustomer(ctx, &kycpb.CheckCustomerRequest{
+		Provider: string(common.Example),
+		UserData: &kycpb.UserData{FirstName: "Erika"},
+	})
+
+	info := assertErrorInfo(t, err, codes.ResourceExhausted)
+	assert.Equal(string(common.ProblemRateLimited), info.Reason)
+	assert.Equal(map[string]string{"retryable": "true", "providerErrorCode": "429"}, info.Metadata)
+
+	// Testing the customer data rejected by the integration.
+	_, err = client.CheckCustomer(ctx, &kycpb.CheckCustomerRequest{
+		Provider: string(common.Example),
+		UserData: &kycpb.UserData{LastName: "Doe"},
+	})
+
+	info = assertErrorInfo(t, err, codes.InvalidArgument)
+	assert.Equal(string(common.ProblemValidationFailed), info.Reason)
+	assert.Equal("missing the required field: FirstName", status.Convert(err).Message())
+
+	_, err = client.CheckCustomer(ctx, &kycpb.CheckCustomerRequest{})
+
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.CheckCustomer(ctx, &kycpb.CheckCustomerRequest{Provider: "Foo"})
+
+	assert.Equal(codes.NotFound, status.Code(err))
+	assert.Equal("unknown KYC provider in the request: Foo", status.Convert(err).Message())
+
+	_, err = client.CheckCustomer(ctx, &kycpb.CheckCustomerRequest{Provider: string(common.Jumio), Instance: "missing"})
+
+	assert.Equal(codes.NotFound, status.Code(err))
+	assert.Equal("unknown Jumio instance in the request: missing", status.Convert(err).Message())
+}
+
+func TestGRPCCheckStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	client, stop := newGRPCClient(t)
+	defer stop()
+
+	ctx := context.Background()
+
+	resp, err := client.CheckStatus(ctx, &kycpb.CheckStatusRequest{Provider: string(common.Example), ReferenceId: "ada"})
+
+	require.NoError(t, err)
+	assert.Equal("Approved", resp.Result.Status)
+
+	_, err = client.CheckStatus(ctx, &kycpb.CheckStatusRequest{Provider: string(common.Example), ReferenceId: "elin"})
+
+	info := assertErrorInfo(t, err, codes.Internal)
+	assert.Equal(string(common.ProblemProviderAuthFailed), info.Reason)
+	assert.Equal("false", info.Metadata["retryable"])
+
+	_, err = client.CheckStatus(ctx, &kycpb.CheckStatusRequest{Provider: string(common.Example)})
+
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.CheckStatus(ctx, &kycpb.CheckStatusRequest{Provider: string(common.Trulioo), ReferenceId: "x"})
+
+	assert.Equal(codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGRPCWatchStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	client, stop := newGRPCClient(t)
+	defer stop()
+
+	// The completed verification is streamed once.
+	stream, err := client.WatchStatus(context.Background(), &kycpb.WatchStatusRequest{
+		Check: &kycpb.CheckStatusRequest{Provider: string(common.Example), ReferenceId: "dana"},
+	})
+	require.NoError(t, err)
+
+	resp, err := stream.Recv()
+
+	require.NoError(t, err)
+	assert.Equal("Denied", resp.Result.Status)
+
+	_, err = stream.Recv()
+
+	assert.Equal(io.EOF, err)
+
+	// The unclear verification is polled until the client cancels the call.
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err = client.WatchStatus(ctx, &kycpb.WatchStatusRequest{
+		Check:               &kycpb.CheckStatusRequest{Provider: string(common.Example), Instance: "eu", ReferenceId: "uma"},
+		PollIntervalSeconds: 1,
+	})
+	require.NoError(t, err)
+
+	resp, err = stream.Recv()
+
+	require.NoError(t, err)
+	assert.Equal("Unclear", resp.Result.Status)
+	assert.Equal("eu", resp.Result.StatusCheck.Instance)
+
+	cancel()
+	_, err = stream.Recv()
+
+	assert.Equal(codes.Canceled, status.Code(err))
+
+	// The provider error ends the call.
+	stream, err = client.WatchStatus(context.Background(), &kycpb.WatchStatusRequest{
+		Check: &kycpb.CheckStatusRequest{Provider: string(common.Example), ReferenceId: "elin"},
+	})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+
+	assert.Equal(codes.Internal, status.Code(err))
+
+	stream, err = client.WatchStatus(context.Background(), &kycpb.WatchStatusRequest{})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPCProviders(t *testing.T) {
+	assert := assert.New(t)
+
+	client, stop := newGRPCClient(t)
+	defer stop()
+
+	ctx := context.Background()
+
+	list, err := client.ListProviders(ctx, &kycpb.ListProvidersRequest{})
+
+	require.NoError(t, err)
+	assert.Len(list.Providers, len(common.KYCProviders))
+
+	provider, err := client.GetProvider(ctx, &kycpb.GetProviderRequest{Name: string(common.SumSub)})
+
+	require.NoError(t, err)
+	assert.True(provider.Implemented)
+
+	_, err = client.GetProvider(ctx, &kycpb.GetProviderRequest{Name: "Foo"})
+
+	assert.Equal(codes.NotFound, status.Code(err))
+
+	// CipherTrace isn't configured in the tests.
+	_, err = client.ScreenTransaction(ctx, &kycpb.ScreenTransactionRequest{Coin: "BTC", TxHash: "x"})
+
+	assert.Equal(codes.Internal, status.Code(err))
+}
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"modulus/kyc/common"
+	"modulus/kyc/main/config"
+)
+
+// probeTimeout limits the time of waiting for the result of a provider credentials probe.
+var probeTimeout = 10 * time.Second
+
+// ProvidersHealth handles requests for probing the credentials of all configured KYC providers.
+// It responds with 503 status if any of the probes has failed.
+func ProvidersHealth(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeErrorResponse(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+	if err := authorizeAdmin(r); err != nil {
+		writeErrorResponse(w, err.status, err)
+		return
+	}
+
+	response := common.ProvidersHealthResponse{
+		ConfigVersion: config.Current().Version,
+		Providers:     CheckProvidersHealth(),
+	}
+
+	status := http.StatusOK
+	for _, health := range response.Providers {
+		if health.Status != common.Healthy {
+			status = http.StatusServiceUnavailable
+			break
+		}
+	}
+
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(response)
+}
+
+// CheckProvidersHealth probes the credentials of every configured provider instance supporting that concurrently.
+// The results are ordered by the provider and the instance names.
+func CheckProvidersHealth() (report []common.ProviderHealth) {
+	cfg := config.Current().Config
+
+	results := make(chan common.ProviderHealth)
+	wg := sync.WaitGroup{}
+	for section := range cfg {
+		provider, instance := config.SplitSectionName(section)
+		if !common.KYCProviders[provider] {
+			continue
+		}
+
+		service, err := createCustomerChecker(provider, instance)
+		if err != nil {
+			// Skip the providers which aren't KYC platforms like CipherTrace.
+			if err.status == http.StatusUnprocessableEntity {
+				continue
+			}
+			report = append(report, common.ProviderHealth{
+				Provider: provider,
+				Instance: instance,
+				Status:   common.Unhealthy,
+				Error:    err.Error(),
+			})
+			continue
+		}
+		prober, ok := service.(common.CredentialsProber)
+		if !ok {
+			continue
+		}
+
+		wg.Add(1)
+		go func(provider common.KYCProvider, instance string, prober common.CredentialsProber) {
+			defer wg.Done()
+			results <- probe(provider, instance, prober)
+		}(provider, instance, prober)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for health := range results {
+		report = append(report, health)
+	}
+
+	sort.Slice(report, func(i, j int) bool {
+		if report[i].Provider != report[j].Provider {
+			return report[i].Provider < report[j].Provider
+		}
+		return report[i].Instance < report[j].Instance
+	})
+
+	return
+}
+
+// probe runs the credentials probe of the provider instance and classifies its result.
+func probe(provider common.KYCProvider, instance string, prober common.CredentialsProber) (health common.ProviderHealth) {
+	health = common.ProviderHealth{
+		Provider: provider,
+		Instance: instance,
+	}
+
+	type result struct {
+		code *int
+		err  error
+	}
+
+	done := make(chan result, 1)
+	start := time.Now()
+	go func() {
+		code, err := prober.ProbeCredentials()
+		done <- result{code: code, err: err}
+	}()
+
+	var res result
+	select {
+	case res = <-done:
+	case <-time.After(probeTimeout):
+		res.err = errors.New("probe timed out")
+	}
+	health.LatencyMs = int64(time.Since(start) / time.Millisecond)
+
+	switch {
+	case res.err == nil:
+		health.Status = common.Healthy
+		return
+	case res.code == nil:
+		health.Status = common.Unreachable
+	case *res.code == http.StatusUnauthorized || *res.code == http.StatusForbidden:
+		health.Status = common.AuthFailed
+	default:
+		health.Status = common.Unhealthy
+	}
+	if res.code != nil {
+		health.ErrorCode = strconv.Itoa(*res.code)
+	}
+	health.Error = res.err.Error()
+
+	return
+}
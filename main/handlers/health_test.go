@@ -0,0 +1,124 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"modulus/kyc/common"
+	"modulus/kyc/main/config"
+	"modulus/kyc/main/handlers"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+)
+
+var healthConfig = config.Config{
+	"Config": {
+		"AdminToken": "secret",
+	},
+	string(common.Coinfirm): {
+		"Host":     "https://api.coinfirm.io/v2",
+		"Email":    "fake@mail.com",
+		"Password": "fakepassword",
+		"Company":  "fakecompany",
+	},
+	string(common.Coinfirm) + ":backup": {
+		"Host":     "https://backup.coinfirm.io/v2",
+		"Email":    "fake@mail.com",
+		"Password": "wrongpassword",
+		"Company":  "fakecompany",
+	},
+	string(common.Coinfirm) + ":offline": {
+		"Host":     "https://offline.coinfirm.io/v2",
+		"Email":    "fake@mail.com",
+		"Password": "fakepassword",
+		"Company":  "fakecompany",
+	},
+}
+
+func TestProvidersHealth(t *testing.T) {
+	assert := assert.New(t)
+
+	previous := config.Current().Config
+	defer config.Set(previous)
+
+	config.Set(healthConfig)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(http.MethodPost, "https://api.coinfirm.io/v2/auth/login", httpmock.NewStringResponder(http.StatusOK, `{"token":"fakeToken"}`))
+	httpmock.RegisterResponder(http.MethodPost, "https://backup.coinfirm.io/v2/auth/login", httpmock.NewStringResponder(http.StatusUnauthorized, `{"error":"Invalid email or password"}`))
+
+	health := func(method, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/admin/providers/health", nil)
+		if len(token) > 0 {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		handlers.ProvidersHealth(w, req)
+		return w
+	}
+
+	// Testing wrong method.
+	w := health(http.MethodPost, "secret")
+
+	assert.Equal(http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(http.MethodGet, w.Header().Get("Allow"))
+
+	// Testing wrong token.
+	w = health(http.MethodGet, "wrong")
+
+	assert.Equal(http.StatusUnauthorized, w.Code)
+	assert.Equal(`{"Error":"invalid admin token"}`, w.Body.String())
+
+	// Testing failed probes.
+	w = health(http.MethodGet, "secret")
+
+	assert.Equal(http.StatusServiceUnavailable, w.Code)
+	assert.Equal("application/json; charset=utf-8", w.Header().Get("Content-Type"))
+
+	resp := common.ProvidersHealthResponse{}
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+
+	assert.NoError(err)
+	assert.Equal(config.Current().Version, resp.ConfigVersion)
+	assert.Len(resp.Providers, 3)
+
+	assert.Equal(common.Coinfirm, resp.Providers[0].Provider)
+	assert.Empty(resp.Providers[0].Instance)
+	assert.Equal(common.Healthy, resp.Providers[0].Status)
+	assert.Empty(resp.Providers[0].ErrorCode)
+	assert.Empty(resp.Providers[0].Error)
+
+	assert.Equal(common.Coinfirm, resp.Providers[1].Provider)
+	assert.Equal("backup", resp.Providers[1].Instance)
+	assert.Equal(common.AuthFailed, resp.Providers[1].Status)
+	assert.Equal("401", resp.Providers[1].ErrorCode)
+	assert.Equal("Invalid email or password", resp.Providers[1].Error)
+
+	assert.Equal(common.Coinfirm, resp.Providers[2].Provider)
+	assert.Equal("offline", resp.Providers[2].Instance)
+	assert.Equal(common.Unreachable, resp.Providers[2].Status)
+	assert.Empty(resp.Providers[2].ErrorCode)
+	assert.NotEmpty(resp.Providers[2].Error)
+
+	// Testing successful probes.
+	cfg := healthConfig.Clone()
+	delete(cfg, string(common.Coinfirm)+":backup")
+	delete(cfg, string(common.Coinfirm)+":offline")
+	config.Set(cfg)
+
+	w = health(http.MethodGet, "secret")
+
+	assert.Equal(http.StatusOK, w.Code)
+
+	resp = common.ProvidersHealthResponse{}
+	err = json.Unmarshal(w.Body.Bytes(), &resp)
+
+	assert.NoError(err)
+	assert.Len(resp.Providers, 1)
+	assert.Equal(common.Healthy, resp.Providers[0].Status)
+}
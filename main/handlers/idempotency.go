@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"modulus/kyc/idempotency"
+)
+
+// Headers of the idempotent requests.
+const (
+	// IdempotencyKeyHeader is the request header making the POST request idempotent.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks the responses replayed for the repeated requests.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// maxIdempotencyKeyLength limits the length of the idempotency keys.
+const maxIdempotencyKeyLength = 255
+
+// idempotencyKeys holds the responses of the idempotent requests.
+var idempotencyKeys = idempotency.NewStore(idempotency.DefaultWindow)
+
+// SetIdempotencyWindow replaces the store of the idempotent responses by the one keeping them for the window specified.
+// The zero window takes the default of the idempotency package. It must be called before the service starts serving requests.
+func SetIdempotencyWindow(window time.Duration) {
+	if window <= 0 {
+		window = idempotency.DefaultWindow
+	}
+	idempotencyKeys = idempotency.NewStore(window)
+}
+
+// Idempotent makes the POST requests with the Idempotency-Key header idempotent.
+// The response to the first request is stored and replayed for the repeats with the same key and body within the window,
+// the concurrent duplicates wait for the in-flight request. The keys are scoped by the request path.
+// The responses with 5xx statuses and the ones marked retryable by markRetryable aren't stored,
+// so the repeats are handled again. The body is limited by the maximum request size, the large one is spooled to disk.
+func Idempotent(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if len(key) == 0 || r.Method != http.MethodPost {
+			h(w, r)
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			writeIdempotencyError(w, r, http.StatusBadRequest, fmt.Sprintf("%s is longer than %d characters", IdempotencyKeyHeader, maxIdempotencyKeyLength))
+			return
+		}
+
+		body, fingerprint, err1 := bufferBody(r.Body)
+		if err1 != nil {
+			writeIdempotencyError(w, r, err1.status, err1.message)
+			return
+		}
+		defer body.Close()
+		r.Body = body
+
+		response, replayed, err := idempotencyKeys.Do(r.Context(), r.URL.Path+" "+key, fingerprint,
+			func() (idempotency.Response, bool) {
+				recorder := &responseRecorder{header: http.Header{}}
+				h(recorder, r)
+				return recorder.response(), recorder.status < http.StatusInternalServerError && !recorder.retryable
+			})
+		switch {
+		case err == idempotency.ErrMismatch:
+			writeIdempotencyError(w, r, http.StatusUnprocessableEntity, fmt.Sprintf("%s is already used for a different request", IdempotencyKeyHeader))
+			return
+		case err != nil:
+			log.Println("Idempotent request Error: ", err)
+			writeIdempotencyError(w, r, http.StatusServiceUnavailable, err.Error())
+			return
+		}
+
+		for name, values := range response.Header {
+			w.Header()[name] = values
+		}
+		if replayed {
+			w.Header().Set(IdempotentReplayedHeader, "true")
+		}
+		w.WriteHeader(response.Status)
+		w.Write(response.Body)
+	}
+}
+
+// markRetryable tells the Idempotent wrapper the response reports the transient failure, e.g. the provider
+// throttling or being unavailable, so it isn't stored and the repeat of the request is handled again.
+func markRetryable(w http.ResponseWriter) {
+	if recorder, ok := w.(*responseRecorder); ok {
+		recorder.retryable = true
+	}
+}
+
+// spoolThreshold is the size of the request bodies kept in memory while their hashes are computed,
+// the larger ones are spooled to disk and read from there by the handlers.
+const spoolThreshold = 1 << 20
+
+// bufferBody reads the request body limited by the maximum request size and returns it to be read again
+// along with its hash. The body larger than the spool threshold is kept in the temporary file removed on closing.
+func bufferBody(r io.Reader) (body io.ReadCloser, fingerprint string, err *serviceError) {
+	hash := sha256.New()
+	content := io.TeeReader(&limitedReader{r: r, n: requestLimits.maxRequestSize}, hash)
+
+	buf := bytes.Buffer{}
+	n, err1 := io.CopyN(&buf, content, spoolThreshold+1)
+	if err1 != nil && err1 != io.EOF {
+		return nil, "", decodingError(err1)
+	}
+	if n <= spoolThreshold {
+		return ioutil.NopCloser(&buf), hex.EncodeToString(hash.Sum(nil)), nil
+	}
+
+	spool, err1 := ioutil.TempFile("", "kyc-request-")
+	if err1 != nil {
+		return nil, "", &serviceError{status: http.StatusInternalServerError, message: err1.Error()}
+	}
+	spooled := &spooledBody{File: spool}
+	if _, err1 = io.Copy(spool, io.MultiReader(&buf, content)); err1 == nil {
+		_, err1 = spool.Seek(0, io.SeekStart)
+	}
+	if err1 != nil {
+		spooled.Close()
+		return nil, "", decodingError(err1)
+	}
+
+	return spooled, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// spooledBody is the request body spooled to the temporary file, the file is removed on closing.
+type spooledBody struct {
+	*os.File
+}
+
+// Close implements io.Closer interface for the spooledBody.
+func (b *spooledBody) Close() error {
+	b.File.Close()
+	return os.Remove(b.Name())
+}
+
+// writeIdempotencyError writes the error in the format of the API of the request.
+func writeIdempotencyError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	if strings.HasPrefix(r.URL.Path, V2Prefix) {
+		writeProblem(w, r, status, statusProblemCode(status), message)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	writeErrorResponse(w, status, errors.New(message))
+}
+
+// responseRecorder keeps the response written by the handler.
+type responseRecorder struct {
+	header    http.Header
+	status    int
+	body      bytes.Buffer
+	retryable bool
+}
+
+// Header implements http.ResponseWriter interface for the responseRecorder.
+func (rec *responseRecorder) Header() http.Header {
+	return rec.header
+}
+
+// WriteHeader implements http.ResponseWriter interface for the responseRecorder.
+func (rec *responseRecorder) WriteHeader(status int) {
+	if rec.status == 0 {
+		rec.status = status
+	}
+}
+
+// Write implements http.ResponseWriter interface for the responseRecorder.
+func (rec *responseRecorder) Write(b []byte) (int, error) {
+	rec.WriteHeader(http.StatusOK)
+	return rec.body.Write(b)
+}
+
+// response returns the recorded response.
+func (rec *responseRecorder) response() idempotency.Response {
+	rec.WriteHeader(http.StatusOK)
+
+	return idempotency.Response{
+		Status: rec.status,
+		Header: rec.header,
+		Body:   rec.body.Bytes(),
+	}
+}
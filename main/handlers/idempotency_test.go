@@ -0,0 +1,139 @@
+package handlers_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"modulus/kyc/main/handlers"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotent(t *testing.T) {
+	assert := assert.New(t)
+
+	mu := sync.Mutex{}
+	calls := 0
+	handler := handlers.Idempotent(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+
+		status := http.StatusOK
+		if strings.Contains(r.URL.Path, "failing") {
+			status = http.StatusInternalServerError
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(status)
+		w.Write([]byte("done"))
+	})
+	post := func(path, key, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		if len(key) > 0 {
+			r.Header.Set(handlers.IdempotencyKeyHeader, key)
+		}
+		handler(w, r)
+		return w
+	}
+
+	w := post("/CheckCustomer", "", "{}")
+	assert.Equal(http.StatusOK, w.Code)
+	w = post("/CheckCustomer", "", "{}")
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Empty(w.Header().Get(handlers.IdempotentReplayedHeader))
+	assert.Equal(2, calls)
+
+	w = post("/CheckCustomer", "idempotent-test", "{}")
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("done", w.Body.String())
+	assert.Empty(w.Header().Get(handlers.IdempotentReplayedHeader))
+
+	w = post("/CheckCustomer", "idempotent-test", "{}")
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("done", w.Body.String())
+	assert.Equal("text/plain", w.Header().Get("Content-Type"))
+	assert.Equal("true", w.Header().Get(handlers.IdempotentReplayedHeader))
+	assert.Equal(3, calls)
+
+	// The keys are scoped by the path.
+	post(handlers.JobsPath, "idempotent-test", "{}")
+	assert.Equal(4, calls)
+
+	w = post("/CheckCustomer", "idempotent-test", `{"Provider":"Example"}`)
+	assert.Equal(http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(`{"Error":"Idempotency-Key is already used for a different request"}`, w.Body.String())
+
+	w = post("/v2/verifications", "idempotent-test", "{}")
+	w = post("/v2/verifications", "idempotent-test", `{"Provider":"Example"}`)
+	assert.Equal(http.StatusUnprocessableEntity, w.Code)
+	assert.Equal("application/problem+json; charset=utf-8", w.Header().Get("Content-Type"))
+
+	w = post("/CheckCustomer", strings.Repeat("k", 256), "{}")
+	assert.Equal(http.StatusBadRequest, w.Code)
+
+	// The failed responses aren't replayed.
+	post("/failing", "idempotent-test", "{}")
+	w = post("/failing", "idempotent-test", "{}")
+	assert.Equal(http.StatusInternalServerError, w.Code)
+	assert.Empty(w.Header().Get(handlers.IdempotentReplayedHeader))
+	assert.Equal(7, calls)
+}
+
+func TestIdempotentRetryable(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := handlers.Idempotent(handlers.CheckCustomer)
+	post := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/CheckCustomer", strings.NewReader(body))
+		r.Header.Set(handlers.IdempotencyKeyHeader, "idempotent-retryable")
+		handler(w, r)
+		return w
+	}
+
+	// The provider throttling is reported with 200 status, still the repeat reaches the provider again.
+	body := `{"Provider":"Example","UserData":{"FirstName":"Erika"}}`
+	post(body)
+	w := post(body)
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Contains(w.Body.String(), `"Retryable":true`)
+	assert.Empty(w.Header().Get(handlers.IdempotentReplayedHeader))
+}
+
+func TestIdempotentBody(t *testing.T) {
+	assert := assert.New(t)
+
+	defer handlers.SetRequestLimits(0, 0)
+	handlers.SetRequestLimits(3<<20, 100)
+
+	var received []byte
+	handler := handlers.Idempotent(func(w http.ResponseWriter, r *http.Request) {
+		received, _ = ioutil.ReadAll(r.Body)
+	})
+	post := func(key string, body []byte) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/CheckCustomer", bytes.NewReader(body))
+		r.Header.Set(handlers.IdempotencyKeyHeader, key)
+		handler(w, r)
+		return w
+	}
+
+	// The large body is spooled and passed to the handler as is.
+	body := bytes.Repeat([]byte("0123456789"), 200<<10)
+	w := post("idempotent-large", body)
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal(body, received)
+
+	w = post("idempotent-too-large", append(body, body...))
+
+	assert.Equal(http.StatusRequestEntityTooLarge, w.Code)
+	assert.Equal(`{"Error":"request body is too large"}`, w.Body.String())
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"modulus/kyc/common"
+	"modulus/kyc/jobs"
+	"modulus/kyc/main/config"
+)
+
+// JobsPath is the path of the asynchronous jobs API.
+const JobsPath = "/jobs"
+
+// jobQueue runs the asynchronous CheckCustomer jobs.
+var jobQueue = jobs.NewQueue(jobs.Config{})
+
+// StartJobs replaces the job queue by the one configured by the server options and registers its readiness check.
+// It must be called before the service starts serving requests.
+func StartJobs(options config.Server) {
+	previous := jobQueue
+	jobQueue = jobs.NewQueue(jobs.Config{
+		Workers:       options.JobWorkers,
+		QueueSize:     options.JobQueueSize,
+		TTL:           options.JobTTL,
+		CallbackKey:   []byte(options.JobCallbackKey),
+		CallbackHosts: options.JobCallbackHosts,
+	})
+	previous.Stop(context.Background())
+	RegisterReadinessCheck("job queue", jobQueue.Check)
+}
+
+// StopJobs stops accepting the jobs and waits for the accepted ones to finish until the context is done.
+func StopJobs(ctx context.Context) error {
+	return jobQueue.Stop(ctx)
+}
+
+// SubmitJob handles requests for the asynchronous KYC verifications.
+// It responds with 202 status and the queued job at once, the job status is polled by GetJob.
+// The finished job is posted to the callback URL of the request if it's specified.
+func SubmitJob(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeErrorResponse(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	req := common.JobRequest{}
+
+	if err := decodeRequest(r, &req, func() *common.UserData { return req.UserData }, decodeOptions{empty: "empty request"}); err != nil {
+		writeErrorResponse(w, err.status, err)
+		return
+	}
+	if len(req.Provider) == 0 {
+		writeErrorResponse(w, http.StatusBadRequest, errors.New("missing KYC provider id in the request"))
+		return
+	}
+	if len(req.CallbackURL) > 0 {
+		if err := jobQueue.CheckCallbackURL(req.CallbackURL); err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	service, err1 := createCustomerChecker(req.Provider, req.Instance)
+	if err1 != nil {
+		log.Println("SubmitJob Error: ", err1)
+		writeErrorResponse(w, err1.status, err1)
+		return
+	}
+
+	control := requestCacheControl(r)
+	job, err := jobQueue.Submit(common.Job{
+		Provider:    req.Provider,
+		Instance:    req.Instance,
+		CallbackURL: req.CallbackURL,
+	}, func() common.KYCResponse {
+		return checkCustomer(service, req.CheckCustomerRequest, control)
+	})
+	if err != nil {
+		w.Header().Set("Retry-After", "1")
+		writeErrorResponse(w, http.StatusServiceUnavailable, err)
+		return
+	}
+	log.Printf("SubmitJob: job %s queued for %s\n", job.ID, config.SectionName(job.Provider, job.Instance))
+
+	w.Header().Set("Location", JobsPath+"/"+job.ID)
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(job)
+}
+
+// GetJob handles requests for the status of the asynchronous job.
+// The job id is taken from the path "/jobs/{id}".
+func GetJob(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeErrorResponse(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	id := strings.TrimPrefix(r.URL.Path, JobsPath+"/")
+	if len(id) == 0 || strings.Contains(id, "/") {
+		writeErrorResponse(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	job, err := jobQueue.Get(id)
+	if err != nil {
+		writeErrorResponse(w, http.StatusNotFound, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(job)
+}
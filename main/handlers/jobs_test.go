@@ -0,0 +1,109 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"modulus/kyc/common"
+	"modulus/kyc/main/handlers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobs(t *testing.T) {
+	assert := assert.New(t)
+
+	submit := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handlers.SubmitJob(w, httptest.NewRequest(http.MethodPost, handlers.JobsPath, bytes.NewReader([]byte(body))))
+		return w
+	}
+	get := func(id string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handlers.GetJob(w, httptest.NewRequest(http.MethodGet, handlers.JobsPath+"/"+id, nil))
+		return w
+	}
+
+	w := submit(``)
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal(`{"Error":"empty request"}`, w.Body.String())
+
+	w = submit(`{"UserData":{}}`)
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal(`{"Error":"missing KYC provider id in the request"}`, w.Body.String())
+
+	w = submit(`{"Provider":"Example","CallbackURL":"ftp://example.com"}`)
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal(`{"Error":"invalid callback URL: absolute http or https URL expected"}`, w.Body.String())
+
+	w = submit(`{"Provider":"Example","CallbackURL":"http://169.254.169.254/latest/meta-data"}`)
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal(`{"Error":"callback address isn't public"}`, w.Body.String())
+
+	w = submit(`{"Provider":"Foo"}`)
+	assert.Equal(http.StatusNotFound, w.Code)
+	assert.Equal(`{"Error":"unknown KYC provider in the request: Foo"}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	handlers.SubmitJob(w, httptest.NewRequest(http.MethodGet, handlers.JobsPath, nil))
+	assert.Equal(http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(http.MethodPost, w.Header().Get("Allow"))
+
+	w = submit(`{"Provider":"Example","Instance":"eu","UserData":{"FirstName":"Urbi"}}`)
+	require.Equal(t, http.StatusAccepted, w.Code)
+
+	job := common.Job{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &job))
+	assert.Equal(handlers.JobsPath+"/"+job.ID, w.Header().Get("Location"))
+	assert.Equal(common.Example, job.Provider)
+	assert.Equal("eu", job.Instance)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for job.Response == nil && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+
+		w = get(job.ID)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &job))
+	}
+
+	assert.Equal(common.JobSucceeded, job.Status)
+	if assert.NotNil(job.Response) && assert.NotNil(job.Response.Result) {
+		assert.Equal("Unclear", job.Response.Result.Status)
+		if assert.NotNil(job.Response.Result.StatusCheck) {
+			assert.Equal("eu", job.Response.Result.StatusCheck.Instance)
+		}
+	}
+
+	w = submit(`{"Provider":"Example","UserData":{"FirstName":"Erika"}}`)
+	require.Equal(t, http.StatusAccepted, w.Code)
+
+	job = common.Job{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &job))
+
+	for job.Response == nil && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+
+		id := job.ID
+		job = common.Job{}
+		json.Unmarshal(get(id).Body.Bytes(), &job)
+	}
+
+	assert.Equal(common.JobFailed, job.Status)
+	if assert.NotNil(job.Response) {
+		assert.Equal(common.RateLimitedError, job.Response.ErrorCategory)
+		assert.True(job.Response.Retryable)
+	}
+
+	w = get("unknown")
+	assert.Equal(http.StatusNotFound, w.Code)
+	assert.Equal(`{"Error":"job not found"}`, w.Body.String())
+
+	w = get("")
+	assert.Equal(http.StatusNotFound, w.Code)
+}
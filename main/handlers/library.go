@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"modulus/kyc"
+	"modulus/kyc/common"
+	"modulus/kyc/main/config"
+)
+
+// problemStatuses maps the problem codes of the KYC library errors to the HTTP statuses.
+var problemStatuses = map[common.ProblemCode]int{
+	common.ProblemInvalidRequest:       http.StatusBadRequest,
+	common.ProblemUnknownProvider:      http.StatusNotFound,
+	common.ProblemUnknownInstance:      http.StatusNotFound,
+	common.ProblemUnsupportedOperation: http.StatusUnprocessableEntity,
+	common.ProblemConfigError:          http.StatusInternalServerError,
+}
+
+// library holds the KYC library service built from the active config and the errors of the config sections
+// left out of it. It's rebuilt when the config version changes.
+var library struct {
+	sync.Mutex
+	version string
+	service *kyc.Service
+	errs    map[string]error
+}
+
+// libraryService returns the KYC library service configured by the config snapshot.
+func libraryService(snapshot *config.Snapshot) (service *kyc.Service, errs map[string]error) {
+	library.Lock()
+	defer library.Unlock()
+
+	if library.service == nil || library.version != snapshot.Version {
+		cfg, sectionErrs := snapshot.Config.Library()
+		library.version = snapshot.Version
+		library.service = kyc.New(cfg)
+		library.errs = sectionErrs
+	}
+
+	return library.service, library.errs
+}
+
+// createPlatform creates the KYCPlatform of the provider instance by the KYC library service.
+// The config section of the instance is checked first to report the config problems by the section names.
+// The instance missing in the config is the fault of the request, so it's reported without the section name.
+func createPlatform(provider common.KYCProvider, instance string, create func(*kyc.Service) (common.KYCPlatform, error)) (platform common.KYCPlatform, err *serviceError) {
+	snapshot := config.Current()
+	service, errs := libraryService(snapshot)
+
+	if provider != common.Example && common.KYCProviders[provider] {
+		section := config.SectionName(provider, instance)
+		if _, ok := snapshot.Config[section]; !ok && len(instance) > 0 {
+			err = unknownInstanceError(provider, instance)
+			return
+		}
+		if _, ok := snapshot.Config[section]; !ok {
+			err = &serviceError{
+				status:  http.StatusInternalServerError,
+				code:    common.ProblemConfigError,
+				message: fmt.Sprintf("missing config for %s", section),
+			}
+			return
+		}
+		if err1, ok := errs[section]; ok {
+			err = &serviceError{
+				status:  http.StatusInternalServerError,
+				code:    common.ProblemConfigError,
+				message: err1.Error(),
+			}
+			return
+		}
+	}
+
+	platform, err1 := create(service)
+	if err1 != nil {
+		err = libraryError(err1)
+	}
+
+	return
+}
+
+// libraryError converts the error of the KYC library service into the service error.
+func libraryError(err error) *serviceError {
+	e, ok := kyc.AsError(err)
+	if !ok {
+		return &serviceError{
+			status:  http.StatusInternalServerError,
+			message: err.Error(),
+		}
+	}
+
+	status, ok := problemStatuses[e.Code]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+	message := e.Message
+	switch e.Code {
+	case common.ProblemUnknownProvider:
+		message = fmt.Sprintf("unknown KYC provider in the request: %s", e.Provider)
+	case common.ProblemUnknownInstance:
+		return unknownInstanceError(e.Provider, e.Instance)
+	}
+
+	return &serviceError{
+		status:  status,
+		code:    e.Code,
+		message: message,
+	}
+}
+
+// unknownInstanceError returns the service error of the provider instance missing in the config.
+func unknownInstanceError(provider common.KYCProvider, instance string) *serviceError {
+	return &serviceError{
+		status:  http.StatusNotFound,
+		code:    common.ProblemUnknownInstance,
+		message: fmt.Sprintf("unknown %s instance in the request: %s", provider, instance),
+	}
+}
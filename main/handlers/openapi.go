@@ -0,0 +1,577 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"modulus/kyc/batch"
+	"modulus/kyc/common"
+	"modulus/kyc/integrations/ciphertrace"
+	"modulus/kyc/jobs"
+	"modulus/kyc/main/handlers/providers"
+	"modulus/kyc/openapi"
+	"modulus/kyc/ownership"
+
+	"github.com/shopspring/decimal"
+)
+
+// APIVersion is the version of the API reported in the OpenAPI document.
+const APIVersion = "2.0.0"
+
+var (
+	apiDocOnce sync.Once
+	apiDoc     []byte
+)
+
+// OpenAPI handles requests for the OpenAPI document describing the service API.
+func OpenAPI(w http.ResponseWriter, r *http.Request) {
+	apiDocOnce.Do(func() {
+		apiDoc, _ = json.Marshal(APIDocument())
+	})
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Write(apiDoc)
+}
+
+// APIDocument generates the OpenAPI document of the service API.
+// The schemas are generated from the request and response models, so the document always matches the code.
+func APIDocument() *openapi.Document {
+	g := openapi.NewGenerator()
+	g.Format(common.Time{}, openapi.Schema{Type: "string", Format: "date-time"})
+	g.Format(decimal.Decimal{}, openapi.Schema{Type: "string", Format: "decimal"})
+
+	errorResponse := func(description string) *openapi.Response {
+		return &openapi.Response{
+			Description: description,
+			Content:     openapi.JSON(g.Schema(common.ErrorResponse{})),
+		}
+	}
+	problemResponse := func(description string) *openapi.Response {
+		return &openapi.Response{
+			Description: description,
+			Content:     openapi.Content("application/problem+json", g.Schema(common.Problem{})),
+		}
+	}
+	jsonResponse := func(description string, value interface{}) *openapi.Response {
+		return &openapi.Response{
+			Description: description,
+			Content:     openapi.JSON(g.Schema(value)),
+		}
+	}
+	textResponse := func(description string) *openapi.Response {
+		return &openapi.Response{
+			Description: description,
+			Content:     openapi.Content("text/plain", &openapi.Schema{Type: "string"}),
+		}
+	}
+	jsonBody := func(value interface{}) *openapi.RequestBody {
+		return &openapi.RequestBody{
+			Required: true,
+			Content:  openapi.JSON(g.Schema(value)),
+		}
+	}
+	// verificationBody describes the verification request sent either as JSON or as multipart/form-data
+	// with the files of the customer documents in the binary parts.
+	verificationBody := func(value interface{}) *openapi.RequestBody {
+		body := jsonBody(value)
+		body.Description = "The JSON request or the multipart/form-data one with the JSON request in the \"" + RequestPart + "\" part. " +
+			"The other parts are the files of the documents referenced by their filename."
+		body.Content["multipart/form-data"] = &openapi.MediaType{Schema: &openapi.Schema{
+			Type:                 "object",
+			Properties:           map[string]*openapi.Schema{RequestPart: g.Schema(value)},
+			AdditionalProperties: &openapi.Schema{Type: "string", Format: "binary"},
+		}}
+		return body
+	}
+	pathParam := func(name, description string) openapi.Parameter {
+		return openapi.Parameter{
+			Name:        name,
+			In:          "path",
+			Description: description,
+			Required:    true,
+			Schema:      &openapi.Schema{Type: "string"},
+		}
+	}
+	idempotencyKey := openapi.Parameter{
+		Name:        IdempotencyKeyHeader,
+		In:          "header",
+		Description: "The key making the request idempotent: the response is replayed for the repeats with the same key and body",
+		Schema:      &openapi.Schema{Type: "string"},
+	}
+	cacheControl := openapi.Parameter{
+		Name:        "Cache-Control",
+		In:          "header",
+		Description: "The no-cache directive bypasses the cached verification results, no-store doesn't cache the fresh ones either",
+		Schema:      &openapi.Schema{Type: "string"},
+	}
+	adminOnly := []map[string][]string{{"adminToken": {}}}
+	vaultToken := []map[string][]string{{"vaultToken": {}}, {"adminToken": {}}}
+
+	doc := &openapi.Document{
+		OpenAPI: openapi.Version,
+		Info: openapi.Info{
+			Title:       "KYC service API",
+			Description: "Customer verification through the KYC providers.",
+			Version:     APIVersion,
+		},
+	}
+
+	// Probes.
+	doc.AddOperation(http.MethodGet, "/Ping", &openapi.Operation{
+		OperationID: "ping",
+		Summary:     "Checks the service responds",
+		Tags:        []string{"probes"},
+		Responses:   map[string]*openapi.Response{"200": textResponse("Pong!")},
+	})
+	doc.AddOperation(http.MethodGet, "/healthz", &openapi.Operation{
+		OperationID: "healthz",
+		Summary:     "Liveness probe",
+		Tags:        []string{"probes"},
+		Responses:   map[string]*openapi.Response{"200": textResponse("The service is alive")},
+	})
+	doc.AddOperation(http.MethodGet, "/readyz", &openapi.Operation{
+		OperationID: "readyz",
+		Summary:     "Readiness probe",
+		Tags:        []string{"probes"},
+		Responses: map[string]*openapi.Response{
+			"200": textResponse("The service is ready to handle requests"),
+			"503": textResponse("The reason the service isn't ready"),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/openapi.json", &openapi.Operation{
+		OperationID: "openapi",
+		Summary:     "Returns this document",
+		Tags:        []string{"meta"},
+		Responses: map[string]*openapi.Response{
+			"200": {
+				Description: "The OpenAPI document",
+				Content:     openapi.JSON(&openapi.Schema{Type: "object"}),
+			},
+		},
+	})
+
+	// The v1 API.
+	doc.AddOperation(http.MethodPost, "/CheckCustomer", &openapi.Operation{
+		OperationID: "checkCustomer",
+		Summary:     "Verifies the customer by the KYC provider",
+		Tags:        []string{"v1"},
+		Parameters:  []openapi.Parameter{idempotencyKey, cacheControl},
+		RequestBody: verificationBody(common.CheckCustomerRequest{}),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The verification result or the error returned by the provider", common.KYCResponse{}),
+			"400": errorResponse("Malformed request"),
+			"404": errorResponse("Unknown KYC provider or provider instance"),
+			"413": errorResponse("The request or one of its files is too large"),
+			"422": errorResponse("The provider doesn't support the verification or the idempotency key is used for another request"),
+			"500": errorResponse("Invalid provider config"),
+		},
+	})
+	doc.AddOperation(http.MethodPost, "/CheckStatus", &openapi.Operation{
+		OperationID: "checkStatus",
+		Summary:     "Checks the status of the verification",
+		Tags:        []string{"v1"},
+		RequestBody: jsonBody(common.CheckStatusRequest{}),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The verification result or the error returned by the provider", common.KYCResponse{}),
+			"400": errorResponse("Malformed request"),
+			"404": errorResponse("Unknown KYC provider or provider instance"),
+			"422": errorResponse("The provider doesn't support status polling"),
+			"500": errorResponse("Invalid provider config"),
+		},
+	})
+	doc.AddOperation(http.MethodPost, JobsPath, &openapi.Operation{
+		OperationID: "submitJob",
+		Summary:     "Starts the asynchronous verification of the customer",
+		Description: "The job runs in the background, its status is polled by the job id. " +
+			"The finished job is posted to the callback URL if it's specified, the URL must point to the public address " +
+			"of the host allowed by the service config. If the service has the job callback key " +
+			"the callback is signed in the " + jobs.SignatureHeader + " header as t=<unix time>,v1=<hex HMAC-SHA256 " +
+			"of the time, a dot and the body>.",
+		Tags:        []string{"jobs"},
+		Parameters:  []openapi.Parameter{idempotencyKey, cacheControl},
+		RequestBody: verificationBody(common.JobRequest{}),
+		Responses: map[string]*openapi.Response{
+			"202": {
+				Description: "The queued job",
+				Headers: map[string]*openapi.Header{
+					"Location": {Description: "The URL of the job", Schema: &openapi.Schema{Type: "string"}},
+				},
+				Content: openapi.JSON(g.Schema(common.Job{})),
+			},
+			"400": errorResponse("Malformed request"),
+			"404": errorResponse("Unknown KYC provider or provider instance"),
+			"413": errorResponse("The request or one of its files is too large"),
+			"422": errorResponse("The provider doesn't support the verification or the idempotency key is used for another request"),
+			"500": errorResponse("Invalid provider config"),
+			"503": errorResponse("The job queue is full"),
+		},
+	})
+	doc.AddOperation(http.MethodGet, JobsPath+"/{id}", &openapi.Operation{
+		OperationID: "getJob",
+		Summary:     "Retrieves the asynchronous job",
+		Tags:        []string{"jobs"},
+		Parameters:  []openapi.Parameter{pathParam("id", "The job id")},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The job with the verification response once it has finished", common.Job{}),
+			"404": errorResponse("Unknown or expired job"),
+		},
+	})
+	queryParam := func(name, description string, schema *openapi.Schema) openapi.Parameter {
+		return openapi.Parameter{Name: name, In: "query", Description: description, Schema: schema}
+	}
+	doc.AddOperation(http.MethodPost, BatchesPath, &openapi.Operation{
+		OperationID: "createBatch",
+		Summary:     "Starts the bulk verification of the customers",
+		Description: "The CSV header names the UserData fields, the nested fields are named by the dotted paths, " +
+			"e.g. CurrentAddress.Town. Every NDJSON line is the UserData.",
+		Tags: []string{"batches"},
+		Parameters: []openapi.Parameter{
+			{Name: "provider", In: "query", Description: "The KYC provider", Required: true, Schema: &openapi.Schema{Type: "string"}},
+			queryParam("instance", "The named config instance of the provider", &openapi.Schema{Type: "string"}),
+			queryParam("concurrency", "The number of the customers verified at once", &openapi.Schema{Type: "integer"}),
+			queryParam("rate", "The maximum number of the verifications started per second", &openapi.Schema{Type: "number"}),
+			cacheControl,
+		},
+		RequestBody: &openapi.RequestBody{
+			Required: true,
+			Content: map[string]*openapi.MediaType{
+				batch.CSV.ContentType():    {Schema: &openapi.Schema{Type: "string"}},
+				batch.NDJSON.ContentType(): {Schema: g.Schema(common.UserData{})},
+			},
+		},
+		Responses: map[string]*openapi.Response{
+			"202": {
+				Description: "The running batch",
+				Headers: map[string]*openapi.Header{
+					"Location": {Description: "The URL of the batch", Schema: &openapi.Schema{Type: "string"}},
+				},
+				Content: openapi.JSON(g.Schema(common.Batch{})),
+			},
+			"400": errorResponse("Malformed request or batch"),
+			"404": errorResponse("Unknown KYC provider or provider instance"),
+			"415": errorResponse("Unsupported batch format"),
+			"422": errorResponse("The provider doesn't support the verification"),
+			"500": errorResponse("Invalid provider config"),
+			"503": errorResponse("Too many batches running"),
+		},
+	})
+	doc.AddOperation(http.MethodGet, BatchesPath+"/{id}", &openapi.Operation{
+		OperationID: "getBatch",
+		Summary:     "Retrieves the state of the batch",
+		Tags:        []string{"batches"},
+		Parameters:  []openapi.Parameter{pathParam("id", "The batch id")},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The batch", common.Batch{}),
+			"404": errorResponse("Unknown or expired batch"),
+		},
+	})
+	doc.AddOperation(http.MethodGet, BatchesPath+"/{id}/results", &openapi.Operation{
+		OperationID: "getBatchResults",
+		Summary:     "Downloads the results of the batch",
+		Tags:        []string{"batches"},
+		Parameters: []openapi.Parameter{
+			pathParam("id", "The batch id"),
+			queryParam("format", "The format of the results, NDJSON by default", &openapi.Schema{Type: "string", Enum: []string{string(batch.CSV), string(batch.NDJSON)}}),
+		},
+		Responses: map[string]*openapi.Response{
+			"200": {
+				Description: "The rows of the batch",
+				Content: map[string]*openapi.MediaType{
+					batch.CSV.ContentType():    {Schema: &openapi.Schema{Type: "string"}},
+					batch.NDJSON.ContentType(): {Schema: g.Schema(common.BatchRow{})},
+				},
+			},
+			"404": errorResponse("Unknown or expired batch"),
+		},
+	})
+	doc.AddOperation(http.MethodPost, DocumentsPath, &openapi.Operation{
+		OperationID: "uploadDocument",
+		Summary:     "Stores the document file in the document vault",
+		Description: "The document is referenced by its id in the DocumentID of the document files of the verification requests. " +
+			"The token granting the " + ScopeDocumentsWrite + " scope is required.",
+		Tags: []string{"documents"},
+		Parameters: []openapi.Parameter{
+			queryParam("filename", "The name of the file", &openapi.Schema{Type: "string"}),
+			queryParam("retention", "The time the document is kept for, e.g. 720h", &openapi.Schema{Type: "string"}),
+		},
+		RequestBody: &openapi.RequestBody{
+			Required: true,
+			Content:  openapi.Content("application/octet-stream", &openapi.Schema{Type: "string", Format: "binary"}),
+		},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The document stored before with the same content", common.StoredDocument{}),
+			"201": {
+				Description: "The new document",
+				Headers: map[string]*openapi.Header{
+					"Location": {Description: "The URL of the document", Schema: &openapi.Schema{Type: "string"}},
+				},
+				Content: openapi.JSON(g.Schema(common.StoredDocument{})),
+			},
+			"400": errorResponse("Empty document or invalid retention"),
+			"401": errorResponse("Missing or invalid token"),
+			"403": errorResponse("The document vault is disabled"),
+			"413": errorResponse("The document is too large"),
+		},
+		Security: vaultToken,
+	})
+	doc.AddOperation(http.MethodGet, DocumentsPath+"/{id}", &openapi.Operation{
+		OperationID: "getDocument",
+		Summary:     "Downloads the document file from the document vault",
+		Description: "The token granting the " + ScopeDocumentsRead + " scope is required.",
+		Tags:        []string{"documents"},
+		Parameters:  []openapi.Parameter{pathParam("id", "The document id")},
+		Responses: map[string]*openapi.Response{
+			"200": {
+				Description: "The content of the document of its type",
+				Content:     openapi.Content("application/octet-stream", &openapi.Schema{Type: "string", Format: "binary"}),
+			},
+			"401": errorResponse("Missing or invalid token"),
+			"403": errorResponse("The document vault is disabled"),
+			"404": errorResponse("Unknown or expired document"),
+		},
+		Security: vaultToken,
+	})
+	doc.AddOperation(http.MethodPost, CustomersPath, &openapi.Operation{
+		OperationID: "createCustomer",
+		Summary:     "Creates the customer profile",
+		Description: "The document files are moved to the document vault, so the profile references them by the ids. " +
+			"The files need the token granting the " + ScopeDocumentsWrite + " scope.",
+		Tags:        []string{"customers"},
+		Parameters:  []openapi.Parameter{idempotencyKey},
+		RequestBody: verificationBody(common.UserData{}),
+		Responses: map[string]*openapi.Response{
+			"201": {
+				Description: "The new customer",
+				Headers: map[string]*openapi.Header{
+					"Location": {Description: "The URL of the customer", Schema: &openapi.Schema{Type: "string"}},
+				},
+				Content: openapi.JSON(g.Schema(common.Customer{})),
+			},
+			"400": errorResponse("Malformed request"),
+			"401": errorResponse("The files are sent without the token granting the " + ScopeDocumentsWrite + " scope"),
+			"413": errorResponse("The request or one of its files is too large"),
+			"422": errorResponse("The files are sent while the document vault is disabled, unknown stored document " +
+				"or the idempotency key is used for another request"),
+		},
+	})
+	doc.AddOperation(http.MethodGet, CustomersPath+"/{id}", &openapi.Operation{
+		OperationID: "getCustomer",
+		Summary:     "Retrieves the customer with the verification history and the overall KYC status",
+		Tags:        []string{"customers"},
+		Parameters: []openapi.Parameter{
+			pathParam("id", "The customer id"),
+			queryParam("refresh", "Checks the status of the latest unclear verifications if true", &openapi.Schema{Type: "boolean"}),
+		},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The customer", common.Customer{}),
+			"404": errorResponse("Unknown customer"),
+		},
+	})
+	doc.AddOperation(http.MethodPut, CustomersPath+"/{id}", &openapi.Operation{
+		OperationID: "updateCustomer",
+		Summary:     "Replaces the customer data keeping the verifications made",
+		Tags:        []string{"customers"},
+		Parameters:  []openapi.Parameter{pathParam("id", "The customer id")},
+		RequestBody: verificationBody(common.UserData{}),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The customer updated", common.Customer{}),
+			"400": errorResponse("Malformed request"),
+			"401": errorResponse("The files are sent without the token granting the " + ScopeDocumentsWrite + " scope"),
+			"404": errorResponse("Unknown customer"),
+			"413": errorResponse("The request or one of its files is too large"),
+			"422": errorResponse("The files are sent while the document vault is disabled or unknown stored document"),
+		},
+	})
+	doc.AddOperation(http.MethodPost, CustomersPath+"/{id}/verifications", &openapi.Operation{
+		OperationID: "verifyCustomer",
+		Summary:     "Verifies the stored customer by the KYC provider",
+		Description: "The verification is added to the history of the customer, the error returned by the provider is kept by it.",
+		Tags:        []string{"customers"},
+		Parameters:  []openapi.Parameter{pathParam("id", "The customer id"), idempotencyKey, cacheControl},
+		RequestBody: jsonBody(common.CustomerVerificationRequest{}),
+		Responses: map[string]*openapi.Response{
+			"201": jsonResponse("The customer with the new verification", common.Customer{}),
+			"400": errorResponse("Malformed request"),
+			"404": errorResponse("Unknown customer or KYC provider"),
+			"422": errorResponse("The provider doesn't support the verification or the stored document is gone"),
+			"500": errorResponse("Invalid provider config"),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/Provider", &openapi.Operation{
+		OperationID: "isProviderImplemented",
+		Summary:     "Lists the implemented KYC providers or checks the one specified",
+		Description: "Without the name parameter the response is the list of the implemented providers.",
+		Tags:        []string{"v1"},
+		Parameters: []openapi.Parameter{
+			{
+				Name:        "name",
+				In:          "query",
+				Description: "The name of the KYC provider to check",
+				Schema:      &openapi.Schema{Type: "string"},
+			},
+		},
+		Responses: map[string]*openapi.Response{
+			"200": {
+				Description: "The list of the providers or whether the provider is implemented",
+				Content: openapi.JSON(&openapi.Schema{
+					OneOf: []*openapi.Schema{
+						g.Schema(providers.ProviderList{}),
+						g.Schema(common.ProviderImplementedResponse{}),
+					},
+				}),
+			},
+			"400": errorResponse("Malformed request"),
+		},
+	})
+
+	// The crypto API.
+	doc.AddOperation(http.MethodPost, "/cipherTrace", &openapi.Operation{
+		OperationID: "cipherTraceCheck",
+		Summary:     "Checks the risk of the transaction by CipherTrace",
+		Tags:        []string{"crypto"},
+		RequestBody: jsonBody(common.CipherTraceRequest{}),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The risk of the transaction addresses", ciphertrace.AddressRisk{}),
+			"400": errorResponse("Unsupported coin or missing config"),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/crypto/wallet/{id}/addresses", &openapi.Operation{
+		OperationID: "walletAddresses",
+		Summary:     "Streams the addresses of the CipherTrace wallet",
+		Description: "The addresses are streamed as newline-delimited JSON. " +
+			"The error occurred in the middle of the stream is reported by the ErrorResponse line.",
+		Tags: []string{"crypto"},
+		Parameters: []openapi.Parameter{
+			pathParam("id", "The wallet id"),
+			{Name: "pageSize", In: "query", Description: "The size of the pages fetched from CipherTrace, a multiple of 100 up to 1000", Schema: g.Schema(0)},
+			{Name: "concurrency", In: "query", Description: "The number of pages fetched concurrently, up to 16", Schema: g.Schema(0)},
+		},
+		Responses: map[string]*openapi.Response{
+			"200": {
+				Description: "The stream of the wallet addresses",
+				Content:     openapi.Content("application/x-ndjson", g.Schema(common.WalletAddress{})),
+			},
+			"400": errorResponse("Malformed request"),
+			"404": errorResponse("Unknown wallet"),
+			"500": errorResponse("Missing CipherTrace config"),
+		},
+	})
+	doc.AddOperation(http.MethodPost, "/crypto/ownership/challenge", &openapi.Operation{
+		OperationID: "issueOwnershipChallenge",
+		Summary:     "Issues the challenge for proving the wallet ownership by the customer",
+		Tags:        []string{"crypto"},
+		RequestBody: jsonBody(common.OwnershipChallengeRequest{}),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The challenge to sign", ownership.Challenge{}),
+			"400": errorResponse("Malformed request"),
+			"404": errorResponse("Unknown customer"),
+		},
+	})
+	doc.AddOperation(http.MethodPost, "/crypto/ownership/verify", &openapi.Operation{
+		OperationID: "verifyOwnershipProof",
+		Summary:     "Verifies the signed wallet ownership challenge and records the proof in the customer profile",
+		Tags:        []string{"crypto"},
+		RequestBody: jsonBody(common.OwnershipProofRequest{}),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The ownership proof and the address screenings", common.OwnershipProofResponse{}),
+			"400": errorResponse("Malformed request"),
+			"404": errorResponse("Unknown or used challenge or unknown customer"),
+			"422": errorResponse("The signature doesn't prove the ownership, the challenge might be signed again"),
+			"500": errorResponse("Failed to record the proof"),
+		},
+	})
+
+	// The v2 API.
+	doc.AddOperation(http.MethodPost, "/v2/verifications", &openapi.Operation{
+		OperationID: "createVerification",
+		Summary:     "Starts the verification of the customer",
+		Tags:        []string{"v2"},
+		Parameters:  []openapi.Parameter{idempotencyKey, cacheControl},
+		RequestBody: verificationBody(common.VerificationRequest{}),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The completed verification", common.Verification{}),
+			"201": {
+				Description: "The verification which status might be checked later",
+				Headers: map[string]*openapi.Header{
+					"Location": {Description: "The URL of the verification", Schema: &openapi.Schema{Type: "string"}},
+				},
+				Content: openapi.JSON(g.Schema(common.Verification{})),
+			},
+			"default": problemResponse("The problem details"),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/v2/verifications/{id}", &openapi.Operation{
+		OperationID: "getVerification",
+		Summary:     "Checks the status of the verification",
+		Tags:        []string{"v2"},
+		Parameters:  []openapi.Parameter{pathParam("id", "The verification id")},
+		Responses: map[string]*openapi.Response{
+			"200":     jsonResponse("The verification", common.Verification{}),
+			"default": problemResponse("The problem details"),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/v2/providers", &openapi.Operation{
+		OperationID: "listProviders",
+		Summary:     "Lists the implemented KYC providers",
+		Tags:        []string{"v2"},
+		Responses: map[string]*openapi.Response{
+			"200":     jsonResponse("The providers", common.ProviderListResponse{}),
+			"default": problemResponse("The problem details"),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/v2/providers/{name}", &openapi.Operation{
+		OperationID: "getProvider",
+		Summary:     "Retrieves the KYC provider",
+		Tags:        []string{"v2"},
+		Parameters:  []openapi.Parameter{pathParam("name", "The provider name")},
+		Responses: map[string]*openapi.Response{
+			"200":     jsonResponse("The provider", common.ProviderResource{}),
+			"default": problemResponse("The problem details"),
+		},
+	})
+
+	// The admin API.
+	doc.AddOperation(http.MethodPost, "/admin/reload", &openapi.Operation{
+		OperationID: "reloadConfig",
+		Summary:     "Reloads the config from its file",
+		Tags:        []string{"admin"},
+		Security:    adminOnly,
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The new config is active", common.ConfigResponse{}),
+			"401": errorResponse("Invalid admin token"),
+			"403": errorResponse("The admin API is disabled"),
+			"422": jsonResponse("The new config is invalid, the previous one stays active", common.ConfigResponse{}),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/admin/providers/health", &openapi.Operation{
+		OperationID: "providersHealth",
+		Summary:     "Probes the credentials of the configured KYC providers",
+		Tags:        []string{"admin"},
+		Security:    adminOnly,
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("All the providers are healthy", common.ProvidersHealthResponse{}),
+			"401": errorResponse("Invalid admin token"),
+			"403": errorResponse("The admin API is disabled"),
+			"503": jsonResponse("Some of the providers are unhealthy", common.ProvidersHealthResponse{}),
+		},
+	})
+
+	doc.Components = openapi.Components{
+		Schemas: g.Components(),
+		SecuritySchemes: map[string]*openapi.SecurityScheme{
+			"adminToken": {
+				Type:        "http",
+				Scheme:      "bearer",
+				Description: "The admin token from the service config",
+			},
+			"vaultToken": {
+				Type:        "http",
+				Scheme:      "bearer",
+				Description: "The read or write token from the document vault config",
+			},
+		},
+	}
+
+	return doc
+}
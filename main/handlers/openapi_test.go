@@ -0,0 +1,93 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"modulus/kyc/main/handlers"
+	"modulus/kyc/openapi"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAPI(t *testing.T) {
+	assert := assert.New(t)
+
+	w := httptest.NewRecorder()
+	handlers.OpenAPI(w, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("application/json; charset=utf-8", w.Header().Get("Content-Type"))
+
+	doc := openapi.Document{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+
+	assert.Equal(openapi.Version, doc.OpenAPI)
+	assert.Equal(handlers.APIVersion, doc.Info.Version)
+
+	schemas := doc.Components.Schemas
+	for _, name := range []string{"UserData", "Result", "Details", "KYCStatusCheck", "Verification", "Problem"} {
+		assert.Contains(schemas, name)
+	}
+	if assert.Contains(schemas, "UserData") {
+		assert.Equal(&openapi.Schema{Type: "string", Format: "date-time"}, schemas["UserData"].Properties["DateOfBirth"])
+		assert.Equal(&openapi.Schema{Ref: "#/components/schemas/Passport"}, schemas["UserData"].Properties["Passport"])
+	}
+	if assert.Contains(schemas, "CustomerData") {
+		assert.Equal(&openapi.Schema{Type: "string", Format: "date-time"}, schemas["CustomerData"].Properties["dateOfBirth"])
+		assert.Equal(&openapi.Schema{Ref: "#/components/schemas/CustomerPassport"}, schemas["CustomerData"].Properties["passport"])
+		assert.NotContains(schemas["CustomerData"].Properties, "Passport")
+	}
+	if assert.Contains(schemas, "KYCStatusCheck") {
+		assert.Equal(&openapi.Schema{Type: "string", Format: "date-time"}, schemas["KYCStatusCheck"].Properties["LastCheck"])
+	}
+
+	operations := map[string]bool{}
+	for path, item := range doc.Paths {
+		for method, operation := range item.Operations() {
+			assert.NotEmpty(operation.OperationID, "%s %s", method, path)
+			assert.False(operations[operation.OperationID], "duplicate operation id %s", operation.OperationID)
+			operations[operation.OperationID] = true
+		}
+	}
+
+	// Every reference must point to the existing schema.
+	var walk func(schema *openapi.Schema)
+	walk = func(schema *openapi.Schema) {
+		if schema == nil {
+			return
+		}
+		if len(schema.Ref) > 0 {
+			assert.Contains(schemas, strings.TrimPrefix(schema.Ref, "#/components/schemas/"))
+		}
+		walk(schema.Items)
+		walk(schema.AdditionalProperties)
+		for _, s := range schema.OneOf {
+			walk(s)
+		}
+		for _, s := range schema.Properties {
+			walk(s)
+		}
+	}
+	for _, schema := range schemas {
+		walk(schema)
+	}
+	for _, item := range doc.Paths {
+		for _, operation := range item.Operations() {
+			if operation.RequestBody != nil {
+				for _, content := range operation.RequestBody.Content {
+					walk(content.Schema)
+				}
+			}
+			for _, response := range operation.Responses {
+				for _, content := range response.Content {
+					walk(content.Schema)
+				}
+			}
+		}
+	}
+}
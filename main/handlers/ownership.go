@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"net/http"
+
+	"modulus/kyc/common"
+	"modulus/kyc/main/config"
+	"modulus/kyc/ownership"
+)
+
+// challenges issues wallet ownership challenges. StartCustomers replaces it by the store signing
+// the challenges by the key derived from the customers store key, so the replicas sharing the profiles
+// accept the challenges issued by each other and the challenges survive restarts.
+var challenges = ownership.NewStore(ownership.DefaultChallengeTTL, nil)
+
+// challengeKey derives the key signing the ownership challenges from the key of the persistent customers store.
+// It returns nil for the store keeping the profiles in memory only.
+func challengeKey(options config.Customers) []byte {
+	if !options.Persistent() {
+		return nil
+	}
+
+	mac := hmac.New(sha256.New, options.KeyBytes())
+	mac.Write([]byte("wallet ownership challenges"))
+	return mac.Sum(nil)
+}
+
+// IssueOwnershipChallenge handles requests for a wallet ownership challenge.
+func IssueOwnershipChallenge(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+	if len(body) == 0 {
+		writeErrorResponse(w, http.StatusBadRequest, errors.New("empty request"))
+		return
+	}
+	log.Println("IssueOwnershipChallenge Request: ", string(body))
+	req := common.OwnershipChallengeRequest{}
+
+	err = json.Unmarshal(body, &req)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if len(req.CustomerID) == 0 {
+		writeErrorResponse(w, http.StatusBadRequest, errors.New("missing customer id in the request"))
+		return
+	}
+	if _, err = profiles.Get(req.CustomerID); err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	challenge, err := challenges.Issue(req.CustomerID, req.Chain, req.Address)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	resp, err := json.Marshal(challenge)
+	if err != nil {
+		writeErrorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+	log.Println("IssueOwnershipChallenge Response: ", string(resp))
+	w.Write(resp)
+}
+
+// VerifyOwnershipProof handles requests for a wallet ownership verification.
+// The challenge is used up only if the signature is valid, the invalid one is rejected with 422.
+// The address of a verified wallet is screened by CipherTrace and Coinfirm if they are configured,
+// the proof is recorded in the profile of the challenged customer along with the screening results.
+func VerifyOwnershipProof(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+	if len(body) == 0 {
+		writeErrorResponse(w, http.StatusBadRequest, errors.New("empty request"))
+		return
+	}
+	log.Println("VerifyOwnershipProof Request: ", string(body))
+	req := common.OwnershipProofRequest{}
+
+	err = json.Unmarshal(body, &req)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if len(req.ChallengeID) == 0 {
+		writeErrorResponse(w, http.StatusBadRequest, errors.New("missing challenge id in the request"))
+		return
+	}
+
+	challenge, err := challenges.Peek(req.ChallengeID)
+	if err != nil {
+		writeErrorResponse(w, http.StatusNotFound, err)
+		return
+	}
+
+	proof, err := ownership.Verify(challenge, ownership.Scheme(req.Scheme), req.Signature)
+	if err != nil {
+		writeErrorResponse(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// The challenge is used up by the valid proof only, so the failed proof might be retried.
+	if _, err = challenges.Take(req.ChallengeID); err != nil {
+		writeErrorResponse(w, http.StatusNotFound, err)
+		return
+	}
+
+	response := common.OwnershipProofResponse{
+		Proof:      proof,
+		Screenings: screenAddress(proof.Chain, proof.Address),
+	}
+
+	_, err = profiles.AddWallet(challenge.CustomerID, common.CustomerWallet{
+		Proof:      proof,
+		Screenings: response.Screenings,
+	})
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+
+	resp, err := json.Marshal(response)
+	if err != nil {
+		writeErrorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+	log.Println("VerifyOwnershipProof Response: ", string(resp))
+	w.Write(resp)
+}
+
+// screenAddress obtains the risk info of the address from CipherTrace and Coinfirm.
+// The providers that aren't configured are skipped.
+func screenAddress(chain common.Blockchain, address string) (screenings []common.AddressScreening) {
+	library, _ := libraryService(config.Current())
+
+	if service, ok := library.CipherTrace(); ok {
+		screening := common.AddressScreening{
+			Provider: common.CipherTrace,
+		}
+
+		var err error
+		switch chain {
+		case common.Bitcoin:
+			screening.Result, err, _ = service.GtSingleAddressRiskInfo(address)
+		case common.Ethereum:
+			screening.Result, err, _ = service.GtSingleAddressRiskInfoETH(address)
+		}
+		if err != nil {
+			screening.Result = nil
+			screening.Error = err.Error()
+		}
+
+		screenings = append(screenings, screening)
+	}
+
+	if client, ok := library.Coinfirm(); ok {
+		screening := common.AddressScreening{
+			Provider: common.Coinfirm,
+		}
+
+		report, err := client.ScreenAddress(address)
+		if err != nil {
+			screening.Error = err.Error()
+		} else {
+			screening.Result = report
+		}
+
+		screenings = append(screenings, screening)
+	}
+
+	return
+}
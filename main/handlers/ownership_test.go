@@ -0,0 +1,309 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"modulus/kyc/common"
+	"modulus/kyc/main/config"
+	"modulus/kyc/main/handlers"
+	"modulus/kyc/ownership"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+	"gopkg.in/jarcoal/httpmock.v1"
+)
+
+const ethAddress = "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"
+
+// newCustomer creates the customer profile for the wallet ownership tests.
+func newCustomer(t *testing.T) string {
+	w := httptest.NewRecorder()
+	handlers.CreateCustomer(w, httptest.NewRequest(http.MethodPost, handlers.CustomersPath, strings.NewReader(`{"FirstName": "Abby"}`)))
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	customer := common.Customer{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &customer))
+
+	return customer.ID
+}
+
+// issueChallenge issues the wallet ownership challenge for ethAddress of the customer.
+func issueChallenge(t *testing.T, customerID string) (challenge ownership.Challenge) {
+	req, _ := json.Marshal(common.OwnershipChallengeRequest{
+		CustomerID: customerID,
+		Chain:      common.Ethereum,
+		Address:    ethAddress,
+	})
+
+	w := httptest.NewRecorder()
+	handlers.IssueOwnershipChallenge(w, httptest.NewRequest(http.MethodPost, "/crypto/ownership/challenge", bytes.NewReader(req)))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &challenge))
+
+	return
+}
+
+func TestOwnershipProof(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(handlers.IssueOwnershipChallenge)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/crypto/ownership/challenge", nil))
+
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal(`{"Error":"empty request"}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/crypto/ownership/challenge", bytes.NewReader([]byte(`{"Chain":"ETH","Address":"`+ethAddress+`"}`))))
+
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal(`{"Error":"missing customer id in the request"}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/crypto/ownership/challenge", bytes.NewReader([]byte(`{"CustomerID":"unknown","Chain":"ETH","Address":"`+ethAddress+`"}`))))
+
+	assert.Equal(http.StatusNotFound, w.Code)
+	assert.Equal(`{"Error":"customer not found"}`, w.Body.String())
+
+	customerID := newCustomer(t)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/crypto/ownership/challenge", bytes.NewReader([]byte(`{"CustomerID":"`+customerID+`","Chain":"ETH","Address":"0x123"}`))))
+
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal(`{"Error":"invalid Ethereum address"}`, w.Body.String())
+
+	req, _ := json.Marshal(common.OwnershipChallengeRequest{
+		CustomerID: customerID,
+		Chain:      common.Ethereum,
+		Address:    ethAddress,
+	})
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/crypto/ownership/challenge", bytes.NewReader(req)))
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("application/json; charset=utf-8", w.Header().Get("Content-Type"))
+
+	challenge := ownership.Challenge{}
+	if !assert.NoError(json.Unmarshal(w.Body.Bytes(), &challenge)) {
+		return
+	}
+	assert.Equal(ethAddress, challenge.Address)
+	assert.Equal(customerID, challenge.CustomerID)
+	assert.NotEmpty(challenge.Message)
+
+	handler = http.HandlerFunc(handlers.VerifyOwnershipProof)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/crypto/ownership/verify", bytes.NewReader([]byte(`{"ChallengeID":"unknown"}`))))
+
+	assert.Equal(http.StatusNotFound, w.Code)
+	assert.Equal(`{"Error":"ownership challenge not found or already used"}`, w.Body.String())
+
+	defer setOptions(string(common.CipherTrace), config.Options{
+		"URL":      "https://rest.ciphertrace.com",
+		"Key":      "fakekey",
+		"Username": "fakeuser",
+	})()
+	defer setOptions(string(common.Coinfirm), config.Options{
+		"Host":     "https://api.coinfirm.io/v2",
+		"Email":    "ownership@example.com",
+		"Password": "fakepassword",
+		"Company":  "fakecompany",
+	})()
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		http.MethodGet,
+		"https://rest.ciphertrace.com/aml/v1/eth/risk?address="+ethAddress,
+		httpmock.NewStringResponder(http.StatusOK, `{"address":"`+ethAddress+`","risk":2}`),
+	)
+	httpmock.RegisterResponder(
+		http.MethodPost,
+		"https://api.coinfirm.io/v2/auth/login",
+		httpmock.NewStringResponder(http.StatusOK, `{"token":"faketoken"}`),
+	)
+	httpmock.RegisterResponder(
+		http.MethodGet,
+		"https://api.coinfirm.io/v2/reports/aml/standard/"+ethAddress,
+		httpmock.NewStringResponder(http.StatusOK, `{"report_id":"8a3b","address":"`+ethAddress+`","cscore":34}`),
+	)
+
+	// The invalid signature doesn't use the challenge up.
+	req, _ = json.Marshal(common.OwnershipProofRequest{
+		ChallengeID: challenge.ID,
+		Signature:   personalSign("another message"),
+	})
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/crypto/ownership/verify", bytes.NewReader(req)))
+
+	assert.Equal(http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(`{"Error":"signature doesn't match the wallet address"}`, w.Body.String())
+
+	req, _ = json.Marshal(common.OwnershipProofRequest{
+		ChallengeID: challenge.ID,
+		Signature:   personalSign(challenge.Message),
+	})
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/crypto/ownership/verify", bytes.NewReader(req)))
+
+	assert.Equal(http.StatusOK, w.Code)
+
+	resp := struct {
+		Proof      *common.WalletOwnershipProof
+		Screenings []struct {
+			Provider common.KYCProvider
+			Result   map[string]interface{}
+			Error    string
+		}
+	}{}
+	if !assert.NoError(json.Unmarshal(w.Body.Bytes(), &resp)) {
+		return
+	}
+	if assert.NotNil(resp.Proof) {
+		assert.Equal(common.Ethereum, resp.Proof.Chain)
+		assert.Equal(ethAddress, resp.Proof.Address)
+		assert.Equal(string(ownership.PersonalSign), resp.Proof.Scheme)
+		assert.Equal(challenge.ID, resp.Proof.ChallengeID)
+	}
+	if assert.Len(resp.Screenings, 2) {
+		assert.Equal(common.CipherTrace, resp.Screenings[0].Provider)
+		assert.Equal(float64(2), resp.Screenings[0].Result["risk"])
+		assert.Empty(resp.Screenings[0].Error)
+		assert.Equal(common.Coinfirm, resp.Screenings[1].Provider)
+		assert.Equal(34.0, resp.Screenings[1].Result["cscore"])
+		assert.Empty(resp.Screenings[1].Error)
+	}
+
+	// The proof is recorded in the customer profile along with the screenings.
+	w = httptest.NewRecorder()
+	handlers.Customer(w, httptest.NewRequest(http.MethodGet, handlers.CustomersPath+"/"+customerID, nil))
+
+	customer := common.Customer{}
+	if assert.NoError(json.Unmarshal(w.Body.Bytes(), &customer)) && assert.Len(customer.Wallets, 1) {
+		assert.Equal(challenge.ID, customer.Wallets[0].Proof.ChallengeID)
+		assert.Len(customer.Wallets[0].Screenings, 2)
+	}
+
+	// The challenge is used only once.
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/crypto/ownership/verify", bytes.NewReader(req)))
+
+	assert.Equal(http.StatusNotFound, w.Code)
+}
+
+func TestOwnershipProofRestart(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "kyc-customers-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	defer handlers.StartCustomers(config.Customers{})
+
+	options := config.Customers{Dir: dir, Key: strings.Repeat("ef", 32)}
+	require.NoError(t, handlers.StartCustomers(options))
+
+	customerID := newCustomer(t)
+	challenge := issueChallenge(t, customerID)
+
+	// The challenge issued before the restart is accepted after it.
+	require.NoError(t, handlers.StartCustomers(options))
+
+	req, _ := json.Marshal(common.OwnershipProofRequest{
+		ChallengeID: challenge.ID,
+		Signature:   personalSign(challenge.Message),
+	})
+
+	w := httptest.NewRecorder()
+	handlers.VerifyOwnershipProof(w, httptest.NewRequest(http.MethodPost, "/crypto/ownership/verify", bytes.NewReader(req)))
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Contains(w.Body.String(), `"Proof":{`)
+
+	// The proof is persisted with the profile.
+	require.NoError(t, handlers.StartCustomers(options))
+
+	w = httptest.NewRecorder()
+	handlers.Customer(w, httptest.NewRequest(http.MethodGet, handlers.CustomersPath+"/"+customerID, nil))
+
+	customer := common.Customer{}
+	if assert.NoError(json.Unmarshal(w.Body.Bytes(), &customer)) && assert.Len(customer.Wallets, 1) {
+		assert.Equal(ethAddress, customer.Wallets[0].Proof.Address)
+	}
+
+	// The challenges signed by another key aren't accepted.
+	challenge = issueChallenge(t, customerID)
+	other, err := ioutil.TempDir("", "kyc-customers-")
+	require.NoError(t, err)
+	defer os.RemoveAll(other)
+	require.NoError(t, handlers.StartCustomers(config.Customers{Dir: other, Key: strings.Repeat("ab", 32)}))
+
+	req, _ = json.Marshal(common.OwnershipProofRequest{
+		ChallengeID: challenge.ID,
+		Signature:   personalSign(challenge.Message),
+	})
+
+	w = httptest.NewRecorder()
+	handlers.VerifyOwnershipProof(w, httptest.NewRequest(http.MethodPost, "/crypto/ownership/verify", bytes.NewReader(req)))
+
+	assert.Equal(http.StatusNotFound, w.Code)
+}
+
+func TestOwnershipProofMismatch(t *testing.T) {
+	assert := assert.New(t)
+
+	req, _ := json.Marshal(common.OwnershipChallengeRequest{
+		CustomerID: newCustomer(t),
+		Chain:      common.Ethereum,
+		Address:    "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+	})
+
+	w := httptest.NewRecorder()
+	handlers.IssueOwnershipChallenge(w, httptest.NewRequest(http.MethodPost, "/crypto/ownership/challenge", bytes.NewReader(req)))
+
+	challenge := ownership.Challenge{}
+	if !assert.NoError(json.Unmarshal(w.Body.Bytes(), &challenge)) {
+		return
+	}
+
+	req, _ = json.Marshal(common.OwnershipProofRequest{
+		ChallengeID: challenge.ID,
+		Signature:   personalSign(challenge.Message),
+	})
+
+	w = httptest.NewRecorder()
+	handlers.VerifyOwnershipProof(w, httptest.NewRequest(http.MethodPost, "/crypto/ownership/verify", bytes.NewReader(req)))
+
+	assert.Equal(http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(`{"Error":"signature doesn't match the wallet address"}`, w.Body.String())
+}
+
+// personalSign signs the message by the key of ethAddress using "personal_sign" method.
+func personalSign(message string) string {
+	raw, _ := hex.DecodeString("c85ef7d79691fe79573b1a7064c19c1a9819ebdbd1faaab1a8ec92344438aaf4")
+	key, _ := btcec.PrivKeyFromBytes(btcec.S256(), raw)
+
+	h := sha3.NewLegacyKeccak256()
+	fmt.Fprintf(h, "\x19Ethereum Signed Message:\n%d%s", len(message), message)
+
+	sig, _ := btcec.SignCompact(btcec.S256(), key, h.Sum(nil), false)
+
+	return "0x" + hex.EncodeToString(append(sig[1:], sig[0]))
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"modulus/kyc/main/config"
+)
+
+// draining is set to 1 when the service is shutting down.
+var draining int32
+
+var (
+	readinessMu     sync.RWMutex
+	readinessChecks = map[string]func() error{}
+)
+
+// RegisterReadinessCheck adds the check of a dependency the service can't handle requests without.
+// The readiness probe fails while any of the checks returns an error.
+func RegisterReadinessCheck(name string, check func() error) {
+	readinessMu.Lock()
+	defer readinessMu.Unlock()
+
+	readinessChecks[name] = check
+}
+
+// SetDraining turns the draining mode on or off. While draining the readiness probe fails,
+// so load balancers stop routing new requests to the shutting down service.
+func SetDraining(on bool) {
+	var value int32
+	if on {
+		value = 1
+	}
+	atomic.StoreInt32(&draining, value)
+}
+
+// Healthz handles the liveness probe requests. It succeeds as long as the service is able to respond at all.
+func Healthz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write([]byte("OK"))
+}
+
+// Readyz handles the readiness probe requests.
+// The service is ready when the config is loaded, the registered dependencies are reachable
+// and the service isn't shutting down.
+func Readyz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+	if err := checkReadiness(); err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	w.Write([]byte("OK"))
+}
+
+// checkReadiness returns the first reason the service isn't ready.
+func checkReadiness() error {
+	if atomic.LoadInt32(&draining) == 1 {
+		return errors.New("shutting down")
+	}
+	if len(config.Current().Config) == 0 {
+		return errors.New("config isn't loaded")
+	}
+
+	readinessMu.RLock()
+	defer readinessMu.RUnlock()
+
+	names := make([]string, 0, len(readinessChecks))
+	for name := range readinessChecks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := readinessChecks[name](); err != nil {
+			return fmt.Errorf("%s isn't reachable: %s", name, err)
+		}
+	}
+
+	return nil
+}
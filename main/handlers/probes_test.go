@@ -0,0 +1,115 @@
+package handlers_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"modulus/kyc/main/config"
+	"modulus/kyc/main/handlers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthz(t *testing.T) {
+	assert := assert.New(t)
+
+	w := httptest.NewRecorder()
+	handlers.Healthz(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("OK", w.Body.String())
+}
+
+func TestReadyz(t *testing.T) {
+	assert := assert.New(t)
+
+	previous := config.Current().Config
+	defer config.Set(previous)
+
+	ready := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handlers.Readyz(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		return w
+	}
+
+	// Testing ready service.
+	w := ready()
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("OK", w.Body.String())
+
+	// Testing missing config.
+	config.Set(config.Config{})
+
+	w = ready()
+
+	assert.Equal(http.StatusServiceUnavailable, w.Code)
+	assert.Equal("config isn't loaded", w.Body.String())
+
+	config.Set(previous)
+
+	// Testing unreachable dependency.
+	var storeErr error
+	handlers.RegisterReadinessCheck("store", func() error {
+		return storeErr
+	})
+
+	storeErr = errors.New("connection refused")
+
+	w = ready()
+
+	assert.Equal(http.StatusServiceUnavailable, w.Code)
+	assert.Equal("store isn't reachable: connection refused", w.Body.String())
+
+	storeErr = nil
+
+	w = ready()
+
+	assert.Equal(http.StatusOK, w.Code)
+
+	// Testing unwritable document vault, the disabled vault isn't checked.
+	handlers.StartDocuments()
+
+	w = ready()
+
+	assert.Equal(http.StatusOK, w.Code)
+
+	dir, err := ioutil.TempDir("", "kyc-vault-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := previous.Clone()
+	cfg[config.VaultSection] = config.Options{
+		"Dir": dir,
+		"Key": strings.Repeat("ab", 32),
+	}
+	config.Set(cfg)
+
+	w = ready()
+
+	assert.Equal(http.StatusOK, w.Code)
+
+	require.NoError(t, os.RemoveAll(dir))
+
+	w = ready()
+
+	assert.Equal(http.StatusServiceUnavailable, w.Code)
+	assert.Contains(w.Body.String(), "document vault isn't reachable: ")
+
+	config.Set(previous)
+
+	// Testing shutting down service.
+	handlers.SetDraining(true)
+	defer handlers.SetDraining(false)
+
+	w = ready()
+
+	assert.Equal(http.StatusServiceUnavailable, w.Code)
+	assert.Equal("shutting down", w.Body.String())
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"modulus/kyc/common"
+)
+
+// ProblemTypePrefix prefixes the problem codes to form the problem type URIs.
+const ProblemTypePrefix = "urn:kyc:problem:"
+
+// errorCategories maps the categories of the KYC errors to the HTTP statuses and the problem codes.
+var errorCategories = map[common.ErrorCategory]struct {
+	status int
+	code   common.ProblemCode
+}{
+	common.ValidationError:          {http.StatusUnprocessableEntity, common.ProblemValidationFailed},
+	common.AuthenticationError:      {http.StatusBadGateway, common.ProblemProviderAuthFailed},
+	common.RateLimitedError:         {http.StatusTooManyRequests, common.ProblemRateLimited},
+	common.ProviderUnavailableError: {http.StatusServiceUnavailable, common.ProblemProviderUnavailable},
+	common.ProviderRejectedError:    {http.StatusUnprocessableEntity, common.ProblemProviderRejected},
+	common.TimeoutError:             {http.StatusGatewayTimeout, common.ProblemProviderTimeout},
+}
+
+// writeProblem writes the RFC 7807 problem details response for the request using the specified status and code.
+func writeProblem(w http.ResponseWriter, r *http.Request, status int, code common.ProblemCode, detail string) {
+	writeProblemDetails(w, common.Problem{
+		Type:     ProblemTypePrefix + string(code),
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: r.URL.Path,
+		Code:     code,
+	})
+}
+
+// writeServiceProblem writes the problem details response for the serviceError.
+func writeServiceProblem(w http.ResponseWriter, r *http.Request, err *serviceError) {
+	code := err.code
+	if len(code) == 0 {
+		code = statusProblemCode(err.status)
+	}
+
+	writeProblem(w, r, err.status, code, err.message)
+}
+
+// writeProblemDetails writes the problem details response.
+func writeProblemDetails(w http.ResponseWriter, problem common.Problem) {
+	resp, _ := json.Marshal(problem)
+
+	w.Header().Set("Content-Type", "application/problem+json; charset=utf-8")
+	w.WriteHeader(problem.Status)
+	w.Write(resp)
+}
+
+// statusProblemCode returns the generic problem code for the HTTP status.
+func statusProblemCode(status int) common.ProblemCode {
+	switch status {
+	case http.StatusBadRequest, http.StatusRequestEntityTooLarge, http.StatusUnprocessableEntity:
+		return common.ProblemInvalidRequest
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return common.ProblemUnauthorized
+	case http.StatusNotFound:
+		return common.ProblemNotFound
+	case http.StatusMethodNotAllowed:
+		return common.ProblemMethodNotAllowed
+	case http.StatusBadGateway:
+		return common.ProblemProviderError
+	default:
+		return common.ProblemInternalError
+	}
+}
@@ -10,10 +10,6 @@ import (
 	"modulus/kyc/main/handlers/providers"
 )
 
-type isProviderImplementedResp struct {
-	Implemented bool
-}
-
 // IsProviderImplemented handles requests for check whether the provider specified in the request is implemented.
 func IsProviderImplemented(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -33,7 +29,7 @@ func IsProviderImplemented(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res := isProviderImplementedResp{
+	res := common.ProviderImplementedResponse{
 		Implemented: name == string(common.Example) || common.KYCProviders[common.KYCProvider(name)],
 	}
 
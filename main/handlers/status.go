@@ -7,18 +7,16 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"strings"
 
+	"modulus/kyc"
 	"modulus/kyc/common"
-	"modulus/kyc/integrations/coinfirm"
-	"modulus/kyc/integrations/example"
-	"modulus/kyc/integrations/identitymind"
-	"modulus/kyc/integrations/jumio"
-	"modulus/kyc/integrations/shuftipro"
-	"modulus/kyc/integrations/sumsub"
-	"modulus/kyc/integrations/synapsefi"
 	"modulus/kyc/main/config"
 )
 
+// referenceSep separates the config section name of the provider instance from the provider reference id.
+const referenceSep = "/"
+
 // CheckStatus handles requests for a status check.
 func CheckStatus(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -50,22 +48,22 @@ func CheckStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	service, err1 := createStatusChecker(req.Provider)
+	instance, referenceID, err1 := parseStatusReference(req.Provider, req.Instance, req.ReferenceID)
 	if err1 != nil {
 		writeErrorResponse(w, err1.status, err1)
 		return
 	}
 
-	response := common.KYCResponse{}
-
-	result, err := service.CheckStatus(req.ReferenceID)
-	if err != nil {
-		response.Error = err.Error()
+	service, err1 := createStatusChecker(req.Provider, instance)
+	if err1 != nil {
+		writeErrorResponse(w, err1.status, err1)
+		return
 	}
 
-	response.Result = common.ResultFromKYCResult(result)
+	result, err := service.CheckStatus(referenceID)
+	result.StatusCheck = instanceStatusCheck(req.Provider, instance, result.StatusCheck)
 
-	resp, err := json.Marshal(response)
+	resp, err := json.Marshal(common.NewKYCResponse(result, err))
 	if err != nil {
 		writeErrorResponse(w, http.StatusInternalServerError, err)
 		return
@@ -74,79 +72,50 @@ func CheckStatus(w http.ResponseWriter, r *http.Request) {
 	w.Write(resp)
 }
 
-// createStatusChecker returns the KYCPlatform object for the specified provider or an error if occurred.
-func createStatusChecker(provider common.KYCProvider) (service common.KYCPlatform, err *serviceError) {
-	if provider == common.Example {
-		service = example.Example{}
-		return
+// createStatusChecker returns the KYCPlatform object for the specified provider instance or an error if occurred.
+func createStatusChecker(provider common.KYCProvider, instance string) (service common.KYCPlatform, err *serviceError) {
+	return createPlatform(provider, instance, func(library *kyc.Service) (common.KYCPlatform, error) {
+		return library.StatusChecker(provider, kyc.WithInstance(instance))
+	})
+}
+
+// instanceStatusCheck returns the copy of the status check data of the verification by the provider instance.
+// The reference id of the named instance is prefixed with its config section name, so the status check finds
+// the instance without the client echoing it.
+func instanceStatusCheck(provider common.KYCProvider, instance string, statusCheck *common.KYCStatusCheck) *common.KYCStatusCheck {
+	if statusCheck == nil {
+		return nil
 	}
 
-	if !common.KYCProviders[provider] {
-		err = &serviceError{
-			status:  http.StatusNotFound,
-			message: fmt.Sprintf("unknown KYC provider in the request: %s", provider),
-		}
-		return
+	check := *statusCheck
+	check.Instance = instance
+	if len(instance) > 0 {
+		check.ReferenceID = config.SectionName(provider, instance) + referenceSep + check.ReferenceID
 	}
 
-	cfg, ok := config.Cfg[string(provider)]
-	if !ok {
-		err = &serviceError{
-			status:  http.StatusInternalServerError,
-			message: fmt.Sprintf("missing config for %s", provider),
-		}
-		return
+	return &check
+}
+
+// parseStatusReference extracts the provider instance and the provider reference id from the status check reference.
+// The reference without the instance is checked by the instance of the request.
+func parseStatusReference(provider common.KYCProvider, instance, reference string) (string, string, *serviceError) {
+	prefix := string(provider) + config.InstanceSep
+	if !strings.HasPrefix(reference, prefix) {
+		return instance, reference, nil
 	}
 
-	switch provider {
-	case common.ComplyAdvantage, common.IDology, common.ThomsonReuters, common.Trulioo:
-		err = &serviceError{
-			status:  http.StatusUnprocessableEntity,
-			message: fmt.Sprintf("%s doesn't support status polling", provider),
-		}
-	case common.Coinfirm:
-		service = coinfirm.New(coinfirm.Config{
-			Host:     cfg["Host"],
-			Email:    cfg["Email"],
-			Password: cfg["Password"],
-			Company:  cfg["Company"],
-		})
-	case common.IdentityMind:
-		service = identitymind.New(identitymind.Config{
-			Host:     cfg["Host"],
-			Username: cfg["Username"],
-			Password: cfg["Password"],
-		})
-	case common.Jumio:
-		service = jumio.New(jumio.Config{
-			BaseURL: cfg["BaseURL"],
-			Token:   cfg["Token"],
-			Secret:  cfg["Secret"],
-		})
-	case common.ShuftiPro:
-		service = shuftipro.New(shuftipro.Config{
-			Host:        cfg["Host"],
-			SecretKey:   cfg["SecretKey"],
-			ClientID:    cfg["ClientID"],
-			CallbackURL: cfg["CallbackURL"],
-		})
-	case common.SumSub:
-		service = sumsub.New(sumsub.Config{
-			Host:   cfg["Host"],
-			APIKey: cfg["APIKey"],
-		})
-	case common.SynapseFI:
-		service = synapsefi.New(synapsefi.Config{
-			Host:         cfg["Host"],
-			ClientID:     cfg["ClientID"],
-			ClientSecret: cfg["ClientSecret"],
-		})
-	default:
-		err = &serviceError{
-			status:  http.StatusUnprocessableEntity,
-			message: fmt.Sprintf("KYC provider not implemented yet: %s", provider),
+	i := strings.Index(reference, referenceSep)
+	if i <= len(prefix) {
+		return instance, reference, nil
+	}
+
+	_, encoded := config.SplitSectionName(reference[:i])
+	if len(instance) > 0 && instance != encoded {
+		return "", "", &serviceError{
+			status:  http.StatusBadRequest,
+			message: fmt.Sprintf("instance %s doesn't match the verification instance %s", instance, encoded),
 		}
 	}
 
-	return
+	return encoded, reference[i+len(referenceSep):], nil
 }
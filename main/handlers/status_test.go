@@ -73,8 +73,22 @@ var errorResponse = []byte(`
 }`)
 
 func init() {
-	if config.Cfg == nil {
-		config.Cfg = cfg
+	if len(config.Current().Config) == 0 {
+		config.Set(cfg)
+	}
+}
+
+// setOptions activates the current config with the options of the section replaced.
+// It returns the function restoring the previous config.
+func setOptions(section string, options config.Options) (restore func()) {
+	previous := config.Current().Config
+
+	cfg := previous.Clone()
+	cfg[section] = options
+	config.Set(cfg)
+
+	return func() {
+		config.Set(previous)
 	}
 }
 
@@ -87,7 +101,7 @@ func (r FailedReader) Read(p []byte) (n int, err error) {
 func TestCheckStatus(t *testing.T) {
 	assert := assert.New(t)
 
-	cfg := config.Cfg[string(common.SumSub)]
+	cfg := config.Current().Config[string(common.SumSub)]
 
 	assert.NotNil(cfg)
 
@@ -330,7 +344,7 @@ func TestCheckStatus(t *testing.T) {
 	assert.Nil(err)
 	assert.NotEmpty(request)
 
-	config.Cfg["Fake Provider"] = map[string]string{"test": "test"}
+	defer setOptions("Fake Provider", config.Options{"test": "test"})()
 
 	req = httptest.NewRequest(http.MethodPost, "/CheckStatus", bytes.NewReader(request))
 	w = httptest.NewRecorder()
@@ -384,7 +398,7 @@ func TestCheckStatus(t *testing.T) {
 	assert.Equal("Access denied", resp.Error)
 
 	// Testing IdentityMind.
-	cfg = config.Cfg[string(common.IdentityMind)]
+	cfg = config.Current().Config[string(common.IdentityMind)]
 
 	assert.NotNil(cfg)
 
@@ -423,3 +437,73 @@ func TestCheckStatus(t *testing.T) {
 	assert.Empty(resp.Result.ErrorCode)
 	assert.Nil(resp.Result.StatusCheck)
 }
+
+func TestCheckStatusInstance(t *testing.T) {
+	assert := assert.New(t)
+
+	section := config.SectionName(common.SumSub, "eu")
+	defer setOptions(section, config.Options{
+		"Host":   "https://eu.test-api.sumsub.com",
+		"APIKey": "fakeEUKey",
+	})()
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		http.MethodGet,
+		"https://eu.test-api.sumsub.com/resources/applicants/testID/status?key=fakeEUKey",
+		httpmock.NewBytesResponder(http.StatusOK, response),
+	)
+
+	request, err := json.Marshal(&common.CheckStatusRequest{
+		Provider:    common.SumSub,
+		Instance:    "eu",
+		ReferenceID: "testID",
+	})
+
+	assert.Nil(err)
+
+	req := httptest.NewRequest(http.MethodPost, "/CheckStatus", bytes.NewReader(request))
+	w := httptest.NewRecorder()
+
+	handlers.CheckStatus(w, req)
+
+	assert.Equal(http.StatusOK, w.Code)
+
+	resp := common.KYCResponse{}
+
+	err = json.Unmarshal(w.Body.Bytes(), &resp)
+
+	assert.Nil(err)
+	assert.Empty(resp.Error)
+	assert.NotNil(resp.Result)
+	assert.Equal(common.KYCStatus2Status[common.Denied], resp.Result.Status)
+
+	// Testing the instance encoded in the reference id, the client doesn't echo it.
+	request = []byte(`{"Provider":"Sum&Substance","ReferenceID":"Sum&Substance:eu/testID"}`)
+
+	req = httptest.NewRequest(http.MethodPost, "/CheckStatus", bytes.NewReader(request))
+	w = httptest.NewRecorder()
+
+	handlers.CheckStatus(w, req)
+
+	assert.Equal(http.StatusOK, w.Code)
+
+	resp = common.KYCResponse{}
+
+	assert.Nil(json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Empty(resp.Error)
+	assert.Equal(common.KYCStatus2Status[common.Denied], resp.Result.Status)
+
+	// Testing the instance of the request mismatching the encoded one.
+	request = []byte(`{"Provider":"Sum&Substance","Instance":"us","ReferenceID":"Sum&Substance:eu/testID"}`)
+
+	req = httptest.NewRequest(http.MethodPost, "/CheckStatus", bytes.NewReader(request))
+	w = httptest.NewRecorder()
+
+	handlers.CheckStatus(w, req)
+
+	assert.Equal(http.StatusBadRequest, w.Code)
+	assert.Equal(`{"Error":"instance us doesn't match the verification instance eu"}`, w.Body.String())
+}
@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"modulus/kyc/common"
+	"modulus/kyc/main/config"
+)
+
+// V2Prefix is the path prefix of the v2 API routes.
+const V2Prefix = "/v2/"
+
+// V2 routes the requests of the v2 API:
+//
+//	POST /v2/verifications       starts a verification of the customer
+//	GET  /v2/verifications/{id}  checks the status of the verification
+//	GET  /v2/providers           lists the implemented KYC providers
+//	GET  /v2/providers/{name}    retrieves the KYC provider
+//
+// All errors are reported as RFC 7807 problem details.
+func V2(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, V2Prefix), "/")
+	parts := strings.Split(path, "/")
+
+	switch {
+	case len(parts) == 1 && parts[0] == "verifications":
+		if allowMethod(w, r, http.MethodPost) {
+			createVerification(w, r)
+		}
+	case len(parts) == 2 && parts[0] == "verifications":
+		if allowMethod(w, r, http.MethodGet) {
+			getVerification(w, r, parts[1])
+		}
+	case len(parts) == 1 && parts[0] == "providers":
+		if allowMethod(w, r, http.MethodGet) {
+			listProviders(w, r)
+		}
+	case len(parts) == 2 && parts[0] == "providers":
+		if allowMethod(w, r, http.MethodGet) {
+			getProvider(w, r, common.KYCProvider(parts[1]))
+		}
+	default:
+		writeProblem(w, r, http.StatusNotFound, common.ProblemNotFound, "no such resource")
+	}
+}
+
+// allowMethod checks the request method and responds with 405 status if it's not the allowed one.
+func allowMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method == method {
+		return true
+	}
+
+	w.Header().Set("Allow", method)
+	writeProblem(w, r, http.StatusMethodNotAllowed, common.ProblemMethodNotAllowed, fmt.Sprintf("method %s isn't allowed", r.Method))
+
+	return false
+}
+
+// createVerification handles requests for starting KYC verifications.
+func createVerification(w http.ResponseWriter, r *http.Request) {
+	req := common.VerificationRequest{}
+	customer := verificationCustomer(&req)
+
+	if err := decodeRequest(r, &req, customer, decodeOptions{strict: true}); err != nil {
+		if err.status == http.StatusBadRequest {
+			err.message = fmt.Sprintf("malformed request: %s", err.message)
+		}
+		writeServiceProblem(w, r, err)
+		return
+	}
+	if len(req.Provider) == 0 {
+		writeProblem(w, r, http.StatusBadRequest, common.ProblemInvalidRequest, "missing KYC provider id in the request")
+		return
+	}
+	if req.Customer == nil {
+		writeProblem(w, r, http.StatusBadRequest, common.ProblemInvalidRequest, "missing customer data in the request")
+		return
+	}
+
+	service, err1 := createCustomerChecker(req.Provider, req.Instance)
+	if err1 != nil {
+		writeServiceProblem(w, r, err1)
+		return
+	}
+
+	result, cached, err := checkCustomerCached(service, req.Provider, req.Instance, customer(), requestCacheControl(r))
+	if err != nil {
+		writeProviderProblem(w, r, result, err)
+		return
+	}
+
+	verification := newVerification(req.Provider, req.Instance, result)
+	if cached != nil {
+		verification.Cache = &common.VerificationCache{
+			CachedAt:  cached.CachedAt,
+			ExpiresAt: cached.ExpiresAt,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	setAge(w, cached)
+	if len(verification.ID) > 0 {
+		w.Header().Set("Location", V2Prefix+"verifications/"+verification.ID)
+		w.WriteHeader(http.StatusCreated)
+	}
+	json.NewEncoder(w).Encode(verification)
+}
+
+// verificationCustomer returns the function converting the customer data of the v2 request into the UserData.
+// The conversion is made once the customer data is decoded, the files of the request are attached to its result.
+func verificationCustomer(req *common.VerificationRequest) func() *common.UserData {
+	var userData *common.UserData
+
+	return func() *common.UserData {
+		if userData == nil {
+			userData = req.Customer.ToUserData()
+		}
+		return userData
+	}
+}
+
+// getVerification handles requests for checking the status of KYC verifications.
+func getVerification(w http.ResponseWriter, r *http.Request, id string) {
+	provider, instance, referenceID, err := parseVerificationID(id)
+	if err != nil {
+		writeProblem(w, r, http.StatusNotFound, common.ProblemNotFound, err.Error())
+		return
+	}
+
+	service, err1 := createStatusChecker(provider, instance)
+	if err1 != nil {
+		writeServiceProblem(w, r, err1)
+		return
+	}
+
+	result, err := service.CheckStatus(referenceID)
+	if err != nil {
+		writeProviderProblem(w, r, result, err)
+		return
+	}
+
+	verification := newVerification(provider, instance, result)
+	if len(verification.ID) == 0 {
+		// The provider doesn't return the status check data for completed verifications.
+		verification.ID = id
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(verification)
+}
+
+// listProviders handles requests for the list of implemented KYC providers.
+func listProviders(w http.ResponseWriter, r *http.Request) {
+	response := common.ProviderListResponse{
+		Providers: []common.ProviderResource{},
+	}
+	for _, provider := range providerList() {
+		response.Providers = append(response.Providers, common.ProviderResource{
+			Name:        provider,
+			Implemented: true,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(response)
+}
+
+// getProvider handles requests for the KYC provider.
+func getProvider(w http.ResponseWriter, r *http.Request, provider common.KYCProvider) {
+	if provider != common.Example && !common.KYCProviders[provider] {
+		writeProblem(w, r, http.StatusNotFound, common.ProblemUnknownProvider, fmt.Sprintf("unknown KYC provider: %s", provider))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(common.ProviderResource{
+		Name:        provider,
+		Implemented: true,
+	})
+}
+
+// writeProviderProblem writes the problem details response for the error returned by the KYC provider.
+// The status and the code of the problem depend on the error category, unclassified errors are reported as 502.
+func writeProviderProblem(w http.ResponseWriter, r *http.Request, result common.KYCResult, err error) {
+	problem := common.Problem{
+		Status:            http.StatusBadGateway,
+		Detail:            err.Error(),
+		Instance:          r.URL.Path,
+		Code:              common.ProblemProviderError,
+		ProviderErrorCode: result.ErrorCode,
+	}
+
+	if kycErr, ok := common.AsKYCError(err); ok {
+		if category, ok := errorCategories[kycErr.Category]; ok {
+			problem.Status = category.status
+			problem.Code = category.code
+		}
+		problem.Retryable = kycErr.Retryable()
+	}
+	if problem.Retryable {
+		markRetryable(w)
+	}
+
+	problem.Type = ProblemTypePrefix + string(problem.Code)
+	problem.Title = http.StatusText(problem.Status)
+
+	writeProblemDetails(w, problem)
+}
+
+// newVerification converts the KYC verification result into the verification resource.
+func newVerification(provider common.KYCProvider, instance string, result common.KYCResult) (verification common.Verification) {
+	res := common.ResultFromKYCResult(result)
+
+	verification = common.Verification{
+		Provider:  provider,
+		Instance:  instance,
+		Status:    res.Status,
+		RiskLevel: res.RiskLevel,
+		ErrorCode: res.ErrorCode,
+	}
+	if res.Details != nil {
+		verification.Details = &common.VerificationDetails{
+			Finality: res.Details.Finality,
+			Reasons:  res.Details.Reasons,
+		}
+	}
+	if result.StatusCheck != nil && len(result.StatusCheck.ReferenceID) > 0 {
+		verification.ID = verificationID(provider, instance, result.StatusCheck.ReferenceID)
+	}
+
+	return
+}
+
+// verificationID forms the opaque verification id from the provider instance and the provider reference id.
+func verificationID(provider common.KYCProvider, instance, referenceID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(config.SectionName(provider, instance) + "/" + referenceID))
+}
+
+// parseVerificationID extracts the provider instance and the provider reference id from the verification id.
+func parseVerificationID(id string) (provider common.KYCProvider, instance, referenceID string, err error) {
+	raw, err := base64.RawURLEncoding.DecodeString(id)
+	if err != nil {
+		err = fmt.Errorf("invalid verification id: %s", id)
+		return
+	}
+
+	parts := strings.SplitN(string(raw), "/", 2)
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		err = fmt.Errorf("invalid verification id: %s", id)
+		return
+	}
+
+	provider, instance = config.SplitSectionName(parts[0])
+	referenceID = parts[1]
+
+	return
+}
@@ -0,0 +1,194 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"modulus/kyc/common"
+	"modulus/kyc/main/handlers"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// serveV2 sends the request to the v2 API handler.
+func serveV2(method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+	handlers.V2(w, req)
+	return w
+}
+
+// assertProblem checks the response is the problem details of the status and the code specified and returns it.
+func assertProblem(t *testing.T, w *httptest.ResponseRecorder, status int, code common.ProblemCode) (problem common.Problem) {
+	assert := assert.New(t)
+
+	assert.Equal(status, w.Code)
+	assert.Equal("application/problem+json; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.NoError(json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(status, problem.Status)
+	assert.Equal(code, problem.Code)
+	assert.Equal(handlers.ProblemTypePrefix+string(code), problem.Type)
+	assert.Equal(http.StatusText(status), problem.Title)
+
+	return
+}
+
+func TestV2CreateVerification(t *testing.T) {
+	assert := assert.New(t)
+
+	w := serveV2(http.MethodPost, "/v2/verifications", `{"provider":"Example","customer":{"firstName":"Abby"}}`)
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("application/json; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Empty(w.Header().Get("Location"))
+	assert.JSONEq(`{"provider":"Example","status":"Approved","riskLevel":"Unknown"}`, w.Body.String())
+
+	verification := common.Verification{}
+	assert.NoError(json.Unmarshal(w.Body.Bytes(), &verification))
+	assert.Equal(common.Verification{
+		Provider:  common.Example,
+		Status:    "Approved",
+		RiskLevel: "Unknown",
+	}, verification)
+
+	// Testing the error returned by the provider.
+	w = serveV2(http.MethodPost, "/v2/verifications", `{"provider":"Example","customer":{"firstName":"Erika"}}`)
+
+	problem := assertProblem(t, w, http.StatusTooManyRequests, common.ProblemRateLimited)
+	assert.Equal("during sending request: http error", problem.Detail)
+	assert.Equal("429", problem.ProviderErrorCode)
+	assert.Equal("/v2/verifications", problem.Instance)
+	assert.True(problem.Retryable)
+
+	// Testing the customer data rejected by the integration.
+	w = serveV2(http.MethodPost, "/v2/verifications", `{"provider":"Example","customer":{"lastName":"Doe"}}`)
+
+	problem = assertProblem(t, w, http.StatusUnprocessableEntity, common.ProblemValidationFailed)
+	assert.Equal("missing the required field: FirstName", problem.Detail)
+	assert.False(problem.Retryable)
+}
+
+func TestV2CreateVerificationErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	w := serveV2(http.MethodGet, "/v2/verifications", "")
+
+	assertProblem(t, w, http.StatusMethodNotAllowed, common.ProblemMethodNotAllowed)
+	assert.Equal(http.MethodPost, w.Header().Get("Allow"))
+
+	w = serveV2(http.MethodPost, "/v2/verifications", "")
+
+	problem := assertProblem(t, w, http.StatusBadRequest, common.ProblemInvalidRequest)
+	assert.Equal("malformed request: EOF", problem.Detail)
+
+	w = serveV2(http.MethodPost, "/v2/verifications", `{"provder":"Example"}`)
+
+	problem = assertProblem(t, w, http.StatusBadRequest, common.ProblemInvalidRequest)
+	assert.Equal(`malformed request: json: unknown field "provder"`, problem.Detail)
+
+	w = serveV2(http.MethodPost, "/v2/verifications", `{"Provider":"Example","Customer":{"FirstName":"Abby"}}`)
+
+	problem = assertProblem(t, w, http.StatusBadRequest, common.ProblemInvalidRequest)
+	assert.Equal(`malformed request: json: unknown field "Provider"`, problem.Detail)
+
+	w = serveV2(http.MethodPost, "/v2/verifications", `{"customer":{}}`)
+
+	problem = assertProblem(t, w, http.StatusBadRequest, common.ProblemInvalidRequest)
+	assert.Equal("missing KYC provider id in the request", problem.Detail)
+
+	w = serveV2(http.MethodPost, "/v2/verifications", `{"provider":"Example"}`)
+
+	problem = assertProblem(t, w, http.StatusBadRequest, common.ProblemInvalidRequest)
+	assert.Equal("missing customer data in the request", problem.Detail)
+
+	w = serveV2(http.MethodPost, "/v2/verifications", `{"provider":"Foo","customer":{}}`)
+
+	problem = assertProblem(t, w, http.StatusNotFound, common.ProblemUnknownProvider)
+	assert.Equal("unknown KYC provider in the request: Foo", problem.Detail)
+
+	w = serveV2(http.MethodPost, "/v2/verifications", `{"provider":"Jumio","instance":"missing","customer":{}}`)
+
+	problem = assertProblem(t, w, http.StatusNotFound, common.ProblemUnknownInstance)
+	assert.Equal("unknown Jumio instance in the request: missing", problem.Detail)
+}
+
+func TestV2GetVerification(t *testing.T) {
+	assert := assert.New(t)
+
+	// The id of the Example provider verification "ada".
+	w := serveV2(http.MethodGet, "/v2/verifications/RXhhbXBsZS9hZGE", "")
+
+	assert.Equal(http.StatusOK, w.Code)
+
+	verification := common.Verification{}
+	assert.NoError(json.Unmarshal(w.Body.Bytes(), &verification))
+	assert.Equal(common.Verification{
+		ID:        "RXhhbXBsZS9hZGE",
+		Provider:  common.Example,
+		Status:    "Approved",
+		RiskLevel: "Unknown",
+	}, verification)
+
+	// The id of the Example provider verification "elin".
+	w = serveV2(http.MethodGet, "/v2/verifications/RXhhbXBsZS9lbGlu", "")
+
+	problem := assertProblem(t, w, http.StatusBadGateway, common.ProblemProviderAuthFailed)
+	assert.Equal("401", problem.ProviderErrorCode)
+	assert.False(problem.Retryable)
+
+	w = serveV2(http.MethodDelete, "/v2/verifications/RXhhbXBsZS9hZGE", "")
+
+	assertProblem(t, w, http.StatusMethodNotAllowed, common.ProblemMethodNotAllowed)
+	assert.Equal(http.MethodGet, w.Header().Get("Allow"))
+
+	w = serveV2(http.MethodGet, "/v2/verifications/!!!", "")
+
+	problem = assertProblem(t, w, http.StatusNotFound, common.ProblemNotFound)
+	assert.Equal("invalid verification id: !!!", problem.Detail)
+
+	// The id of the Trulioo verification "x".
+	w = serveV2(http.MethodGet, "/v2/verifications/VHJ1bGlvby94", "")
+
+	problem = assertProblem(t, w, http.StatusUnprocessableEntity, common.ProblemUnsupportedOperation)
+	assert.Equal("Trulioo doesn't support status polling", problem.Detail)
+
+	// The id of the unknown provider verification "x".
+	w = serveV2(http.MethodGet, "/v2/verifications/Rm9vL3g", "")
+
+	assertProblem(t, w, http.StatusNotFound, common.ProblemUnknownProvider)
+}
+
+func TestV2Providers(t *testing.T) {
+	assert := assert.New(t)
+
+	w := serveV2(http.MethodGet, "/v2/providers", "")
+
+	assert.Equal(http.StatusOK, w.Code)
+
+	list := common.ProviderListResponse{}
+	assert.NoError(json.Unmarshal(w.Body.Bytes(), &list))
+	assert.Len(list.Providers, len(common.KYCProviders))
+	assert.Contains(list.Providers, common.ProviderResource{Name: common.Jumio, Implemented: true})
+
+	w = serveV2(http.MethodGet, "/v2/providers/Sum&Substance", "")
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.JSONEq(`{"name":"Sum&Substance","implemented":true}`, w.Body.String())
+
+	w = serveV2(http.MethodGet, "/v2/providers/Foo", "")
+
+	problem := assertProblem(t, w, http.StatusNotFound, common.ProblemUnknownProvider)
+	assert.Equal("unknown KYC provider: Foo", problem.Detail)
+
+	w = serveV2(http.MethodPost, "/v2/providers", "")
+
+	assertProblem(t, w, http.StatusMethodNotAllowed, common.ProblemMethodNotAllowed)
+
+	w = serveV2(http.MethodGet, "/v2/foo", "")
+
+	problem = assertProblem(t, w, http.StatusNotFound, common.ProblemNotFound)
+	assert.Equal("/v2/foo", problem.Instance)
+}
@@ -2,14 +2,18 @@ package main
 
 import (
 	"flag"
-	"github.com/fsnotify/fsnotify"
 	"io"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 
+	"modulus/kyc/common"
 	"modulus/kyc/main/config"
 	"modulus/kyc/main/handlers"
+
+	"google.golang.org/grpc"
 )
 
 const (
@@ -22,11 +26,14 @@ const (
 var DevEnv = "true"
 
 var (
-	cfgFile = flag.String("config", "", "Load the service configuration from the file specified")
+	cfgFile = flag.String("config", "", "Load the service configuration from the file specified (.cfg, .yaml, .json or .toml)")
 	port    = flag.String("port", "", "Listen on the port specified")
 )
 
 func main() {
+	if code, ok := runCommand(os.Args[1:], os.Stdout, os.Stderr); ok {
+		os.Exit(code)
+	}
 
 	// FIXME: temporarily turned off license check.
 	// Validate license in production environment.
@@ -47,19 +54,18 @@ func main() {
 	// If the command line flag is set its value will be used as the config file name otherwise
 	// a predefined file name will be used depending on the value of DevEnv variable.
 	if len(*cfgFile) == 0 {
-		switch DevEnv {
-		case "true":
-			*cfgFile = devCfgFile
-		default:
-			*cfgFile = prodCfgFile
-		}
+		*cfgFile = defaultConfigFile()
 	}
 	if err := config.FromFile(*cfgFile); err != nil {
 		log.Fatalf("Loading configuration from %s: %s\n", *cfgFile, err)
 	}
 
-	// watch config changes.
+	// Probe the credentials of the configured providers in the background.
+	go logProvidersHealth()
+
+	// Reload config on changes of the file and on SIGHUP.
 	go watchConfigs()
+	go reloadOnSignal()
 
 	createHandlers()
 
@@ -67,55 +73,136 @@ func main() {
 	// If the command line flag is set its value will be used for the listening port
 	// otherwise the option from the service config will be used.
 	if len(*port) == 0 {
-		*port = config.Cfg.ServicePort()
+		*port = config.Current().Config.ServicePort()
+	}
+
+	// Configure timeouts and TLS of the server from the service config section.
+	options, err := config.Current().Config.Server()
+	if err != nil {
+		log.Fatalln("Configuring server:", err)
+	}
+	handlers.StartJobs(options)
+	handlers.StartDocuments()
+
+	customers, err := config.Current().Config.Customers()
+	if err != nil {
+		log.Fatalln("Configuring customers:", err)
+	}
+	if err := handlers.StartCustomers(customers); err != nil {
+		log.Fatalln("Opening customers store:", err)
+	}
+	handlers.SetIdempotencyWindow(options.IdempotencyWindow)
+	handlers.SetRequestLimits(options.MaxRequestSize, options.MaxFileSize)
+
+	server, err := newServer(*port, options, handlers.WithConfigVersion(http.DefaultServeMux))
+	if err != nil {
+		log.Fatalln("Configuring server:", err)
+	}
+
+	var grpcServer *grpc.Server
+	if len(options.GRPCPort) > 0 {
+		if grpcServer, err = newGRPCServer(options); err != nil {
+			log.Fatalln("Configuring gRPC server:", err)
+		}
 	}
 
 	log.Printf("Listen on :%v", *port)
 
-	if err := http.ListenAndServe(":"+*port, nil); err != nil {
+	if err := serve(server, grpcServer, options); err != nil {
 		log.Fatalln("ListenAndServe:", err)
 	}
+
+	log.Println("Server stopped")
 }
 
-// createHandlers registers the API handlers in the DefaultServeMux.
-func createHandlers() {
-	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+// defaultConfigFile returns the config file name depending on the value of DevEnv variable.
+func defaultConfigFile() string {
+	if DevEnv == "true" {
+		return devCfgFile
+	}
+	return prodCfgFile
+}
+
+// route binds the handler to the pattern of the DefaultServeMux.
+type route struct {
+	pattern string
+	handler http.HandlerFunc
+}
+
+// routes lists the API handlers. Every route but the welcome page is described by the OpenAPI document.
+var routes = []route{
+	{"/", func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte("Welcome to the KYC service. Have a nice day!\n"))
-	})
-	http.HandleFunc("/Ping", func(w http.ResponseWriter, r *http.Request) {
+	}},
+	{"/Ping", func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte("Pong!"))
-	})
-	http.HandleFunc("/CheckCustomer", handlers.CheckCustomer)
-	http.HandleFunc("/CheckStatus", handlers.CheckStatus)
-	http.HandleFunc("/Provider", handlers.IsProviderImplemented)
-	http.HandleFunc("/cipherTrace", handlers.CipherTraceCheck)
+	}},
+	{"/healthz", handlers.Healthz},
+	{"/readyz", handlers.Readyz},
+	{"/openapi.json", handlers.OpenAPI},
+	{"/CheckCustomer", handlers.Idempotent(handlers.CheckCustomer)},
+	{"/CheckStatus", handlers.CheckStatus},
+	{handlers.JobsPath, handlers.Idempotent(handlers.SubmitJob)},
+	{handlers.JobsPath + "/", handlers.GetJob},
+	{handlers.BatchesPath, handlers.CreateBatch},
+	{handlers.BatchesPath + "/", handlers.GetBatch},
+	{handlers.CustomersPath, handlers.Idempotent(handlers.CreateCustomer)},
+	{handlers.CustomersPath + "/", handlers.Idempotent(handlers.Customer)},
+	{handlers.DocumentsPath, handlers.UploadDocument},
+	{handlers.DocumentsPath + "/", handlers.GetDocument},
+	{"/Provider", handlers.IsProviderImplemented},
+	{"/cipherTrace", handlers.CipherTraceCheck},
+	{"/crypto/wallet/", handlers.WalletAddresses},
+	{"/crypto/ownership/challenge", handlers.IssueOwnershipChallenge},
+	{"/crypto/ownership/verify", handlers.VerifyOwnershipProof},
+	{handlers.V2Prefix, handlers.Idempotent(handlers.V2)},
+	{"/admin/reload", handlers.ReloadConfig},
+	{"/admin/providers/health", handlers.ProvidersHealth},
 }
 
-// Watch config file and update configs when events happen.
+// createHandlers registers the API handlers in the DefaultServeMux.
+func createHandlers() {
+	for _, r := range routes {
+		http.HandleFunc(r.pattern, r.handler)
+	}
+}
+
+// watchConfigs reloads the config when its file changes.
 func watchConfigs() {
-	watcher, err := fsnotify.NewWatcher()
-	if err != nil {
-		log.Println("file watcher can not be created")
+	if err := config.Watch(nil, logReload); err != nil {
+		log.Fatalf("Watching configuration from %s: %s\n", *cfgFile, err)
 	}
-	err = watcher.Add(*cfgFile)
+}
+
+// reloadOnSignal reloads the config when the service receives SIGHUP.
+func reloadOnSignal() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+
+	for range signals {
+		logReload(config.Reload())
+	}
+}
+
+// logReload logs the result of the config reload.
+func logReload(snapshot *config.Snapshot, err error) {
 	if err != nil {
-		log.Fatalf("Watching configuration from %s: %s\n", *cfgFile, err)
+		log.Printf("Reloading configuration from %s: %s, version %s stays active\n", snapshot.Source, err, snapshot.Version)
+		return
 	}
-	go func() {
-		for {
-			select {
-			case event, ok := <-watcher.Events:
-				if ok && event.Op.String() == "WRITE" {
-					if err := config.FromFile(*cfgFile); err != nil {
-						log.Printf("Reloading configuration from %s: %s\n", *cfgFile, err)
-					} else {
-						log.Printf("Reloading configuration from %s\n", *cfgFile)
-					}
-				}
-			case err, _ := <-watcher.Errors:
-				log.Println("error watching config file:", err)
-			}
+	log.Printf("Configuration from %s version %s is active\n", snapshot.Source, snapshot.Version)
+
+	go logProvidersHealth()
+}
+
+// logProvidersHealth probes the credentials of the configured providers and logs the results.
+func logProvidersHealth() {
+	for _, health := range handlers.CheckProvidersHealth() {
+		name := config.SectionName(health.Provider, health.Instance)
+		if health.Status == common.Healthy {
+			log.Printf("Provider %s credentials probe: %s in %dms\n", name, health.Status, health.LatencyMs)
+			continue
 		}
-	}()
-	<-make(chan struct{})
+		log.Printf("Provider %s credentials probe: %s in %dms: %s\n", name, health.Status, health.LatencyMs, health.Error)
+	}
 }
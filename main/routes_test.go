@@ -0,0 +1,39 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"modulus/kyc/main/handlers"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIDocumentRoutes(t *testing.T) {
+	assert := assert.New(t)
+
+	createHandlers()
+
+	// Every documented operation is routed.
+	documented := map[string]bool{}
+	for path, item := range handlers.APIDocument().Paths {
+		// Path parameters are replaced with sample values.
+		target := strings.NewReplacer("{id}", "x", "{name}", "x").Replace(path)
+
+		for method := range item.Operations() {
+			_, pattern := http.DefaultServeMux.Handler(httptest.NewRequest(method, target, nil))
+
+			assert.NotEqual("/", pattern, "%s %s isn't routed", method, path)
+			documented[pattern] = true
+		}
+	}
+
+	// Every route but the welcome page is documented.
+	for _, r := range routes {
+		if r.pattern != "/" {
+			assert.True(documented[r.pattern], "%s isn't documented", r.pattern)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"modulus/kyc/kycpb"
+	"modulus/kyc/main/config"
+	"modulus/kyc/main/handlers"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// newServer constructs the HTTP server listening on the port with the options specified.
+func newServer(port string, options config.Server, handler http.Handler) (server *http.Server, err error) {
+	server = &http.Server{
+		Addr:         ":" + port,
+		Handler:      handler,
+		ReadTimeout:  options.ReadTimeout,
+		WriteTimeout: options.WriteTimeout,
+		IdleTimeout:  options.IdleTimeout,
+	}
+
+	if options.TLS() {
+		certificate, err := newCertReloader(options.TLSCertFile, options.TLSKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		server.TLSConfig = &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: certificate.GetCertificate,
+		}
+	}
+
+	return
+}
+
+// newGRPCServer constructs the gRPC server of the KYC service with the options specified.
+func newGRPCServer(options config.Server) (server *grpc.Server, err error) {
+	var opts []grpc.ServerOption
+	if options.TLS() {
+		certificate, err := newCertReloader(options.TLSCertFile, options.TLSKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(&tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: certificate.GetCertificate,
+		})))
+	}
+
+	server = grpc.NewServer(opts...)
+	kycpb.RegisterKYCServer(server, handlers.GRPCServer{})
+
+	return
+}
+
+// serve runs the server and the gRPC server if it isn't nil until they are shut down by SIGTERM or SIGINT.
+// On the signal the readiness probe starts failing at once while the requests are still served for the shutdown delay.
+// Then the servers stop listening and wait for the in-flight requests to complete within the shutdown timeout.
+func serve(server *http.Server, grpcServer *grpc.Server, options config.Server) (err error) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+	defer signal.Stop(signals)
+
+	if grpcServer != nil {
+		listener, err := net.Listen("tcp", ":"+options.GRPCPort)
+		if err != nil {
+			return err
+		}
+		log.Printf("gRPC listen on :%v", options.GRPCPort)
+
+		go func() {
+			if err := grpcServer.Serve(listener); err != nil {
+				log.Println("gRPC server:", err)
+			}
+		}()
+	}
+
+	stopped := make(chan error, 1)
+	go func() {
+		sig := <-signals
+		log.Printf("Received %s, shutting down\n", sig)
+
+		handlers.SetDraining(true)
+		time.Sleep(options.ShutdownDelay)
+
+		ctx, cancel := context.WithTimeout(context.Background(), options.ShutdownTimeout)
+		defer cancel()
+
+		wg := sync.WaitGroup{}
+		if grpcServer != nil {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				stopGRPC(ctx, grpcServer)
+			}()
+		}
+
+		err := server.Shutdown(ctx)
+		wg.Wait()
+
+		// Let the accepted asynchronous jobs finish and deliver their callbacks,
+		// the batches stop starting the verifications and finish the ones in progress.
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if err1 := handlers.StopJobs(ctx); err1 != nil {
+				log.Println("Stopping jobs:", err1)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if err1 := handlers.StopBatches(ctx); err1 != nil {
+				log.Println("Stopping batches:", err1)
+			}
+		}()
+		wg.Wait()
+
+		stopped <- err
+	}()
+
+	if options.TLS() {
+		err = server.ListenAndServeTLS("", "")
+	} else {
+		err = server.ListenAndServe()
+	}
+	if err != http.ErrServerClosed {
+		return
+	}
+
+	// Wait for the in-flight requests to complete.
+	return <-stopped
+}
+
+// stopGRPC stops the gRPC server gracefully. The calls still running when the context is done are cancelled.
+func stopGRPC(ctx context.Context, server *grpc.Server) {
+	done := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		server.Stop()
+	}
+}
+
+// certCheckInterval is the minimal interval between the checks of the TLS certificate files for modification.
+const certCheckInterval = 10 * time.Second
+
+// certReloader provides the TLS certificate reading it again whenever its files change.
+// The files are checked at most once per interval, so the handshakes don't stat them every time.
+type certReloader struct {
+	certFile string
+	keyFile  string
+	interval time.Duration
+
+	mu          sync.Mutex
+	certificate *tls.Certificate
+	modTime     time.Time
+	checkedAt   time.Time
+}
+
+// newCertReloader constructs a new certReloader loading the certificate from the files specified.
+func newCertReloader(certFile, keyFile string) (reloader *certReloader, err error) {
+	reloader = &certReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		interval: certCheckInterval,
+	}
+
+	if err = reloader.reload(); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// GetCertificate implements the tls.Config.GetCertificate callback.
+// If the renewed certificate fails to load the previous one is served and the error is logged.
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checkedAt) < r.interval {
+		return r.certificate, nil
+	}
+
+	if err := r.reload(); err != nil {
+		log.Printf("Reloading TLS certificate from %s: %s\n", r.certFile, err)
+	}
+
+	return r.certificate, nil
+}
+
+// reload loads the certificate if its files have been modified since the last loading.
+// The caller must hold the lock unless the reloader isn't shared yet.
+func (r *certReloader) reload() (err error) {
+	r.checkedAt = time.Now()
+
+	modTime, err := r.lastModified()
+	if err != nil {
+		return
+	}
+	if r.certificate != nil && modTime.Equal(r.modTime) {
+		return
+	}
+
+	certificate, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return
+	}
+
+	r.certificate = &certificate
+	r.modTime = modTime
+
+	return
+}
+
+// lastModified returns the latest modification time of the certificate files.
+func (r *certReloader) lastModified() (modTime time.Time, err error) {
+	for _, filename := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(filename)
+		if err != nil {
+			return modTime, err
+		}
+		if info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+	}
+
+	return
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"testing"
+	"time"
+
+	"modulus/kyc/main/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCertificate writes the self-signed certificate with the serial number specified and its key to the files.
+func writeCertificate(t *testing.T, certFile, keyFile string, serial int64) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644))
+	require.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+}
+
+func TestCertReloader(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "kyc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+
+	// Testing missing files.
+	_, err = newCertReloader(certFile, keyFile)
+
+	assert.Error(err)
+
+	writeCertificate(t, certFile, keyFile, 1)
+
+	reloader, err := newCertReloader(certFile, keyFile)
+	require.NoError(t, err)
+	reloader.interval = 0
+
+	serial := func() int64 {
+		certificate, err := reloader.GetCertificate(nil)
+		require.NoError(t, err)
+
+		leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+		require.NoError(t, err)
+
+		return leaf.SerialNumber.Int64()
+	}
+
+	assert.Equal(int64(1), serial())
+
+	// Testing renewed certificate.
+	writeCertificate(t, certFile, keyFile, 2)
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, later, later))
+
+	assert.Equal(int64(2), serial())
+
+	// Testing broken certificate, the previous one stays in use.
+	require.NoError(t, ioutil.WriteFile(certFile, []byte("broken"), 0644))
+	later = later.Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, later, later))
+
+	assert.Equal(int64(2), serial())
+
+	// Testing the files aren't checked again within the interval.
+	reloader.interval = time.Hour
+	writeCertificate(t, certFile, keyFile, 3)
+	later = later.Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, later, later))
+
+	assert.Equal(int64(2), serial())
+
+	reloader.interval = 0
+
+	assert.Equal(int64(3), serial())
+}
+
+func TestServeGracefulShutdown(t *testing.T) {
+	assert := assert.New(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := strconv.Itoa(listener.Addr().(*net.TCPAddr).Port)
+	listener.Close()
+
+	started := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		w.Write([]byte("done"))
+	})
+
+	options, err := config.Config{}.Server()
+	require.NoError(t, err)
+
+	server, err := newServer(port, options, handler)
+	require.NoError(t, err)
+
+	served := make(chan error, 1)
+	go func() {
+		served <- serve(server, nil, options)
+	}()
+
+	// Wait for the server to start listening.
+	var resp *http.Response
+	responded := make(chan error, 1)
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", "127.0.0.1:"+port)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}, time.Second, 10*time.Millisecond)
+
+	go func() {
+		var err error
+		resp, err = http.Get("http://127.0.0.1:" + port)
+		responded <- err
+	}()
+
+	<-started
+	require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGTERM))
+
+	// The in-flight request completes before the server stops.
+	assert.NoError(<-responded)
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	assert.NoError(err)
+	assert.Equal("done", string(body))
+	assert.NoError(<-served)
+}
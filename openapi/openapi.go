@@ -0,0 +1,162 @@
+package openapi
+
+// Version is the version of the OpenAPI specification the documents conform to.
+const Version = "3.0.3"
+
+// Document represents the OpenAPI document describing the API.
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Servers    []Server             `json:"servers,omitempty"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+// Info represents the metadata about the API.
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// Server represents the server providing the API.
+type Server struct {
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// PathItem represents the operations available on the single path.
+type PathItem struct {
+	Get    *Operation `json:"get,omitempty"`
+	Post   *Operation `json:"post,omitempty"`
+	Put    *Operation `json:"put,omitempty"`
+	Delete *Operation `json:"delete,omitempty"`
+	Patch  *Operation `json:"patch,omitempty"`
+}
+
+// Operation represents the single API operation on the path.
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+// Parameter represents the path or the query parameter of the operation.
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody represents the request body of the operation.
+type RequestBody struct {
+	Description string                `json:"description,omitempty"`
+	Required    bool                  `json:"required,omitempty"`
+	Content     map[string]*MediaType `json:"content"`
+}
+
+// Response represents the single response of the operation.
+type Response struct {
+	Description string                `json:"description"`
+	Headers     map[string]*Header    `json:"headers,omitempty"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// Header represents the response header.
+type Header struct {
+	Description string  `json:"description,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// MediaType represents the schema of the content of the specific media type.
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the reusable objects of the document.
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas,omitempty"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme represents the security scheme used by the operations.
+type SecurityScheme struct {
+	Type        string `json:"type"`
+	Scheme      string `json:"scheme,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Schema represents the subset of the OpenAPI schema object used to describe Go types.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+// JSON returns the media type map with the content of "application/json" type described by the schema.
+func JSON(schema *Schema) map[string]*MediaType {
+	return Content("application/json", schema)
+}
+
+// Content returns the media type map with the content of the media type described by the schema.
+func Content(mediaType string, schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{
+		mediaType: {Schema: schema},
+	}
+}
+
+// AddOperation adds the operation for the method on the path to the document.
+func (d *Document) AddOperation(method, path string, operation *Operation) {
+	if d.Paths == nil {
+		d.Paths = map[string]*PathItem{}
+	}
+
+	item, ok := d.Paths[path]
+	if !ok {
+		item = &PathItem{}
+		d.Paths[path] = item
+	}
+
+	switch method {
+	case "GET":
+		item.Get = operation
+	case "POST":
+		item.Post = operation
+	case "PUT":
+		item.Put = operation
+	case "DELETE":
+		item.Delete = operation
+	case "PATCH":
+		item.Patch = operation
+	}
+}
+
+// Operations returns the operations of the path item keyed by their methods.
+func (item *PathItem) Operations() map[string]*Operation {
+	operations := map[string]*Operation{}
+	for method, operation := range map[string]*Operation{
+		"GET":    item.Get,
+		"POST":   item.Post,
+		"PUT":    item.Put,
+		"DELETE": item.Delete,
+		"PATCH":  item.Patch,
+	} {
+		if operation != nil {
+			operations[method] = operation
+		}
+	}
+	return operations
+}
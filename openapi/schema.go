@@ -0,0 +1,175 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// Generator generates the schemas of Go types following the rules of the encoding/json package.
+// Named struct types become the components of the document referenced by their names,
+// so the schemas stay in sync with the models they are generated from.
+type Generator struct {
+	components map[string]*Schema
+	names      map[reflect.Type]string
+	formats    map[reflect.Type]*Schema
+}
+
+// NewGenerator creates a new schema generator.
+func NewGenerator() *Generator {
+	g := &Generator{
+		components: map[string]*Schema{},
+		names:      map[reflect.Type]string{},
+		formats:    map[reflect.Type]*Schema{},
+	}
+	g.Format(time.Time{}, Schema{Type: "string", Format: "date-time"})
+
+	return g
+}
+
+// Format sets the schema for the type of the value.
+// It's meant for the types with the custom JSON encoding the generator can't discover.
+func (g *Generator) Format(value interface{}, schema Schema) {
+	g.formats[reflect.TypeOf(value)] = &schema
+}
+
+// Schema returns the schema of the type of the value.
+// The value might be a nil pointer of the type, e.g. (*common.UserData)(nil).
+func (g *Generator) Schema(value interface{}) *Schema {
+	return g.schema(reflect.TypeOf(value))
+}
+
+// Components returns the schemas of the named struct types generated so far.
+func (g *Generator) Components() map[string]*Schema {
+	return g.components
+}
+
+// schema returns the schema of the type.
+func (g *Generator) schema(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if format, ok := g.formats[t]; ok {
+		schema := *format
+		return &schema
+	}
+	if t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType) {
+		// The encoding is unknown, so any value is allowed.
+		return &Schema{}
+	}
+	if t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType) {
+		return &Schema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: g.schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schema(t.Elem())}
+	case reflect.Struct:
+		if len(t.Name()) == 0 {
+			return g.object(t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + g.component(t)}
+	default:
+		// Interfaces might hold any value.
+		return &Schema{}
+	}
+}
+
+// component registers the named struct type as the component and returns its name.
+func (g *Generator) component(t reflect.Type) string {
+	if name, ok := g.names[t]; ok {
+		return name
+	}
+
+	name := t.Name()
+	if _, taken := g.components[name]; taken {
+		pkg := t.PkgPath()
+		name = strings.Title(pkg[strings.LastIndex(pkg, "/")+1:]) + name
+	}
+
+	// The name is registered before generating the schema in order to support recursive types.
+	g.names[t] = name
+	g.components[name] = &Schema{}
+	*g.components[name] = *g.object(t)
+
+	return name
+}
+
+// object returns the schema of the struct type.
+func (g *Generator) object(t reflect.Type) *Schema {
+	schema := &Schema{
+		Type:       "object",
+		Properties: map[string]*Schema{},
+	}
+	g.addFields(schema, t)
+
+	return schema
+}
+
+// addFields adds the JSON encoded fields of the struct type to the properties of the schema.
+func (g *Generator) addFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts := tag, ""
+		if idx := strings.Index(tag, ","); idx >= 0 {
+			name, opts = tag[:idx], tag[idx+1:]
+		}
+
+		if field.Anonymous && len(name) == 0 {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				g.addFields(schema, ft)
+				continue
+			}
+		}
+		if len(field.PkgPath) > 0 {
+			// Unexported field.
+			continue
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+
+		fieldSchema := g.schema(field.Type)
+		for _, opt := range strings.Split(opts, ",") {
+			if opt == "string" {
+				fieldSchema = &Schema{Type: "string", Format: fieldSchema.Format}
+			}
+		}
+
+		schema.Properties[name] = fieldSchema
+	}
+}
@@ -0,0 +1,102 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testMoney struct {
+	Amount string
+}
+
+func (m testMoney) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Amount)
+}
+
+type testBase struct {
+	ID      string `json:"id"`
+	Created time.Time
+}
+
+type testNode struct {
+	testBase
+	Name     string `json:"name,omitempty"`
+	Count    int64  `json:",string"`
+	Ratio    float64
+	Enabled  *bool
+	Data     []byte
+	Tags     []string
+	Labels   map[string]int
+	Children []*testNode
+	Price    testMoney
+	Any      interface{}
+	Inline   struct{ Note string }
+	Skipped  string `json:"-"`
+	internal string
+}
+
+func TestGeneratorSchema(t *testing.T) {
+	assert := assert.New(t)
+
+	g := NewGenerator()
+
+	assert.Equal(&Schema{Ref: "#/components/schemas/testNode"}, g.Schema((*testNode)(nil)))
+	assert.Equal(&Schema{Type: "array", Items: &Schema{Type: "string"}}, g.Schema([]string{}))
+
+	components := g.Components()
+
+	assert.Len(components, 1)
+	assert.Equal(&Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"id":       {Type: "string"},
+			"Created":  {Type: "string", Format: "date-time"},
+			"name":     {Type: "string"},
+			"Count":    {Type: "string", Format: "int64"},
+			"Ratio":    {Type: "number", Format: "double"},
+			"Enabled":  {Type: "boolean"},
+			"Data":     {Type: "string", Format: "byte"},
+			"Tags":     {Type: "array", Items: &Schema{Type: "string"}},
+			"Labels":   {Type: "object", AdditionalProperties: &Schema{Type: "integer", Format: "int32"}},
+			"Children": {Type: "array", Items: &Schema{Ref: "#/components/schemas/testNode"}},
+			"Price":    {},
+			"Any":      {},
+			"Inline": {
+				Type:       "object",
+				Properties: map[string]*Schema{"Note": {Type: "string"}},
+			},
+		},
+	}, components["testNode"])
+}
+
+func TestGeneratorFormat(t *testing.T) {
+	assert := assert.New(t)
+
+	g := NewGenerator()
+	g.Format(testMoney{}, Schema{Type: "string", Format: "decimal"})
+
+	assert.Equal(&Schema{Type: "string", Format: "decimal"}, g.Schema(testMoney{}))
+	assert.Equal(&Schema{Type: "string", Format: "decimal"}, g.Schema(&testMoney{}))
+
+	// The returned schemas are copies of the format.
+	g.Schema(testMoney{}).Description = "changed"
+
+	assert.Empty(g.Schema(testMoney{}).Description)
+}
+
+func TestDocumentAddOperation(t *testing.T) {
+	assert := assert.New(t)
+
+	doc := &Document{}
+	get := &Operation{OperationID: "get"}
+	post := &Operation{OperationID: "post"}
+
+	doc.AddOperation("GET", "/items", get)
+	doc.AddOperation("POST", "/items", post)
+
+	assert.Len(doc.Paths, 1)
+	assert.Equal(map[string]*Operation{"GET": get, "POST": post}, doc.Paths["/items"].Operations())
+}
@@ -0,0 +1,239 @@
+package ownership
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// bitcoinMessageMagic is the prefix of every message signed with the legacy Bitcoin scheme.
+const bitcoinMessageMagic = "Bitcoin Signed Message:\n"
+
+// bip322Tag is the tag of the BIP-322 message hash.
+const bip322Tag = "BIP0322-signed-message"
+
+// maxWitnessItemSize limits the size of a witness stack item of a BIP-322 signature.
+const maxWitnessItemSize = 10000
+
+// bitcoinNets enumerates the networks a Bitcoin address is checked against.
+var bitcoinNets = []*chaincfg.Params{
+	&chaincfg.MainNetParams,
+	&chaincfg.TestNet3Params,
+}
+
+// decodeBitcoinAddress decodes the address trying all supported networks.
+func decodeBitcoinAddress(address string) (addr btcutil.Address, net *chaincfg.Params, err error) {
+	for _, net = range bitcoinNets {
+		addr, err = btcutil.DecodeAddress(address, net)
+		if err == nil && addr.IsForNet(net) {
+			return
+		}
+	}
+	if err == nil {
+		err = errors.New("unknown network")
+	}
+	err = fmt.Errorf("invalid Bitcoin address: %s", err)
+
+	return
+}
+
+// verifyBitcoinMessage verifies the legacy "signmessage" signature of the message.
+// The signature is the base64 encoded compact signature with the public key recovery header.
+func verifyBitcoinMessage(address, message, signature string) (err error) {
+	addr, net, err := decodeBitcoinAddress(address)
+	if err != nil {
+		return
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("malformed signature: %s", err)
+	}
+	if len(sig) != 65 {
+		return errors.New("malformed signature: invalid length")
+	}
+
+	// BIP-137 headers 35-42 are used for segwit addresses, the recovery itself is the same as for compressed keys.
+	switch header := sig[0]; {
+	case header >= 27 && header <= 34:
+	case header >= 35 && header <= 42:
+		sig[0] = 31 + (header-27)%4
+	default:
+		return errors.New("malformed signature: invalid recovery header")
+	}
+
+	pubKey, compressed, err := btcec.RecoverCompact(btcec.S256(), sig, bitcoinMessageHash(message))
+	if err != nil {
+		return ErrSignatureMismatch
+	}
+
+	candidates, err := pubKeyAddresses(pubKey, compressed, net)
+	if err != nil {
+		return
+	}
+	for _, candidate := range candidates {
+		if candidate.EncodeAddress() == addr.EncodeAddress() {
+			return nil
+		}
+	}
+
+	return ErrSignatureMismatch
+}
+
+// bitcoinMessageHash returns the hash of the message signed with the legacy Bitcoin scheme.
+func bitcoinMessageHash(message string) []byte {
+	buf := &bytes.Buffer{}
+	wire.WriteVarString(buf, 0, bitcoinMessageMagic)
+	wire.WriteVarString(buf, 0, message)
+
+	return chainhash.DoubleHashB(buf.Bytes())
+}
+
+// pubKeyAddresses returns all single key addresses the public key might be represented by.
+func pubKeyAddresses(pubKey *btcec.PublicKey, compressed bool, net *chaincfg.Params) (addrs []btcutil.Address, err error) {
+	if !compressed {
+		addr, err := btcutil.NewAddressPubKeyHash(btcutil.Hash160(pubKey.SerializeUncompressed()), net)
+		if err != nil {
+			return nil, err
+		}
+		return []btcutil.Address{addr}, nil
+	}
+
+	pkHash := btcutil.Hash160(pubKey.SerializeCompressed())
+
+	p2pkh, err := btcutil.NewAddressPubKeyHash(pkHash, net)
+	if err != nil {
+		return
+	}
+	p2wpkh, err := btcutil.NewAddressWitnessPubKeyHash(pkHash, net)
+	if err != nil {
+		return
+	}
+	redeemScript := append([]byte{txscript.OP_0, txscript.OP_DATA_20}, pkHash...)
+	p2shp2wpkh, err := btcutil.NewAddressScriptHash(redeemScript, net)
+	if err != nil {
+		return
+	}
+
+	addrs = []btcutil.Address{p2pkh, p2wpkh, p2shp2wpkh}
+
+	return
+}
+
+// verifyBIP322 verifies the BIP-322 "simple" signature of the message.
+// The signature is the base64 encoded witness stack of the virtual "to_sign" transaction.
+func verifyBIP322(address, message, signature string) (err error) {
+	addr, _, err := decodeBitcoinAddress(address)
+	if err != nil {
+		return
+	}
+
+	switch addr.(type) {
+	case *btcutil.AddressWitnessPubKeyHash, *btcutil.AddressWitnessScriptHash:
+	default:
+		return errors.New("BIP-322 simple signatures are supported for native segwit addresses only")
+	}
+
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return
+	}
+
+	witness, err := decodeWitness(signature)
+	if err != nil {
+		return fmt.Errorf("malformed signature: %s", err)
+	}
+
+	toSign := bip322ToSign(bip322ToSpend(message, pkScript), witness)
+
+	vm, err := txscript.NewEngine(pkScript, toSign, 0, txscript.StandardVerifyFlags, nil, txscript.NewTxSigHashes(toSign), 0)
+	if err != nil {
+		return
+	}
+	if err = vm.Execute(); err != nil {
+		return ErrSignatureMismatch
+	}
+
+	return
+}
+
+// bip322MessageHash returns the tagged hash of the message as defined by BIP-322.
+func bip322MessageHash(message string) []byte {
+	tag := sha256.Sum256([]byte(bip322Tag))
+
+	h := sha256.New()
+	h.Write(tag[:])
+	h.Write(tag[:])
+	h.Write([]byte(message))
+
+	return h.Sum(nil)
+}
+
+// bip322ToSpend constructs the virtual "to_spend" transaction committing to the message.
+func bip322ToSpend(message string, pkScript []byte) *wire.MsgTx {
+	scriptSig := append([]byte{txscript.OP_0, txscript.OP_DATA_32}, bip322MessageHash(message)...)
+
+	in := wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, wire.MaxPrevOutIndex), scriptSig, nil)
+	in.Sequence = 0
+
+	tx := wire.NewMsgTx(0)
+	tx.AddTxIn(in)
+	tx.AddTxOut(wire.NewTxOut(0, pkScript))
+
+	return tx
+}
+
+// bip322ToSign constructs the virtual "to_sign" transaction spending the "to_spend" one.
+func bip322ToSign(toSpend *wire.MsgTx, witness wire.TxWitness) *wire.MsgTx {
+	hash := toSpend.TxHash()
+
+	in := wire.NewTxIn(wire.NewOutPoint(&hash, 0), nil, witness)
+	in.Sequence = 0
+
+	tx := wire.NewMsgTx(0)
+	tx.AddTxIn(in)
+	tx.AddTxOut(wire.NewTxOut(0, []byte{txscript.OP_RETURN}))
+
+	return tx
+}
+
+// decodeWitness decodes the base64 encoded serialized witness stack.
+func decodeWitness(signature string) (witness wire.TxWitness, err error) {
+	raw, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return
+	}
+
+	r := bytes.NewReader(raw)
+
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return
+	}
+	if count == 0 || count > uint64(len(raw)) {
+		err = errors.New("invalid witness stack size")
+		return
+	}
+
+	witness = make(wire.TxWitness, count)
+	for i := range witness {
+		witness[i], err = wire.ReadVarBytes(r, 0, maxWitnessItemSize, "witness item")
+		if err != nil {
+			return
+		}
+	}
+	if r.Len() > 0 {
+		err = errors.New("unexpected trailing data")
+	}
+
+	return
+}
@@ -0,0 +1,266 @@
+package ownership
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"modulus/kyc/common"
+)
+
+// statement is the human readable part of every challenge message.
+const statement = "I confirm that I control this wallet address and agree to the verification of its ownership."
+
+// Challenge represents a message the customer has to sign with the wallet key to prove the address ownership.
+// The ID carries the signed challenge fields, so any store sharing the key can take the challenge.
+type Challenge struct {
+	ID         string
+	CustomerID string `json:",omitempty"`
+	Chain      common.Blockchain
+	Address    string
+	Nonce      string
+	Message    string
+	TypedData  *TypedData `json:",omitempty"`
+	IssuedAt   time.Time
+	ExpiresAt  time.Time
+}
+
+// challengeClaims holds the challenge fields signed into the challenge id.
+type challengeClaims struct {
+	CustomerID string            `json:"u,omitempty"`
+	Chain      common.Blockchain `json:"c"`
+	Address    string            `json:"a"`
+	Nonce      string            `json:"n"`
+	IssuedAt   int64             `json:"i"`
+	ExpiresAt  int64             `json:"e"`
+}
+
+// Store issues challenges signed by the HMAC key and takes them back by the ids.
+// The challenges aren't kept, so the stores of different processes sharing the key accept the challenges
+// issued by each other, only the ids of the taken challenges are kept until they expire.
+// It's safe for concurrent use.
+type Store struct {
+	ttl  time.Duration
+	key  []byte
+	mu   sync.Mutex
+	used map[string]time.Time
+}
+
+// NewStore constructs a new challenges store with the specified lifetime of challenges and the HMAC key
+// signing them. The random key is generated if the key is empty, then the challenges are accepted
+// by this store only.
+func NewStore(ttl time.Duration, key []byte) *Store {
+	if len(key) == 0 {
+		key = make([]byte, sha256.Size)
+		if _, err := rand.Read(key); err != nil {
+			panic(err)
+		}
+	}
+
+	return &Store{
+		ttl:  ttl,
+		key:  key,
+		used: map[string]time.Time{},
+	}
+}
+
+// Issue creates a new challenge for the customer to prove the ownership of the specified wallet address.
+func (s *Store) Issue(customerID string, chain common.Blockchain, address string) (challenge Challenge, err error) {
+	if err = validateAddress(chain, address); err != nil {
+		return
+	}
+
+	nonce := make([]byte, 16)
+	if _, err = rand.Read(nonce); err != nil {
+		return
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	claims := challengeClaims{
+		CustomerID: customerID,
+		Chain:      chain,
+		Address:    address,
+		Nonce:      hex.EncodeToString(nonce),
+		IssuedAt:   now.Unix(),
+		ExpiresAt:  now.Add(s.ttl).Unix(),
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return
+	}
+
+	challenge = s.challenge(claims)
+	challenge.ID = base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(s.sign(payload))
+
+	return
+}
+
+// Peek checks the signature of the challenge id and returns the challenge it carries without using it up.
+// It fails the same way Take does, so the proof might be verified before the challenge is taken.
+func (s *Store) Peek(id string) (challenge Challenge, err error) {
+	if challenge, err = s.parse(id); err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err = s.check(challenge, time.Now())
+
+	return
+}
+
+// Take checks the signature of the challenge id and returns the challenge it carries.
+// Every challenge might be used only once.
+func (s *Store) Take(id string) (challenge Challenge, err error) {
+	if challenge, err = s.parse(id); err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err = s.check(challenge, time.Now()); err != nil {
+		return
+	}
+	s.used[id] = challenge.ExpiresAt
+
+	return
+}
+
+// parse checks the signature of the challenge id and restores the challenge it carries.
+func (s *Store) parse(id string) (challenge Challenge, err error) {
+	parts := strings.Split(id, ".")
+	if len(parts) != 2 {
+		err = ErrChallengeNotFound
+		return
+	}
+	payload, err1 := base64.RawURLEncoding.DecodeString(parts[0])
+	signature, err2 := base64.RawURLEncoding.DecodeString(parts[1])
+	if err1 != nil || err2 != nil || !hmac.Equal(signature, s.sign(payload)) {
+		err = ErrChallengeNotFound
+		return
+	}
+
+	claims := challengeClaims{}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		err = ErrChallengeNotFound
+		return
+	}
+	challenge = s.challenge(claims)
+	challenge.ID = id
+
+	return
+}
+
+// check fails if the challenge has been used or has expired. The caller must hold the lock.
+func (s *Store) check(challenge Challenge, now time.Time) error {
+	s.removeExpired(now)
+	if _, ok := s.used[challenge.ID]; ok {
+		return ErrChallengeNotFound
+	}
+	if now.After(challenge.ExpiresAt) {
+		return ErrChallengeExpired
+	}
+
+	return nil
+}
+
+// challenge restores the challenge from the signed fields.
+func (s *Store) challenge(claims challengeClaims) (challenge Challenge) {
+	challenge = Challenge{
+		CustomerID: claims.CustomerID,
+		Chain:      claims.Chain,
+		Address:    claims.Address,
+		Nonce:      claims.Nonce,
+		IssuedAt:   time.Unix(claims.IssuedAt, 0).UTC(),
+		ExpiresAt:  time.Unix(claims.ExpiresAt, 0).UTC(),
+	}
+	challenge.Message = challenge.message()
+	if challenge.Chain == common.Ethereum {
+		challenge.TypedData = challenge.typedData()
+	}
+
+	return
+}
+
+// sign computes the HMAC-SHA256 of the challenge payload.
+func (s *Store) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// removeExpired drops the ids of expired challenges from the store. The caller must hold the lock.
+func (s *Store) removeExpired(now time.Time) {
+	for id, expiresAt := range s.used {
+		if now.After(expiresAt) {
+			delete(s.used, id)
+		}
+	}
+}
+
+// message composes the text of the challenge to sign.
+func (c Challenge) message() string {
+	return fmt.Sprintf("%s\n\nAddress: %s\nNonce: %s\nIssued At: %s",
+		statement,
+		c.Address,
+		c.Nonce,
+		c.IssuedAt.Format(time.RFC3339),
+	)
+}
+
+// typedData composes EIP-712 typed data of the challenge to sign with Ethereum wallets.
+func (c Challenge) typedData() *TypedData {
+	return &TypedData{
+		Types: map[string][]TypedDataField{
+			eip712DomainType: {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+			},
+			"Challenge": {
+				{Name: "address", Type: "address"},
+				{Name: "statement", Type: "string"},
+				{Name: "nonce", Type: "string"},
+				{Name: "issuedAt", Type: "string"},
+			},
+		},
+		PrimaryType: "Challenge",
+		Domain: map[string]interface{}{
+			"name":    "KYC Wallet Ownership",
+			"version": "1",
+		},
+		Message: map[string]interface{}{
+			"address":   c.Address,
+			"statement": statement,
+			"nonce":     c.Nonce,
+			"issuedAt":  c.IssuedAt.Format(time.RFC3339),
+		},
+	}
+}
+
+// validateAddress checks the format of the wallet address for the chain.
+func validateAddress(chain common.Blockchain, address string) (err error) {
+	if len(address) == 0 {
+		return fmt.Errorf("empty wallet address")
+	}
+
+	switch chain {
+	case common.Bitcoin:
+		_, _, err = decodeBitcoinAddress(address)
+	case common.Ethereum:
+		err = validateEthereumAddress(address)
+	default:
+		err = fmt.Errorf("unsupported blockchain: %s", chain)
+	}
+
+	return
+}
@@ -0,0 +1,32 @@
+package ownership
+
+import (
+	"errors"
+	"time"
+)
+
+// DefaultChallengeTTL holds the default lifetime of an issued challenge.
+const DefaultChallengeTTL = 10 * time.Minute
+
+// List of supported Scheme values.
+const (
+	// BitcoinMessage is the legacy Bitcoin Core "signmessage" scheme.
+	// BIP-137 headers for P2SH-P2WPKH and P2WPKH addresses are accepted as well.
+	BitcoinMessage Scheme = "signmessage"
+	// BIP322 is the BIP-322 "simple" signature scheme for segwit addresses.
+	BIP322 Scheme = "bip322"
+	// PersonalSign is the Ethereum "personal_sign" (EIP-191) scheme.
+	PersonalSign Scheme = "personal_sign"
+	// EIP712 is the Ethereum typed structured data signing scheme ("eth_signTypedData_v4").
+	EIP712 Scheme = "eip712"
+)
+
+// Scheme represents a message signing scheme.
+type Scheme string
+
+// List of errors returned by the package.
+var (
+	ErrChallengeNotFound = errors.New("ownership challenge not found or already used")
+	ErrChallengeExpired  = errors.New("ownership challenge expired")
+	ErrSignatureMismatch = errors.New("signature doesn't match the wallet address")
+)
@@ -0,0 +1,269 @@
+package ownership
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// eip712DomainType is the name of the EIP-712 domain type.
+const eip712DomainType = "EIP712Domain"
+
+// TypedData represents EIP-712 typed structured data in the form accepted by "eth_signTypedData_v4".
+type TypedData struct {
+	Types       map[string][]TypedDataField `json:"types"`
+	PrimaryType string                      `json:"primaryType"`
+	Domain      map[string]interface{}      `json:"domain"`
+	Message     map[string]interface{}      `json:"message"`
+}
+
+// TypedDataField represents a member of a struct type of the typed data.
+type TypedDataField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Hash returns the EIP-712 signing hash of the typed data.
+func (td TypedData) Hash() ([]byte, error) {
+	if _, ok := td.Types[td.PrimaryType]; !ok {
+		return nil, fmt.Errorf("unknown primary type: %s", td.PrimaryType)
+	}
+
+	domain, err := td.hashStruct(eip712DomainType, td.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("hashing domain: %s", err)
+	}
+
+	message, err := td.hashStruct(td.PrimaryType, td.Message)
+	if err != nil {
+		return nil, fmt.Errorf("hashing message: %s", err)
+	}
+
+	return keccak256([]byte{0x19, 0x01}, domain, message), nil
+}
+
+// hashStruct implements EIP-712 hashStruct function.
+func (td TypedData) hashStruct(typ string, data map[string]interface{}) ([]byte, error) {
+	encoded, err := td.encodeData(typ, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return keccak256(encoded), nil
+}
+
+// encodeData implements EIP-712 encodeData function prefixed with the type hash.
+func (td TypedData) encodeData(typ string, data map[string]interface{}) ([]byte, error) {
+	fields, ok := td.Types[typ]
+	if !ok {
+		return nil, fmt.Errorf("unknown type: %s", typ)
+	}
+
+	encoded := keccak256([]byte(td.encodeType(typ)))
+	for _, field := range fields {
+		value, err := td.encodeValue(field.Type, data[field.Name])
+		if err != nil {
+			return nil, fmt.Errorf("field '%s': %s", field.Name, err)
+		}
+		encoded = append(encoded, value...)
+	}
+
+	return encoded, nil
+}
+
+// encodeType implements EIP-712 encodeType function.
+func (td TypedData) encodeType(primary string) string {
+	deps := map[string]bool{}
+	td.dependencies(primary, deps)
+	delete(deps, primary)
+
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	b := strings.Builder{}
+	for _, name := range append([]string{primary}, names...) {
+		b.WriteString(name)
+		b.WriteByte('(')
+		for i, field := range td.Types[name] {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(field.Type)
+			b.WriteByte(' ')
+			b.WriteString(field.Name)
+		}
+		b.WriteByte(')')
+	}
+
+	return b.String()
+}
+
+// dependencies collects all struct types referenced by the type including itself.
+func (td TypedData) dependencies(typ string, found map[string]bool) {
+	typ = baseType(typ)
+	if found[typ] {
+		return
+	}
+	if _, ok := td.Types[typ]; !ok {
+		return
+	}
+
+	found[typ] = true
+	for _, field := range td.Types[typ] {
+		td.dependencies(field.Type, found)
+	}
+}
+
+// encodeValue encodes a single value of the specified type into 32 bytes.
+func (td TypedData) encodeValue(typ string, value interface{}) ([]byte, error) {
+	if strings.HasSuffix(typ, "]") {
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, errors.New("array expected")
+		}
+		itemType := typ[:strings.LastIndex(typ, "[")]
+		encoded := []byte{}
+		for _, item := range items {
+			v, err := td.encodeValue(itemType, item)
+			if err != nil {
+				return nil, err
+			}
+			encoded = append(encoded, v...)
+		}
+		return keccak256(encoded), nil
+	}
+
+	if _, ok := td.Types[typ]; ok {
+		data, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("object expected")
+		}
+		return td.hashStruct(typ, data)
+	}
+
+	switch {
+	case typ == "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, errors.New("string expected")
+		}
+		return keccak256([]byte(s)), nil
+	case typ == "bytes":
+		b, err := hexBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		return keccak256(b), nil
+	case typ == "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, errors.New("boolean expected")
+		}
+		encoded := make([]byte, 32)
+		if b {
+			encoded[31] = 1
+		}
+		return encoded, nil
+	case typ == "address":
+		b, err := hexBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) != 20 {
+			return nil, errors.New("invalid address length")
+		}
+		return leftPad(b), nil
+	case strings.HasPrefix(typ, "bytes"):
+		size, err := strconv.Atoi(typ[len("bytes"):])
+		if err != nil || size < 1 || size > 32 {
+			return nil, fmt.Errorf("unsupported type: %s", typ)
+		}
+		b, err := hexBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) != size {
+			return nil, fmt.Errorf("%d bytes expected", size)
+		}
+		encoded := make([]byte, 32)
+		copy(encoded, b)
+		return encoded, nil
+	case strings.HasPrefix(typ, "uint"), strings.HasPrefix(typ, "int"):
+		n, err := bigInt(value)
+		if err != nil {
+			return nil, err
+		}
+		if n.Sign() < 0 {
+			if strings.HasPrefix(typ, "uint") {
+				return nil, errors.New("unsigned integer expected")
+			}
+			// Two's complement representation of the negative value.
+			n = new(big.Int).Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+		}
+		if n.BitLen() > 256 {
+			return nil, errors.New("integer overflow")
+		}
+		return leftPad(n.Bytes()), nil
+	}
+
+	return nil, fmt.Errorf("unsupported type: %s", typ)
+}
+
+// baseType strips array dimensions from the type.
+func baseType(typ string) string {
+	if i := strings.Index(typ, "["); i >= 0 {
+		return typ[:i]
+	}
+	return typ
+}
+
+// hexBytes decodes 0x-prefixed hex string value.
+func hexBytes(value interface{}) ([]byte, error) {
+	s, ok := value.(string)
+	if !ok || !strings.HasPrefix(s, "0x") {
+		return nil, errors.New("0x-prefixed hex string expected")
+	}
+	return hex.DecodeString(s[2:])
+}
+
+// bigInt converts a JSON number, a decimal or a 0x-prefixed hex string value into the integer.
+func bigInt(value interface{}) (*big.Int, error) {
+	switch v := value.(type) {
+	case float64:
+		n, accuracy := big.NewFloat(v).Int(nil)
+		if accuracy != big.Exact {
+			return nil, errors.New("integer expected")
+		}
+		return n, nil
+	case json.Number:
+		return bigInt(string(v))
+	case string:
+		n, ok := new(big.Int), false
+		if strings.HasPrefix(v, "0x") {
+			n, ok = n.SetString(v[2:], 16)
+		} else {
+			n, ok = n.SetString(v, 10)
+		}
+		if !ok {
+			return nil, errors.New("integer expected")
+		}
+		return n, nil
+	}
+
+	return nil, errors.New("integer expected")
+}
+
+// leftPad pads the value with zeros up to 32 bytes.
+func leftPad(b []byte) []byte {
+	encoded := make([]byte, 32)
+	copy(encoded[32-len(b):], b)
+	return encoded
+}
@@ -0,0 +1,117 @@
+package ownership
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"golang.org/x/crypto/sha3"
+)
+
+// ethereumAddressRe describes the format of an Ethereum address.
+var ethereumAddressRe = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
+// validateEthereumAddress checks the address format and its EIP-55 checksum if the address is mixed-case.
+func validateEthereumAddress(address string) error {
+	if !ethereumAddressRe.MatchString(address) {
+		return errors.New("invalid Ethereum address")
+	}
+
+	hexpart := address[2:]
+	if hexpart == strings.ToLower(hexpart) || hexpart == strings.ToUpper(hexpart) {
+		return nil
+	}
+
+	raw, _ := hex.DecodeString(hexpart)
+	if checksumAddress(raw) != address {
+		return errors.New("invalid Ethereum address checksum")
+	}
+
+	return nil
+}
+
+// checksumAddress returns EIP-55 mixed-case representation of the address.
+func checksumAddress(addr []byte) string {
+	lower := hex.EncodeToString(addr)
+	hash := hex.EncodeToString(keccak256([]byte(lower)))
+
+	b := []byte(lower)
+	for i, c := range b {
+		if c >= 'a' && hash[i] >= '8' {
+			b[i] = c - 'a' + 'A'
+		}
+	}
+
+	return "0x" + string(b)
+}
+
+// personalSignHash returns the hash of the message signed with the "personal_sign" method.
+func personalSignHash(message string) []byte {
+	prefix := fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(message))
+	return keccak256([]byte(prefix), []byte(message))
+}
+
+// verifyEthereumSignature checks that the signature of the hash was produced by the address key.
+// The signature is the hex encoded 65 bytes of R, S and V values.
+func verifyEthereumSignature(address string, hash []byte, signature string) (err error) {
+	if err = validateEthereumAddress(address); err != nil {
+		return
+	}
+
+	signer, err := recoverEthereumAddress(hash, signature)
+	if err != nil {
+		return
+	}
+
+	if !strings.EqualFold(signer, address) {
+		return ErrSignatureMismatch
+	}
+
+	return
+}
+
+// recoverEthereumAddress recovers the address of the signer of the hash.
+func recoverEthereumAddress(hash []byte, signature string) (address string, err error) {
+	sig, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil {
+		err = fmt.Errorf("malformed signature: %s", err)
+		return
+	}
+	if len(sig) != 65 {
+		err = errors.New("malformed signature: invalid length")
+		return
+	}
+
+	v := sig[64]
+	if v >= 27 {
+		v -= 27
+	}
+	if v > 1 {
+		err = errors.New("malformed signature: invalid recovery id")
+		return
+	}
+
+	compact := append([]byte{27 + v}, sig[:64]...)
+
+	pubKey, _, err := btcec.RecoverCompact(btcec.S256(), compact, hash)
+	if err != nil {
+		err = ErrSignatureMismatch
+		return
+	}
+
+	address = checksumAddress(keccak256(pubKey.SerializeUncompressed()[1:])[12:])
+
+	return
+}
+
+// keccak256 returns Keccak-256 hash of the concatenated data.
+func keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
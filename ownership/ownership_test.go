@@ -0,0 +1,260 @@
+package ownership
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"modulus/kyc/common"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	bip322Address  = "bc1q9vza2e8x573nczrlzms0wvx3gsqjx7vavgkx0l"
+	bip322EmptySig = "AkcwRAIgM2gBAQqvZX15ZiysmKmQpDrG83avLIT492QBzLnQIxYCIBaTpOaD20qRlEylyxFSeEA2ba9YOixpX8z46TSDtS40ASECx/EgAxlkQpQ9hYjgGu6EBCPMVPwVIVJqO4XCsMvViHI="
+	bip322HelloSig = "AkcwRAIgZRfIY3p7/DoVTty6YZbWS71bc5Vct9p9Fia83eRmw2QCICK/ENGfwLtptFluMGs2KsqoNSk89pO7F29zJLUx9a/sASECx/EgAxlkQpQ9hYjgGu6EBCPMVPwVIVJqO4XCsMvViHI="
+)
+
+func TestStore(t *testing.T) {
+	assert := assert.New(t)
+
+	store := NewStore(time.Minute, []byte("key"))
+
+	_, err := store.Issue("", common.Bitcoin, "")
+	assert.EqualError(err, "empty wallet address")
+
+	_, err = store.Issue("", common.Bitcoin, "bc1qinvalid")
+	assert.Error(err)
+
+	_, err = store.Issue("", common.Ethereum, "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD827")
+	assert.EqualError(err, "invalid Ethereum address checksum")
+
+	_, err = store.Issue("", "XRP", "rAddress")
+	assert.EqualError(err, "unsupported blockchain: XRP")
+
+	challenge, err := store.Issue("", common.Bitcoin, bip322Address)
+	assert.NoError(err)
+	assert.NotEmpty(challenge.ID)
+	assert.Len(challenge.Nonce, 32)
+	assert.Contains(challenge.Message, bip322Address)
+	assert.Contains(challenge.Message, challenge.Nonce)
+	assert.Nil(challenge.TypedData)
+	assert.Equal(time.Minute, challenge.ExpiresAt.Sub(challenge.IssuedAt))
+
+	// The challenge might be peeked until it's taken.
+	for i := 0; i < 2; i++ {
+		peeked, err := store.Peek(challenge.ID)
+		assert.NoError(err)
+		assert.Equal(challenge, peeked)
+	}
+
+	taken, err := store.Take(challenge.ID)
+	assert.NoError(err)
+	assert.Equal(challenge, taken)
+
+	_, err = store.Take(challenge.ID)
+	assert.Equal(ErrChallengeNotFound, err)
+
+	_, err = store.Peek(challenge.ID)
+	assert.Equal(ErrChallengeNotFound, err)
+
+	// The challenge is accepted by the stores sharing the key only.
+	challenge, err = store.Issue("customer", common.Bitcoin, bip322Address)
+	assert.NoError(err)
+	assert.Equal("customer", challenge.CustomerID)
+
+	_, err = NewStore(time.Minute, []byte("other key")).Take(challenge.ID)
+	assert.Equal(ErrChallengeNotFound, err)
+
+	_, err = store.Take(strings.Replace(challenge.ID, ".", "x.", 1))
+	assert.Equal(ErrChallengeNotFound, err)
+
+	taken, err = NewStore(time.Minute, []byte("key")).Take(challenge.ID)
+	assert.NoError(err)
+	assert.Equal(challenge, taken)
+
+	challenge, err = store.Issue("", common.Ethereum, "0xcd2a3d9f938e13cd947ec05abc7fe734df8dd826")
+	assert.NoError(err)
+	assert.NotNil(challenge.TypedData)
+	assert.Equal(challenge.Address, challenge.TypedData.Message["address"])
+
+	expiring := NewStore(-time.Second, nil)
+	challenge, err = expiring.Issue("", common.Ethereum, "0xcd2a3d9f938e13cd947ec05abc7fe734df8dd826")
+	assert.NoError(err)
+
+	_, err = expiring.Peek(challenge.ID)
+	assert.Equal(ErrChallengeExpired, err)
+
+	_, err = expiring.Take(challenge.ID)
+	assert.Equal(ErrChallengeExpired, err)
+}
+
+func TestVerifyBitcoinMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	if !assert.NoError(err) {
+		return
+	}
+
+	pkHash := btcutil.Hash160(key.PubKey().SerializeCompressed())
+	p2pkh, _ := btcutil.NewAddressPubKeyHash(pkHash, &chaincfg.MainNetParams)
+	p2wpkh, _ := btcutil.NewAddressWitnessPubKeyHash(pkHash, &chaincfg.MainNetParams)
+
+	message := "Hello World"
+
+	sig, err := btcec.SignCompact(btcec.S256(), key, bitcoinMessageHash(message), true)
+	if !assert.NoError(err) {
+		return
+	}
+	signature := base64.StdEncoding.EncodeToString(sig)
+
+	assert.NoError(verifyBitcoinMessage(p2pkh.EncodeAddress(), message, signature))
+	assert.NoError(verifyBitcoinMessage(p2wpkh.EncodeAddress(), message, signature))
+	assert.Equal(ErrSignatureMismatch, verifyBitcoinMessage(p2pkh.EncodeAddress(), "Goodbye World", signature))
+	assert.Equal(ErrSignatureMismatch, verifyBitcoinMessage(bip322Address, message, signature))
+
+	// BIP-137 segwit header.
+	sig[0] += 8
+	assert.NoError(verifyBitcoinMessage(p2wpkh.EncodeAddress(), message, base64.StdEncoding.EncodeToString(sig)))
+
+	assert.EqualError(verifyBitcoinMessage(p2pkh.EncodeAddress(), message, "c2lnbmF0dXJl"), "malformed signature: invalid length")
+}
+
+func TestBIP322(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("c90c269c4f8fcbe6880f72a721ddfbf1914268a794cbb21cfafee13770ae19f1", hex.EncodeToString(bip322MessageHash("")))
+	assert.Equal("f0eb03b1a75ac6d9847f55c624a99169b5dccba2a31f5b23bea77ba270de0a7a", hex.EncodeToString(bip322MessageHash("Hello World")))
+
+	assert.NoError(verifyBIP322(bip322Address, "", bip322EmptySig))
+	assert.NoError(verifyBIP322(bip322Address, "Hello World", bip322HelloSig))
+	assert.Equal(ErrSignatureMismatch, verifyBIP322(bip322Address, "", bip322HelloSig))
+	assert.Equal(ErrSignatureMismatch, verifyBIP322(bip322Address, "Hello World", bip322EmptySig))
+
+	assert.EqualError(verifyBIP322("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "", bip322EmptySig), "BIP-322 simple signatures are supported for native segwit addresses only")
+}
+
+func TestEIP712(t *testing.T) {
+	assert := assert.New(t)
+
+	td := TypedData{
+		Types: map[string][]TypedDataField{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Person": {
+				{Name: "name", Type: "string"},
+				{Name: "wallet", Type: "address"},
+			},
+			"Mail": {
+				{Name: "from", Type: "Person"},
+				{Name: "to", Type: "Person"},
+				{Name: "contents", Type: "string"},
+			},
+		},
+		PrimaryType: "Mail",
+		Domain: map[string]interface{}{
+			"name":              "Ether Mail",
+			"version":           "1",
+			"chainId":           float64(1),
+			"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+		},
+		Message: map[string]interface{}{
+			"from": map[string]interface{}{
+				"name":   "Cow",
+				"wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+			},
+			"to": map[string]interface{}{
+				"name":   "Bob",
+				"wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+			},
+			"contents": "Hello, Bob!",
+		},
+	}
+
+	assert.Equal("Mail(Person from,Person to,string contents)Person(string name,address wallet)", td.encodeType("Mail"))
+
+	hash, err := td.Hash()
+	assert.NoError(err)
+	assert.Equal("be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hex.EncodeToString(hash))
+
+	signature := "0x4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b915621c"
+
+	signer, err := recoverEthereumAddress(hash, signature)
+	assert.NoError(err)
+	assert.Equal("0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826", signer)
+
+	td.PrimaryType = "Letter"
+	_, err = td.Hash()
+	assert.EqualError(err, "unknown primary type: Letter")
+}
+
+func TestVerify(t *testing.T) {
+	assert := assert.New(t)
+
+	raw, _ := hex.DecodeString("c85ef7d79691fe79573b1a7064c19c1a9819ebdbd1faaab1a8ec92344438aaf4")
+	key, _ := btcec.PrivKeyFromBytes(btcec.S256(), raw)
+
+	store := NewStore(DefaultChallengeTTL, nil)
+
+	challenge, err := store.Issue("", common.Ethereum, "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826")
+	if !assert.NoError(err) {
+		return
+	}
+
+	proof, err := Verify(challenge, "", ethereumSign(key, personalSignHash(challenge.Message)))
+	if assert.NoError(err) && assert.NotNil(proof) {
+		assert.Equal(common.Ethereum, proof.Chain)
+		assert.Equal(challenge.Address, proof.Address)
+		assert.Equal(string(PersonalSign), proof.Scheme)
+		assert.Equal(challenge.ID, proof.ChallengeID)
+		assert.False(proof.VerifiedAt.IsZero())
+	}
+
+	hash, err := challenge.TypedData.Hash()
+	if !assert.NoError(err) {
+		return
+	}
+
+	proof, err = Verify(challenge, EIP712, ethereumSign(key, hash))
+	assert.NoError(err)
+	assert.NotNil(proof)
+
+	proof, err = Verify(challenge, EIP712, ethereumSign(key, personalSignHash(challenge.Message)))
+	assert.Equal(ErrSignatureMismatch, err)
+	assert.Nil(proof)
+
+	_, err = Verify(challenge, BIP322, "signature")
+	assert.EqualError(err, "unsupported signature scheme for ETH: bip322")
+
+	_, err = Verify(challenge, "", "")
+	assert.EqualError(err, "empty signature")
+
+	challenge = Challenge{
+		ID:      "id",
+		Chain:   common.Bitcoin,
+		Address: bip322Address,
+		Message: "Hello World",
+	}
+
+	proof, err = Verify(challenge, "", bip322HelloSig)
+	if assert.NoError(err) && assert.NotNil(proof) {
+		assert.Equal(string(BIP322), proof.Scheme)
+	}
+}
+
+// ethereumSign produces the Ethereum signature of the hash in R, S, V form.
+func ethereumSign(key *btcec.PrivateKey, hash []byte) string {
+	sig, _ := btcec.SignCompact(btcec.S256(), key, hash, false)
+	return "0x" + hex.EncodeToString(append(sig[1:], sig[0]))
+}
@@ -0,0 +1,86 @@
+package ownership
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"modulus/kyc/common"
+)
+
+// Verify checks the signature of the challenge message made with the key of the challenge wallet address.
+// If the scheme is empty it's detected from the chain of the challenge and the signature.
+// It returns the ownership proof if the signature is valid.
+func Verify(challenge Challenge, scheme Scheme, signature string) (proof *common.WalletOwnershipProof, err error) {
+	if len(signature) == 0 {
+		err = errors.New("empty signature")
+		return
+	}
+	if len(scheme) == 0 {
+		scheme = detectScheme(challenge.Chain, signature)
+	}
+
+	switch challenge.Chain {
+	case common.Bitcoin:
+		switch scheme {
+		case BitcoinMessage:
+			err = verifyBitcoinMessage(challenge.Address, challenge.Message, signature)
+		case BIP322:
+			err = verifyBIP322(challenge.Address, challenge.Message, signature)
+		default:
+			err = fmt.Errorf("unsupported signature scheme for %s: %s", challenge.Chain, scheme)
+		}
+	case common.Ethereum:
+		switch scheme {
+		case PersonalSign:
+			err = verifyEthereumSignature(challenge.Address, personalSignHash(challenge.Message), signature)
+		case EIP712:
+			if challenge.TypedData == nil {
+				err = errors.New("missing typed data in the challenge")
+				return
+			}
+			hash, err1 := challenge.TypedData.Hash()
+			if err1 != nil {
+				err = err1
+				return
+			}
+			err = verifyEthereumSignature(challenge.Address, hash, signature)
+		default:
+			err = fmt.Errorf("unsupported signature scheme for %s: %s", challenge.Chain, scheme)
+		}
+	default:
+		err = fmt.Errorf("unsupported blockchain: %s", challenge.Chain)
+	}
+	if err != nil {
+		return
+	}
+
+	proof = &common.WalletOwnershipProof{
+		Chain:       challenge.Chain,
+		Address:     challenge.Address,
+		Scheme:      string(scheme),
+		Message:     challenge.Message,
+		Signature:   signature,
+		ChallengeID: challenge.ID,
+		VerifiedAt:  time.Now(),
+	}
+
+	return
+}
+
+// detectScheme guesses the signature scheme when it isn't specified explicitly.
+func detectScheme(chain common.Blockchain, signature string) Scheme {
+	switch chain {
+	case common.Bitcoin:
+		// Legacy compact signatures are always 65 bytes long, BIP-322 ones are serialized witness stacks.
+		if raw, err := base64.StdEncoding.DecodeString(signature); err == nil && len(raw) == 65 {
+			return BitcoinMessage
+		}
+		return BIP322
+	case common.Ethereum:
+		return PersonalSign
+	}
+
+	return ""
+}
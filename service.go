@@ -0,0 +1,386 @@
+package kyc
+
+import (
+	"context"
+	"sync"
+
+	"modulus/kyc/common"
+	"modulus/kyc/integrations/ciphertrace"
+	"modulus/kyc/integrations/coinfirm"
+	"modulus/kyc/integrations/complyadvantage"
+	"modulus/kyc/integrations/example"
+	"modulus/kyc/integrations/identitymind"
+	"modulus/kyc/integrations/idology"
+	"modulus/kyc/integrations/jumio"
+	"modulus/kyc/integrations/shuftipro"
+	"modulus/kyc/integrations/sumsub"
+	"modulus/kyc/integrations/synapsefi"
+	"modulus/kyc/integrations/thomsonreuters"
+	"modulus/kyc/integrations/trulioo"
+)
+
+// MaxAbandonedCalls limits the number of the calls of a provider instance left running after their contexts are done.
+const MaxAbandonedCalls = 64
+
+// abandoned counts the calls left running after their contexts are done by the provider instances.
+var abandoned = struct {
+	sync.Mutex
+	calls map[string]int
+}{calls: map[string]int{}}
+
+// Service verifies customers by the configured KYC providers and screens crypto transactions.
+// It's the in-process counterpart of the HTTP and gRPC APIs and is safe for concurrent use.
+type Service struct {
+	config Config
+}
+
+// Option customizes a single call of the Service.
+type Option func(*callOptions)
+
+// callOptions holds the settings of a call.
+type callOptions struct {
+	instance string
+}
+
+// WithInstance selects the named config instance of the provider. The default instance is used without the option.
+func WithInstance(instance string) Option {
+	return func(o *callOptions) {
+		o.instance = instance
+	}
+}
+
+// New constructs a new Service using the config specified.
+// The config is used as is, it must not be modified afterwards.
+func New(config Config) *Service {
+	return &Service{config: config}
+}
+
+// CustomerChecker returns the KYC platform of the provider instance for verifying customers.
+// The returned error is the Error.
+func (s *Service) CustomerChecker(provider common.KYCProvider, opts ...Option) (platform common.KYCPlatform, err error) {
+	o := newCallOptions(opts)
+
+	if provider == common.Example {
+		platform = example.Example{}
+		return
+	}
+	if !common.KYCProviders[provider] {
+		err = newError(common.ProblemUnknownProvider, provider, o.instance, "unknown KYC provider: %s", provider)
+		return
+	}
+
+	var ok bool
+	switch provider {
+	case common.Coinfirm:
+		var cfg coinfirm.Config
+		if cfg, ok = s.config.Coinfirm[o.instance]; ok {
+			platform = coinfirm.New(cfg)
+		}
+	case common.ComplyAdvantage:
+		var cfg complyadvantage.Config
+		if cfg, ok = s.config.ComplyAdvantage[o.instance]; ok {
+			platform = complyadvantage.New(cfg)
+		}
+	case common.IdentityMind:
+		var cfg identitymind.Config
+		if cfg, ok = s.config.IdentityMind[o.instance]; ok {
+			platform = identitymind.New(cfg)
+		}
+	case common.IDology:
+		var cfg idology.Config
+		if cfg, ok = s.config.IDology[o.instance]; ok {
+			platform = idology.New(cfg)
+		}
+	case common.Jumio:
+		var cfg jumio.Config
+		if cfg, ok = s.config.Jumio[o.instance]; ok {
+			platform = jumio.New(cfg)
+		}
+	case common.ShuftiPro:
+		var cfg shuftipro.Config
+		if cfg, ok = s.config.ShuftiPro[o.instance]; ok {
+			platform = shuftipro.New(cfg)
+		}
+	case common.SumSub:
+		var cfg sumsub.Config
+		if cfg, ok = s.config.SumSub[o.instance]; ok {
+			platform = sumsub.New(cfg)
+		}
+	case common.SynapseFI:
+		var cfg synapsefi.Config
+		if cfg, ok = s.config.SynapseFI[o.instance]; ok {
+			platform = synapsefi.New(cfg)
+		}
+	case common.ThomsonReuters:
+		var cfg thomsonreuters.Config
+		if cfg, ok = s.config.ThomsonReuters[o.instance]; ok {
+			platform = thomsonreuters.New(cfg)
+		}
+	case common.Trulioo:
+		var cfg trulioo.Config
+		if cfg, ok = s.config.Trulioo[o.instance]; ok {
+			platform = trulioo.New(cfg)
+		}
+	default:
+		err = newError(common.ProblemUnsupportedOperation, provider, o.instance, "KYC provider not implemented yet: %s", provider)
+		return
+	}
+	switch {
+	case ok:
+	case len(o.instance) > 0:
+		err = newError(common.ProblemUnknownInstance, provider, o.instance, "unknown %s", instanceName(provider, o.instance))
+	default:
+		err = newError(common.ProblemConfigError, provider, o.instance, "missing config for %s", provider)
+	}
+
+	return
+}
+
+// StatusChecker returns the KYC platform of the provider instance for checking the statuses of verifications.
+// The returned error is the Error.
+func (s *Service) StatusChecker(provider common.KYCProvider, opts ...Option) (platform common.KYCPlatform, err error) {
+	switch provider {
+	case common.ComplyAdvantage, common.IDology, common.ThomsonReuters, common.Trulioo:
+		err = newError(common.ProblemUnsupportedOperation, provider, newCallOptions(opts).instance, "%s doesn't support status polling", provider)
+		return
+	}
+
+	return s.CustomerChecker(provider, opts...)
+}
+
+// CheckCustomer verifies the customer by the KYC provider.
+// The status check of the result refers to the provider instance used, so it might be passed to CheckStatus as is.
+// If the context is done before the provider responds the context error is returned. The provider requests
+// can't be cancelled, so the call keeps running in the background until the provider responds or its request
+// times out. While MaxAbandonedCalls of such calls of the provider instance run, the new calls of the instance
+// fail at once with the Error of the ProblemProviderUnavailable code.
+func (s *Service) CheckCustomer(ctx context.Context, provider common.KYCProvider, customer *common.UserData, opts ...Option) (result common.KYCResult, err error) {
+	platform, err := s.CustomerChecker(provider, opts...)
+	if err != nil {
+		return
+	}
+
+	var (
+		res  common.KYCResult
+		err1 error
+	)
+	if err = wait(ctx, provider, newCallOptions(opts).instance, func() { res, err1 = platform.CheckCustomer(customer) }); err != nil {
+		return
+	}
+	result, err = res, err1
+	if result.StatusCheck != nil {
+		result.StatusCheck.Instance = newCallOptions(opts).instance
+	}
+
+	return
+}
+
+// CheckStatus checks the status of the verification by the KYC provider.
+// The context is handled the same way as by CheckCustomer.
+func (s *Service) CheckStatus(ctx context.Context, provider common.KYCProvider, referenceID string, opts ...Option) (result common.KYCResult, err error) {
+	platform, err := s.StatusChecker(provider, opts...)
+	if err != nil {
+		return
+	}
+
+	var (
+		res  common.KYCResult
+		err1 error
+	)
+	if err = wait(ctx, provider, newCallOptions(opts).instance, func() { res, err1 = platform.CheckStatus(referenceID) }); err != nil {
+		return
+	}
+	result, err = res, err1
+	if result.StatusCheck != nil {
+		result.StatusCheck.Instance = newCallOptions(opts).instance
+	}
+
+	return
+}
+
+// CipherTrace returns the CipherTrace service. It returns false if CipherTrace isn't configured.
+func (s *Service) CipherTrace() (service *ciphertrace.CipherService, ok bool) {
+	cfg := s.config.CipherTrace
+	if cfg == nil {
+		return
+	}
+
+	return ciphertrace.NewCipherService(cfg.URL, cfg.Key, cfg.Username), true
+}
+
+// Coinfirm returns the Coinfirm client of the default instance for the crypto address screening.
+// It returns false if Coinfirm isn't configured.
+func (s *Service) Coinfirm() (client coinfirm.Coinfirm, ok bool) {
+	cfg, ok := s.config.Coinfirm[""]
+	if !ok {
+		return
+	}
+
+	return coinfirm.New(cfg), true
+}
+
+// ScreenTransaction checks the risk of the crypto transaction by CipherTrace.
+// The failed CipherTrace requests are reported as the common.KYCError.
+// The context is handled the same way as by CheckCustomer.
+func (s *Service) ScreenTransaction(ctx context.Context, chain common.Blockchain, txHash string) (risk *ciphertrace.AddressRisk, err error) {
+	service, err := s.cipherTrace(chain)
+	if err != nil {
+		return
+	}
+
+	var (
+		res  *ciphertrace.AddressRisk
+		err1 error
+		code int
+	)
+	err = wait(ctx, common.CipherTrace, "", func() {
+		if chain == common.Bitcoin {
+			res, err1, code = service.GtAddressRiskInfo(txHash)
+		} else {
+			res, err1, code = service.GtAddressRiskInfoETH(txHash)
+		}
+	})
+	if err != nil {
+		return
+	}
+	if err1 != nil {
+		err = cipherTraceError(code, err1)
+		return
+	}
+	risk = res
+
+	return
+}
+
+// ScreenAddress checks the risk of the crypto address by CipherTrace.
+// The risk is the *ciphertrace.SingleAddressRisk for Bitcoin and the *ciphertrace.ETHAddressRisk for Ethereum.
+// The failed CipherTrace requests are reported as the common.KYCError.
+// The context is handled the same way as by CheckCustomer.
+func (s *Service) ScreenAddress(ctx context.Context, chain common.Blockchain, address string) (risk interface{}, err error) {
+	service, err := s.cipherTrace(chain)
+	if err != nil {
+		return
+	}
+
+	var (
+		res  interface{}
+		err1 error
+		code int
+	)
+	err = wait(ctx, common.CipherTrace, "", func() {
+		if chain == common.Bitcoin {
+			res, err1, code = service.GtSingleAddressRiskInfo(address)
+		} else {
+			res, err1, code = service.GtSingleAddressRiskInfoETH(address)
+		}
+	})
+	if err != nil {
+		return
+	}
+	if err1 != nil {
+		err = cipherTraceError(code, err1)
+		return
+	}
+	risk = res
+
+	return
+}
+
+// cipherTrace returns the CipherTrace service for screening on the blockchain.
+func (s *Service) cipherTrace(chain common.Blockchain) (service *ciphertrace.CipherService, err error) {
+	if chain != common.Bitcoin && chain != common.Ethereum {
+		err = newError(common.ProblemInvalidRequest, common.CipherTrace, "", "not supported coin")
+		return
+	}
+
+	service, ok := s.CipherTrace()
+	if !ok {
+		err = newError(common.ProblemConfigError, common.CipherTrace, "", "missing config for %s", common.CipherTrace)
+	}
+
+	return
+}
+
+// cipherTraceError classifies the error of the CipherTrace request by the HTTP status code of the response.
+func cipherTraceError(code int, err error) error {
+	var statusCode *int
+	if code > 0 {
+		statusCode = &code
+	}
+
+	return common.NewProviderError(common.CipherTrace, statusCode, err)
+}
+
+// newCallOptions applies the options of the call.
+func newCallOptions(opts []Option) (o callOptions) {
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return
+}
+
+// wait runs the call of the provider instance returning early with the context error if the context is done first.
+// The provider requests can't be interrupted, so the abandoned fn completes in the background. The calls are
+// rejected while MaxAbandonedCalls of the provider instance run in the background.
+func wait(ctx context.Context, provider common.KYCProvider, instance string, fn func()) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	name := instanceName(provider, instance)
+
+	abandoned.Lock()
+	full := abandoned.calls[name] >= MaxAbandonedCalls
+	abandoned.Unlock()
+	if full {
+		return newError(common.ProblemProviderUnavailable, provider, instance, "too many calls of %s are still running after their deadlines", name)
+	}
+
+	var (
+		mu       sync.Mutex
+		finished bool
+		left     bool
+	)
+	done := make(chan struct{})
+	go func() {
+		fn()
+
+		mu.Lock()
+		finished = true
+		if left {
+			release(name)
+		}
+		mu.Unlock()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if finished {
+		return nil
+	}
+	left = true
+	abandoned.Lock()
+	abandoned.calls[name]++
+	abandoned.Unlock()
+
+	return ctx.Err()
+}
+
+// release uncounts the abandoned call of the provider instance which has completed.
+func release(name string) {
+	abandoned.Lock()
+	defer abandoned.Unlock()
+
+	if abandoned.calls[name]--; abandoned.calls[name] <= 0 {
+		delete(abandoned.calls, name)
+	}
+}
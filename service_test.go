@@ -0,0 +1,175 @@
+package kyc_test
+
+import (
+	"context"
+	"testing"
+
+	"modulus/kyc"
+	"modulus/kyc/common"
+	"modulus/kyc/integrations/idology"
+	"modulus/kyc/integrations/jumio"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_CustomerChecker(t *testing.T) {
+	assert := assert.New(t)
+
+	service := kyc.New(kyc.Config{
+		Jumio: map[string]jumio.Config{
+			"":   {BaseURL: "https://lon.netverify.com/api/netverify/v2"},
+			"eu": {BaseURL: "https://lon.netverify.com/api/netverify/v2"},
+		},
+	})
+
+	platform, err := service.CustomerChecker(common.Example)
+	assert.NoError(err)
+	assert.NotNil(platform)
+
+	platform, err = service.CustomerChecker(common.Jumio)
+	assert.NoError(err)
+	assert.NotNil(platform)
+
+	platform, err = service.CustomerChecker(common.Jumio, kyc.WithInstance("eu"))
+	assert.NoError(err)
+	assert.NotNil(platform)
+
+	_, err = service.CustomerChecker(common.Jumio, kyc.WithInstance("us"))
+	assertError(t, err, common.ProblemUnknownInstance, "unknown Jumio instance 'us'")
+
+	_, err = service.CustomerChecker(common.IDology)
+	assertError(t, err, common.ProblemConfigError, "missing config for IDology")
+
+	_, err = service.CustomerChecker("Foo")
+	assertError(t, err, common.ProblemUnknownProvider, "unknown KYC provider: Foo")
+
+	_, err = service.CustomerChecker(common.CipherTrace)
+	assertError(t, err, common.ProblemUnsupportedOperation, "KYC provider not implemented yet: CipherTrace")
+}
+
+func TestService_StatusChecker(t *testing.T) {
+	assert := assert.New(t)
+
+	service := kyc.New(kyc.Config{
+		IDology: map[string]idology.Config{
+			"": {Host: "https://web.idologylive.com/api/idiq.svc"},
+		},
+	})
+
+	platform, err := service.StatusChecker(common.Example)
+	assert.NoError(err)
+	assert.NotNil(platform)
+
+	_, err = service.StatusChecker(common.IDology)
+	assertError(t, err, common.ProblemUnsupportedOperation, "IDology doesn't support status polling")
+
+	_, err = service.StatusChecker(common.Jumio)
+	assertError(t, err, common.ProblemConfigError, "missing config for Jumio")
+}
+
+func TestService_CheckCustomer(t *testing.T) {
+	assert := assert.New(t)
+
+	service := kyc.New(kyc.Config{})
+
+	result, err := service.CheckCustomer(context.Background(), common.Example, &common.UserData{FirstName: "Abby"})
+	assert.NoError(err)
+	assert.Equal(common.Approved, result.Status)
+
+	result, err = service.CheckCustomer(context.Background(), common.Example, &common.UserData{FirstName: "Urbi"}, kyc.WithInstance("eu"))
+	assert.NoError(err)
+	assert.Equal(common.Unclear, result.Status)
+	if assert.NotNil(result.StatusCheck) {
+		assert.Equal("eu", result.StatusCheck.Instance)
+		assert.Equal("lily_was_here", result.StatusCheck.ReferenceID)
+	}
+
+	result, err = service.CheckCustomer(context.Background(), common.Example, &common.UserData{FirstName: "Erika"})
+	assert.Error(err)
+	assert.Equal("429", result.ErrorCode)
+	kycErr, ok := common.AsKYCError(err)
+	if assert.True(ok) {
+		assert.Equal(common.RateLimitedError, kycErr.Category)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = service.CheckCustomer(ctx, common.Example, &common.UserData{FirstName: "Abby"})
+	assert.Equal(context.Canceled, err)
+
+	_, err = service.CheckCustomer(context.Background(), common.Jumio, &common.UserData{FirstName: "Abby"})
+	assertError(t, err, common.ProblemConfigError, "missing config for Jumio")
+}
+
+func TestService_CheckStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	service := kyc.New(kyc.Config{})
+
+	result, err := service.CheckStatus(context.Background(), common.Example, "ada")
+	assert.NoError(err)
+	assert.Equal(common.Approved, result.Status)
+
+	result, err = service.CheckStatus(context.Background(), common.Example, "uma", kyc.WithInstance("eu"))
+	assert.NoError(err)
+	assert.Equal(common.Unclear, result.Status)
+	if assert.NotNil(result.StatusCheck) {
+		assert.Equal("eu", result.StatusCheck.Instance)
+	}
+
+	_, err = service.CheckStatus(context.Background(), common.Trulioo, "ada")
+	assertError(t, err, common.ProblemUnsupportedOperation, "Trulioo doesn't support status polling")
+}
+
+func TestService_Screening(t *testing.T) {
+	assert := assert.New(t)
+
+	service := kyc.New(kyc.Config{})
+
+	_, ok := service.CipherTrace()
+	assert.False(ok)
+
+	_, err := service.ScreenTransaction(context.Background(), common.Bitcoin, "txhash")
+	assertError(t, err, common.ProblemConfigError, "missing config for CipherTrace")
+
+	_, err = service.ScreenAddress(context.Background(), common.Ethereum, "0x0")
+	assertError(t, err, common.ProblemConfigError, "missing config for CipherTrace")
+
+	service = kyc.New(kyc.Config{
+		CipherTrace: &kyc.CipherTraceConfig{URL: "https://rest.ciphertrace.com"},
+	})
+
+	_, ok = service.CipherTrace()
+	assert.True(ok)
+
+	_, err = service.ScreenTransaction(context.Background(), "LTC", "txhash")
+	assertError(t, err, common.ProblemInvalidRequest, "not supported coin")
+}
+
+func TestAsError(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := kyc.New(kyc.Config{}).CustomerChecker("Foo")
+
+	e, ok := kyc.AsError(errors.Wrap(err, "during creating checker"))
+	if assert.True(ok) {
+		assert.Equal(common.ProblemUnknownProvider, e.Code)
+		assert.Equal(common.KYCProvider("Foo"), e.Provider)
+	}
+
+	_, ok = kyc.AsError(errors.New("foo"))
+	assert.False(ok)
+
+	_, ok = kyc.AsError(nil)
+	assert.False(ok)
+}
+
+func assertError(t *testing.T, err error, code common.ProblemCode, message string) {
+	e, ok := kyc.AsError(err)
+	if assert.True(t, ok, "kyc.Error expected, got: %v", err) {
+		assert.Equal(t, code, e.Code)
+		assert.Equal(t, message, e.Message)
+	}
+}
@@ -0,0 +1,64 @@
+package travelrule
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultThreshold holds the default fiat value of the transfer starting from which the full originator information
+// is required. It corresponds to the FATF Recommendation 16 threshold of USD/EUR 1000.
+var DefaultThreshold = decimal.NewFromInt(1000)
+
+// DefaultReplayWindow is the default time the incoming messages are accepted within since they were sealed.
+const DefaultReplayWindow = 10 * time.Minute
+
+// Config holds the settings of the travel rule message exchange of a VASP.
+type Config struct {
+	// VASPID identifies the VASP among its counterparties.
+	VASPID string
+	// PrivateKey is used to sign outgoing messages.
+	PrivateKey ed25519.PrivateKey
+	// Peers holds public keys of the counterparty VASPs by their ids.
+	Peers map[string]ed25519.PublicKey
+	// Threshold is the fiat value of the transfer starting from which the full originator information is required.
+	// It's compared with the FiatAmount of the transfers. DefaultThreshold is used if it's zero.
+	Threshold decimal.Decimal
+	// ReplayWindow is the time the incoming messages are accepted within since they were sealed,
+	// the repeated ones are refused. DefaultReplayWindow is used if it's zero.
+	ReplayWindow time.Duration
+	// Transport delivers messages to the counterparty VASPs.
+	Transport Transport
+	// Handler decides on incoming transfers. All valid transfers are accepted if it's nil.
+	Handler Handler
+}
+
+// Transport represents a way of delivering messages to counterparty VASPs.
+type Transport interface {
+	// Send delivers the envelope to the VASP and returns its reply.
+	Send(vaspID string, envelope Envelope) (Envelope, error)
+}
+
+// Handler decides on the incoming transfer as the beneficiary VASP.
+// It returns the beneficiary information to complete the identity payload with
+// or an error to reject the transfer.
+type Handler func(transfer Transfer) (beneficiary *Beneficiary, err error)
+
+// Mock is a mock Transport to be used in tests.
+type Mock struct {
+	SendFn func(vaspID string, envelope Envelope) (Envelope, error)
+}
+
+// Send implements Transport interface for the Mock.
+func (mock Mock) Send(vaspID string, envelope Envelope) (Envelope, error) {
+	return mock.SendFn(vaspID, envelope)
+}
+
+// List of errors returned by the package.
+var (
+	ErrUnknownVASP      = errors.New("unknown VASP")
+	ErrInvalidSignature = errors.New("invalid message signature")
+	ErrReplayedMessage  = errors.New("replayed or expired message")
+)
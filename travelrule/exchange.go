@@ -0,0 +1,284 @@
+package travelrule
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"modulus/kyc/common"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Transfer represents a virtual asset transfer accompanied by the identity information of its parties.
+// Amount is in the units of the asset, FiatAmount is its value in the currency of the threshold at the time of the transfer.
+// The full originator information is required if the fiat value is unknown.
+type Transfer struct {
+	Asset      string          `json:"asset"`
+	Amount     decimal.Decimal `json:"amount"`
+	FiatAmount decimal.Decimal `json:"fiatAmount"`
+	TxHash     string          `json:"txHash,omitempty"`
+	Identity   IdentityPayload `json:"identity"`
+}
+
+// ReplyStatus represents the beneficiary VASP decision on the transfer.
+type ReplyStatus string
+
+// Possible values of ReplyStatus.
+const (
+	Accepted ReplyStatus = "accepted"
+	Rejected ReplyStatus = "rejected"
+)
+
+// Reply represents the response of the beneficiary VASP to the transfer.
+// The identity payload of an accepted transfer is completed with the beneficiary information.
+type Reply struct {
+	TransferID string           `json:"transferId"`
+	Status     ReplyStatus      `json:"status"`
+	Reason     string           `json:"reason,omitempty"`
+	Identity   *IdentityPayload `json:"identity,omitempty"`
+}
+
+// Envelope represents a signed message exchanged between VASPs.
+// The signature covers the id, the sender, the Unix time the message was sealed at and the payload of the message.
+type Envelope struct {
+	ID        string `json:"id"`
+	Sender    string `json:"sender"`
+	Timestamp int64  `json:"timestamp"`
+	Payload   []byte `json:"payload"`
+	Signature []byte `json:"signature"`
+}
+
+// Exchange sends and receives travel rule messages on behalf of a VASP.
+type Exchange struct {
+	config Config
+
+	mu sync.Mutex
+	// seen holds the times of the transfers received within the replay window by the sender and the envelope id.
+	seen map[string]time.Time
+}
+
+// New constructs a new travel rule message exchange.
+func New(config Config) *Exchange {
+	if config.Threshold.IsZero() {
+		config.Threshold = DefaultThreshold
+	}
+	if config.ReplayWindow <= 0 {
+		config.ReplayWindow = DefaultReplayWindow
+	}
+
+	return &Exchange{
+		config: config,
+		seen:   map[string]time.Time{},
+	}
+}
+
+// Send validates the transfer and delivers it to the beneficiary VASP.
+// It returns the verified reply of the beneficiary VASP.
+func (e *Exchange) Send(vaspID string, transfer Transfer) (reply Reply, err error) {
+	if _, ok := e.config.Peers[vaspID]; !ok {
+		err = ErrUnknownVASP
+		return
+	}
+
+	if err = transfer.Validate(e.config.Threshold); err != nil {
+		return
+	}
+
+	envelope, err := e.seal(uuid.New().String(), transfer)
+	if err != nil {
+		return
+	}
+
+	response, err := e.config.Transport.Send(vaspID, envelope)
+	if err != nil {
+		return
+	}
+
+	if response.Sender != vaspID {
+		err = fmt.Errorf("unexpected reply sender: %s", response.Sender)
+		return
+	}
+
+	if err = e.open(response, &reply); err != nil {
+		return
+	}
+
+	if reply.TransferID != envelope.ID {
+		err = fmt.Errorf("unexpected reply to the transfer: %s", reply.TransferID)
+	}
+
+	return
+}
+
+// Receive verifies and handles the transfer delivered by the originating VASP.
+// It returns the signed reply to the transfer or an error if the envelope can't be trusted.
+// The envelopes sealed outside of the replay window and the repeated ones are refused with ErrReplayedMessage.
+func (e *Exchange) Receive(envelope Envelope) (response Envelope, err error) {
+	transfer := Transfer{}
+	if err = e.open(envelope, &transfer); err != nil {
+		return
+	}
+	if err = e.checkReplay(envelope); err != nil {
+		return
+	}
+
+	reply := Reply{
+		TransferID: envelope.ID,
+		Status:     Accepted,
+	}
+
+	beneficiary, err := e.handle(transfer)
+	if err != nil {
+		reply.Status = Rejected
+		reply.Reason = err.Error()
+	} else {
+		identity := transfer.Identity
+		if beneficiary != nil {
+			identity.Beneficiary = *beneficiary
+		}
+		reply.Identity = &identity
+	}
+
+	return e.seal(envelope.ID, reply)
+}
+
+// ServeHTTP implements http.Handler interface for the Exchange to receive transfers over HTTP.
+func (e *Exchange) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	envelope := Envelope{}
+	if err = json.Unmarshal(body, &envelope); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := e.Receive(envelope)
+	if err != nil {
+		status := http.StatusBadRequest
+		switch err {
+		case ErrUnknownVASP, ErrInvalidSignature:
+			status = http.StatusForbidden
+		case ErrReplayedMessage:
+			status = http.StatusConflict
+		}
+		writeError(w, status, err)
+		return
+	}
+
+	resp, err := json.Marshal(response)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Write(resp)
+}
+
+// handle validates the incoming transfer and passes it to the configured handler.
+func (e *Exchange) handle(transfer Transfer) (beneficiary *Beneficiary, err error) {
+	if err = transfer.Validate(e.config.Threshold); err != nil {
+		return
+	}
+	if e.config.Handler == nil {
+		return
+	}
+
+	return e.config.Handler(transfer)
+}
+
+// checkReplay records the envelope unless it's sealed outside of the replay window or it has been received before.
+func (e *Exchange) checkReplay(envelope Envelope) error {
+	now := time.Now()
+	sealedAt := time.Unix(envelope.Timestamp, 0)
+	if sealedAt.Before(now.Add(-e.config.ReplayWindow)) || sealedAt.After(now.Add(e.config.ReplayWindow)) {
+		return ErrReplayedMessage
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for key, receivedAt := range e.seen {
+		if now.Sub(receivedAt) > 2*e.config.ReplayWindow {
+			delete(e.seen, key)
+		}
+	}
+
+	key := envelope.Sender + "/" + envelope.ID
+	if _, ok := e.seen[key]; ok {
+		return ErrReplayedMessage
+	}
+	e.seen[key] = now
+
+	return nil
+}
+
+// seal serializes and signs the message.
+func (e *Exchange) seal(id string, message interface{}) (envelope Envelope, err error) {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return
+	}
+
+	envelope = Envelope{
+		ID:        id,
+		Sender:    e.config.VASPID,
+		Timestamp: time.Now().Unix(),
+		Payload:   payload,
+	}
+	envelope.Signature = ed25519.Sign(e.config.PrivateKey, envelope.signingInput())
+
+	return
+}
+
+// open verifies the signature of the envelope and deserializes its payload into the message.
+func (e *Exchange) open(envelope Envelope, message interface{}) error {
+	key, ok := e.config.Peers[envelope.Sender]
+	if !ok {
+		return ErrUnknownVASP
+	}
+	if !ed25519.Verify(key, envelope.signingInput(), envelope.Signature) {
+		return ErrInvalidSignature
+	}
+
+	return json.Unmarshal(envelope.Payload, message)
+}
+
+// signingInput returns the signed content of the envelope.
+func (e Envelope) signingInput() []byte {
+	return []byte(e.ID + "." + e.Sender + "." + strconv.FormatInt(e.Timestamp, 10) + "." + base64.RawURLEncoding.EncodeToString(e.Payload))
+}
+
+// writeError writes the error response using the specified HTTP status code.
+func writeError(w http.ResponseWriter, status int, err error) {
+	resp, _ := json.Marshal(common.ErrorResponse{
+		Error: err.Error(),
+	})
+
+	w.WriteHeader(status)
+	w.Write(resp)
+}
+
+// errorFromResponse extracts the error from the response of a counterparty VASP.
+func errorFromResponse(resp []byte) error {
+	eresp := common.ErrorResponse{}
+	if err := json.Unmarshal(resp, &eresp); err != nil || len(eresp.Error) == 0 {
+		return errors.New("http error")
+	}
+
+	return errors.New(eresp.Error)
+}
@@ -0,0 +1,249 @@
+package travelrule
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"modulus/kyc/common"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// newVASPs constructs a pair of exchanges trusting each other.
+func newVASPs() (originator, beneficiary *Exchange) {
+	originatorPub, originatorKey, _ := ed25519.GenerateKey(nil)
+	beneficiaryPub, beneficiaryKey, _ := ed25519.GenerateKey(nil)
+
+	originator = New(Config{
+		VASPID:     "originator-vasp",
+		PrivateKey: originatorKey,
+		Peers: map[string]ed25519.PublicKey{
+			"beneficiary-vasp": beneficiaryPub,
+		},
+	})
+	beneficiary = New(Config{
+		VASPID:     "beneficiary-vasp",
+		PrivateKey: beneficiaryKey,
+		Peers: map[string]ed25519.PublicKey{
+			"originator-vasp": originatorPub,
+		},
+	})
+
+	return
+}
+
+// newTransfer constructs a valid transfer above the default threshold.
+func newTransfer() Transfer {
+	originator := PersonFromUserData(&common.UserData{
+		FirstName: "John",
+		LastName:  "Doe",
+		Passport: &common.Passport{
+			Number:        "P123456",
+			CountryAlpha2: "GB",
+		},
+	})
+	beneficiary := PersonFromUserData(&common.UserData{
+		FullName: "Jane Roe",
+	})
+
+	return Transfer{
+		Asset:      "ETH",
+		Amount:     decimal.NewFromFloat(1.25),
+		FiatAmount: decimal.NewFromInt(2500),
+		Identity: IdentityPayload{
+			Originator: Originator{
+				OriginatorPersons: []Person{originator},
+				AccountNumber:     []string{"0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+			},
+			Beneficiary: Beneficiary{
+				BeneficiaryPersons: []Person{beneficiary},
+				AccountNumber:      []string{"0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+			},
+		},
+	}
+}
+
+func TestExchange(t *testing.T) {
+	assert := assert.New(t)
+
+	originator, beneficiary := newVASPs()
+	originator.config.Transport = LocalTransport{"beneficiary-vasp": beneficiary}
+
+	reply, err := originator.Send("beneficiary-vasp", newTransfer())
+	assert.NoError(err)
+	assert.Equal(Accepted, reply.Status)
+	assert.NotEmpty(reply.TransferID)
+	if assert.NotNil(reply.Identity) {
+		assert.Equal("Jane Roe", reply.Identity.Beneficiary.BeneficiaryPersons[0].NaturalPerson.Name.NameIdentifier[0].PrimaryIdentifier)
+	}
+
+	beneficiary.config.Handler = func(transfer Transfer) (*Beneficiary, error) {
+		assert.Equal("ETH", transfer.Asset)
+		assert.True(decimal.NewFromFloat(1.25).Equal(transfer.Amount))
+		assert.True(decimal.NewFromInt(2500).Equal(transfer.FiatAmount))
+
+		return &Beneficiary{
+			BeneficiaryPersons: []Person{
+				PersonFromUserData(&common.UserData{
+					FirstName: "Jane",
+					LastName:  "Roe",
+				}),
+			},
+			AccountNumber: transfer.Identity.Beneficiary.AccountNumber,
+		}, nil
+	}
+
+	reply, err = originator.Send("beneficiary-vasp", newTransfer())
+	assert.NoError(err)
+	assert.Equal(Accepted, reply.Status)
+	if assert.NotNil(reply.Identity) {
+		name := reply.Identity.Beneficiary.BeneficiaryPersons[0].NaturalPerson.Name.NameIdentifier[0]
+		assert.Equal("Roe", name.PrimaryIdentifier)
+		assert.Equal("Jane", name.SecondaryIdentifier)
+	}
+
+	beneficiary.config.Handler = func(transfer Transfer) (*Beneficiary, error) {
+		return nil, errors.New("unknown beneficiary account")
+	}
+
+	reply, err = originator.Send("beneficiary-vasp", newTransfer())
+	assert.NoError(err)
+	assert.Equal(Rejected, reply.Status)
+	assert.Equal("unknown beneficiary account", reply.Reason)
+	assert.Nil(reply.Identity)
+
+	// The beneficiary VASP applies a lower threshold than the originator.
+	beneficiary.config.Threshold = decimal.NewFromInt(1)
+	beneficiary.config.Handler = nil
+
+	transfer := newTransfer()
+	transfer.FiatAmount = decimal.NewFromInt(10)
+	transfer.Identity.Originator.OriginatorPersons[0].NaturalPerson.NationalIdentification = nil
+
+	reply, err = originator.Send("beneficiary-vasp", transfer)
+	assert.NoError(err)
+	assert.Equal(Rejected, reply.Status)
+	assert.Contains(reply.Reason, "originator.originatorPersons[0].naturalPerson: address")
+
+	transfer.FiatAmount = decimal.NewFromInt(1000)
+
+	_, err = originator.Send("beneficiary-vasp", transfer)
+	assert.IsType(ValidationError{}, err)
+
+	_, err = originator.Send("unknown-vasp", newTransfer())
+	assert.Equal(ErrUnknownVASP, err)
+}
+
+func TestExchangeSignature(t *testing.T) {
+	assert := assert.New(t)
+
+	originator, beneficiary := newVASPs()
+
+	var tampered bool
+	originator.config.Transport = Mock{
+		SendFn: func(vaspID string, envelope Envelope) (Envelope, error) {
+			assert.Equal("beneficiary-vasp", vaspID)
+			assert.Equal("originator-vasp", envelope.Sender)
+
+			if tampered {
+				envelope.Payload = []byte(string(envelope.Payload[:len(envelope.Payload)-1]) + ` `)
+			}
+
+			return beneficiary.Receive(envelope)
+		},
+	}
+
+	_, err := originator.Send("beneficiary-vasp", newTransfer())
+	assert.NoError(err)
+
+	tampered = true
+
+	_, err = originator.Send("beneficiary-vasp", newTransfer())
+	assert.Equal(ErrInvalidSignature, err)
+
+	stranger, _ := newVASPs()
+	stranger.config.VASPID = "stranger-vasp"
+
+	envelope, err := stranger.seal("id", newTransfer())
+	assert.NoError(err)
+
+	_, err = beneficiary.Receive(envelope)
+	assert.Equal(ErrUnknownVASP, err)
+
+	// The reply signed by another VASP is refused.
+	originator.config.Transport = Mock{
+		SendFn: func(vaspID string, envelope Envelope) (Envelope, error) {
+			reply, _ := beneficiary.Receive(envelope)
+			reply.Sender = "originator-vasp"
+			return reply, nil
+		},
+	}
+
+	_, err = originator.Send("beneficiary-vasp", newTransfer())
+	assert.EqualError(err, "unexpected reply sender: originator-vasp")
+}
+
+func TestExchangeReplay(t *testing.T) {
+	assert := assert.New(t)
+
+	originator, beneficiary := newVASPs()
+
+	envelope, err := originator.seal("transfer-1", newTransfer())
+	assert.NoError(err)
+
+	reply, err := beneficiary.Receive(envelope)
+	assert.NoError(err)
+	assert.Equal("transfer-1", reply.ID)
+
+	_, err = beneficiary.Receive(envelope)
+	assert.Equal(ErrReplayedMessage, err)
+
+	// The stale envelopes are refused even if they haven't been seen.
+	envelope, err = originator.seal("transfer-2", newTransfer())
+	assert.NoError(err)
+	envelope.Timestamp -= int64(2 * DefaultReplayWindow / time.Second)
+	envelope.Signature = ed25519.Sign(originator.config.PrivateKey, envelope.signingInput())
+
+	_, err = beneficiary.Receive(envelope)
+	assert.Equal(ErrReplayedMessage, err)
+
+	// The timestamp is signed.
+	envelope, err = originator.seal("transfer-3", newTransfer())
+	assert.NoError(err)
+	envelope.Timestamp++
+
+	_, err = beneficiary.Receive(envelope)
+	assert.Equal(ErrInvalidSignature, err)
+}
+
+func TestHTTPTransport(t *testing.T) {
+	assert := assert.New(t)
+
+	originator, beneficiary := newVASPs()
+
+	server := httptest.NewServer(beneficiary)
+	defer server.Close()
+
+	originator.config.Transport = HTTPTransport{
+		Endpoints: map[string]string{
+			"beneficiary-vasp": server.URL,
+		},
+	}
+
+	reply, err := originator.Send("beneficiary-vasp", newTransfer())
+	assert.NoError(err)
+	assert.Equal(Accepted, reply.Status)
+
+	// The beneficiary VASP doesn't trust the originator anymore.
+	delete(beneficiary.config.Peers, "originator-vasp")
+
+	_, err = originator.Send("beneficiary-vasp", newTransfer())
+	assert.EqualError(err, "unknown VASP")
+
+	_, err = HTTPTransport{}.Send("beneficiary-vasp", Envelope{})
+	assert.Equal(ErrUnknownVASP, err)
+}
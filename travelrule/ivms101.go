@@ -0,0 +1,164 @@
+package travelrule
+
+// IdentityPayload represents IVMS101 identity information of the transfer parties.
+type IdentityPayload struct {
+	Originator      Originator       `json:"originator"`
+	Beneficiary     Beneficiary      `json:"beneficiary"`
+	OriginatingVASP *OriginatingVASP `json:"originatingVASP,omitempty"`
+	BeneficiaryVASP *BeneficiaryVASP `json:"beneficiaryVASP,omitempty"`
+}
+
+// Originator represents the account holder who allows the transfer from that account.
+type Originator struct {
+	OriginatorPersons []Person `json:"originatorPersons"`
+	AccountNumber     []string `json:"accountNumber,omitempty"`
+}
+
+// Beneficiary represents the receiver of the requested transfer.
+type Beneficiary struct {
+	BeneficiaryPersons []Person `json:"beneficiaryPersons"`
+	AccountNumber      []string `json:"accountNumber,omitempty"`
+}
+
+// OriginatingVASP represents the VASP which initiates the transfer.
+type OriginatingVASP struct {
+	OriginatingVASP Person `json:"originatingVASP"`
+}
+
+// BeneficiaryVASP represents the VASP which receives the transfer.
+type BeneficiaryVASP struct {
+	BeneficiaryVASP Person `json:"beneficiaryVASP"`
+}
+
+// Person represents either a natural or a legal person.
+type Person struct {
+	NaturalPerson *NaturalPerson `json:"naturalPerson,omitempty"`
+	LegalPerson   *LegalPerson   `json:"legalPerson,omitempty"`
+}
+
+// NaturalPerson represents a uniquely distinguishable individual.
+type NaturalPerson struct {
+	Name                   NaturalPersonName       `json:"name"`
+	GeographicAddress      []Address               `json:"geographicAddress,omitempty"`
+	NationalIdentification *NationalIdentification `json:"nationalIdentification,omitempty"`
+	CustomerIdentification string                  `json:"customerIdentification,omitempty"`
+	DateAndPlaceOfBirth    *DateAndPlaceOfBirth    `json:"dateAndPlaceOfBirth,omitempty"`
+	CountryOfResidence     string                  `json:"countryOfResidence,omitempty"`
+}
+
+// NaturalPersonName represents the names of a natural person.
+type NaturalPersonName struct {
+	NameIdentifier         []NaturalPersonNameID `json:"nameIdentifier"`
+	LocalNameIdentifier    []NaturalPersonNameID `json:"localNameIdentifier,omitempty"`
+	PhoneticNameIdentifier []NaturalPersonNameID `json:"phoneticNameIdentifier,omitempty"`
+}
+
+// NaturalPersonNameID represents a single name of a natural person.
+type NaturalPersonNameID struct {
+	PrimaryIdentifier   string                `json:"primaryIdentifier"`
+	SecondaryIdentifier string                `json:"secondaryIdentifier,omitempty"`
+	NameIdentifierType  NaturalPersonNameType `json:"nameIdentifierType"`
+}
+
+// LegalPerson represents an entity other than a natural person, e.g. a company.
+type LegalPerson struct {
+	Name                   LegalPersonName         `json:"name"`
+	GeographicAddress      []Address               `json:"geographicAddress,omitempty"`
+	CustomerNumber         string                  `json:"customerNumber,omitempty"`
+	NationalIdentification *NationalIdentification `json:"nationalIdentification,omitempty"`
+	CountryOfRegistration  string                  `json:"countryOfRegistration,omitempty"`
+}
+
+// LegalPersonName represents the names of a legal person.
+type LegalPersonName struct {
+	NameIdentifier []LegalPersonNameID `json:"nameIdentifier"`
+}
+
+// LegalPersonNameID represents a single name of a legal person.
+type LegalPersonNameID struct {
+	LegalPersonName               string              `json:"legalPersonName"`
+	LegalPersonNameIdentifierType LegalPersonNameType `json:"legalPersonNameIdentifierType"`
+}
+
+// Address represents the particulars of a physical postal address.
+type Address struct {
+	AddressType        AddressType `json:"addressType"`
+	Department         string      `json:"department,omitempty"`
+	SubDepartment      string      `json:"subDepartment,omitempty"`
+	StreetName         string      `json:"streetName,omitempty"`
+	BuildingNumber     string      `json:"buildingNumber,omitempty"`
+	BuildingName       string      `json:"buildingName,omitempty"`
+	Floor              string      `json:"floor,omitempty"`
+	PostBox            string      `json:"postBox,omitempty"`
+	Room               string      `json:"room,omitempty"`
+	PostCode           string      `json:"postCode,omitempty"`
+	TownName           string      `json:"townName,omitempty"`
+	TownLocationName   string      `json:"townLocationName,omitempty"`
+	DistrictName       string      `json:"districtName,omitempty"`
+	CountrySubDivision string      `json:"countrySubDivision,omitempty"`
+	AddressLine        []string    `json:"addressLine,omitempty"`
+	Country            string      `json:"country"`
+}
+
+// NationalIdentification represents a national identifier of a person.
+type NationalIdentification struct {
+	NationalIdentifier     string                 `json:"nationalIdentifier"`
+	NationalIdentifierType NationalIdentifierType `json:"nationalIdentifierType"`
+	CountryOfIssue         string                 `json:"countryOfIssue,omitempty"`
+	RegistrationAuthority  string                 `json:"registrationAuthority,omitempty"`
+}
+
+// DateAndPlaceOfBirth represents the date and place of birth of a natural person.
+type DateAndPlaceOfBirth struct {
+	DateOfBirth  string `json:"dateOfBirth"`
+	PlaceOfBirth string `json:"placeOfBirth"`
+}
+
+// NaturalPersonNameType represents the nature of a natural person name.
+type NaturalPersonNameType string
+
+// Possible values of NaturalPersonNameType.
+const (
+	AliasName   NaturalPersonNameType = "ALIA"
+	BirthName   NaturalPersonNameType = "BIRT"
+	MaidenName  NaturalPersonNameType = "MAID"
+	LegalName   NaturalPersonNameType = "LEGL"
+	Unspecified NaturalPersonNameType = "MISC"
+)
+
+// LegalPersonNameType represents the nature of a legal person name.
+type LegalPersonNameType string
+
+// Possible values of LegalPersonNameType.
+const (
+	LegalEntityName LegalPersonNameType = "LEGL"
+	ShortName       LegalPersonNameType = "SHRT"
+	TradingName     LegalPersonNameType = "TRAD"
+)
+
+// AddressType represents the nature of an address.
+type AddressType string
+
+// Possible values of AddressType.
+const (
+	Residential AddressType = "HOME"
+	Business    AddressType = "BIZZ"
+	Geographic  AddressType = "GEOG"
+)
+
+// NationalIdentifierType represents the kind of a national identifier.
+type NationalIdentifierType string
+
+// Possible values of NationalIdentifierType.
+const (
+	AlienRegistrationNumber       NationalIdentifierType = "ARNU"
+	PassportNumber                NationalIdentifierType = "CCPT"
+	RegistrationAuthorityID       NationalIdentifierType = "RAID"
+	DriverLicenseNumber           NationalIdentifierType = "DRLC"
+	ForeignInvestmentIdentity     NationalIdentifierType = "FIIN"
+	TaxIdentificationNumber       NationalIdentifierType = "TXID"
+	SocialSecurityNumber          NationalIdentifierType = "SOCS"
+	IdentityCardNumber            NationalIdentifierType = "IDCD"
+	LegalEntityIdentifier         NationalIdentifierType = "LEIX"
+	UnspecifiedNationalIdentifier NationalIdentifierType = "MISC"
+)
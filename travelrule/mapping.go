@@ -0,0 +1,178 @@
+package travelrule
+
+import (
+	"strings"
+	"time"
+
+	"modulus/kyc/common"
+)
+
+// PersonFromUserData maps the customer data into IVMS101 person.
+// The customer is treated as a legal person if there is no personal name but company data is present.
+func PersonFromUserData(user *common.UserData) Person {
+	hasName := len(user.FirstName) > 0 || len(user.LastName) > 0 || len(user.FullName) > 0
+	hasCompany := len(user.CompanyName) > 0 || user.Business != nil
+
+	if !hasName && hasCompany {
+		return Person{
+			LegalPerson: LegalPersonFromUserData(user),
+		}
+	}
+
+	return Person{
+		NaturalPerson: NaturalPersonFromUserData(user),
+	}
+}
+
+// NaturalPersonFromUserData maps the customer data into IVMS101 natural person.
+func NaturalPersonFromUserData(user *common.UserData) *NaturalPerson {
+	person := &NaturalPerson{
+		Name:                   naturalPersonName(user),
+		GeographicAddress:      addresses(user, Residential),
+		NationalIdentification: nationalIdentification(user),
+		CountryOfResidence:     user.CountryAlpha2,
+	}
+
+	if dob := time.Time(user.DateOfBirth); !dob.IsZero() {
+		place := user.PlaceOfBirth
+		if len(place) == 0 {
+			place = user.CountryOfBirthAlpha2
+		}
+		person.DateAndPlaceOfBirth = &DateAndPlaceOfBirth{
+			DateOfBirth:  dob.Format("2006-01-02"),
+			PlaceOfBirth: place,
+		}
+	}
+
+	return person
+}
+
+// LegalPersonFromUserData maps the customer company data into IVMS101 legal person.
+func LegalPersonFromUserData(user *common.UserData) *LegalPerson {
+	name := user.CompanyName
+	if user.Business != nil && len(user.Business.Name) > 0 {
+		name = user.Business.Name
+	}
+
+	person := &LegalPerson{
+		Name: LegalPersonName{
+			NameIdentifier: []LegalPersonNameID{
+				{
+					LegalPersonName:               name,
+					LegalPersonNameIdentifierType: LegalEntityName,
+				},
+			},
+		},
+		GeographicAddress:     addresses(user, Geographic),
+		CountryOfRegistration: user.CountryAlpha2,
+	}
+
+	if user.Business != nil && len(user.Business.RegistrationNumber) > 0 {
+		person.NationalIdentification = &NationalIdentification{
+			NationalIdentifier:     user.Business.RegistrationNumber,
+			NationalIdentifierType: RegistrationAuthorityID,
+			CountryOfIssue:         user.CountryAlpha2,
+		}
+	}
+
+	return person
+}
+
+// naturalPersonName composes the legal name of the customer.
+func naturalPersonName(user *common.UserData) NaturalPersonName {
+	id := NaturalPersonNameID{
+		NameIdentifierType: LegalName,
+	}
+
+	if len(user.LastName) > 0 {
+		id.PrimaryIdentifier = strings.TrimSpace(user.LastName + " " + user.MaternalLastName)
+		id.SecondaryIdentifier = strings.TrimSpace(user.FirstName + " " + user.MiddleName)
+	} else {
+		id.PrimaryIdentifier = user.Fullname()
+	}
+
+	return NaturalPersonName{
+		NameIdentifier: []NaturalPersonNameID{id},
+	}
+}
+
+// addresses maps the current and supplemental addresses of the customer skipping empty ones.
+// The current address gets the specified type, supplemental ones are considered as geographic.
+func addresses(user *common.UserData, currentType AddressType) (result []Address) {
+	if user.CurrentAddress != (common.Address{}) {
+		result = append(result, address(user.CurrentAddress, currentType))
+	}
+	for _, a := range user.SupplementalAddresses {
+		if a != (common.Address{}) {
+			result = append(result, address(a, Geographic))
+		}
+	}
+
+	return
+}
+
+// address maps the customer address into IVMS101 address.
+func address(a common.Address, addressType AddressType) Address {
+	street := a.Street
+	if len(a.StreetType) > 0 {
+		street = strings.TrimSpace(street + " " + a.StreetType)
+	}
+
+	result := Address{
+		AddressType:        addressType,
+		StreetName:         street,
+		BuildingNumber:     a.BuildingNumber,
+		BuildingName:       a.BuildingName,
+		Room:               a.FlatNumber,
+		PostBox:            a.PostOfficeBox,
+		PostCode:           a.PostCode,
+		TownName:           a.Town,
+		TownLocationName:   a.Suburb,
+		DistrictName:       a.County,
+		CountrySubDivision: a.State,
+		Country:            a.CountryAlpha2,
+	}
+	if len(a.SubStreet) > 0 {
+		result.AddressLine = []string{a.SubStreet}
+	}
+
+	return result
+}
+
+// nationalIdentification picks the most reliable identity document of the customer.
+func nationalIdentification(user *common.UserData) *NationalIdentification {
+	switch {
+	case user.Passport != nil && len(user.Passport.Number) > 0:
+		return &NationalIdentification{
+			NationalIdentifier:     user.Passport.Number,
+			NationalIdentifierType: PassportNumber,
+			CountryOfIssue:         user.Passport.CountryAlpha2,
+		}
+	case user.IDCard != nil && len(user.IDCard.Number) > 0:
+		return &NationalIdentification{
+			NationalIdentifier:     user.IDCard.Number,
+			NationalIdentifierType: IdentityCardNumber,
+			CountryOfIssue:         user.IDCard.CountryAlpha2,
+		}
+	case user.DriverLicense != nil && len(user.DriverLicense.Number) > 0:
+		return &NationalIdentification{
+			NationalIdentifier:     user.DriverLicense.Number,
+			NationalIdentifierType: DriverLicenseNumber,
+			CountryOfIssue:         user.DriverLicense.CountryAlpha2,
+		}
+	case user.TaxID != nil && len(user.TaxID.Number) > 0:
+		return &NationalIdentification{
+			NationalIdentifier:     user.TaxID.Number,
+			NationalIdentifierType: TaxIdentificationNumber,
+			CountryOfIssue:         user.CountryAlpha2,
+		}
+	case user.SocialServiceID != nil && len(user.SocialServiceID.Number) > 0:
+		return &NationalIdentification{
+			NationalIdentifier:     user.SocialServiceID.Number,
+			NationalIdentifierType: SocialSecurityNumber,
+			CountryOfIssue:         user.CountryAlpha2,
+		}
+	}
+
+	return nil
+}
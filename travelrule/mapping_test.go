@@ -0,0 +1,137 @@
+package travelrule
+
+import (
+	"testing"
+	"time"
+
+	"modulus/kyc/common"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersonFromUserData(t *testing.T) {
+	assert := assert.New(t)
+
+	user := &common.UserData{
+		FirstName:        "John",
+		MiddleName:       "Fitzgerald",
+		LastName:         "Doe",
+		MaternalLastName: "Smith",
+		DateOfBirth:      common.Time(time.Date(1980, 5, 14, 0, 0, 0, 0, time.UTC)),
+		PlaceOfBirth:     "London",
+		CountryAlpha2:    "GB",
+		CurrentAddress: common.Address{
+			CountryAlpha2:  "GB",
+			State:          "England",
+			Town:           "London",
+			Street:         "Baker",
+			StreetType:     "Street",
+			BuildingNumber: "221B",
+			PostCode:       "NW1 6XE",
+		},
+		SupplementalAddresses: []common.Address{
+			{},
+			{
+				CountryAlpha2: "FR",
+				Town:          "Paris",
+				SubStreet:     "12 Rue de Rivoli",
+			},
+		},
+		IDCard: &common.IDCard{
+			Number:        "ID123",
+			CountryAlpha2: "GB",
+		},
+		Passport: &common.Passport{
+			Number:        "P123456",
+			CountryAlpha2: "GB",
+		},
+	}
+
+	person := PersonFromUserData(user)
+	assert.Nil(person.LegalPerson)
+	if !assert.NotNil(person.NaturalPerson) {
+		return
+	}
+
+	np := person.NaturalPerson
+	assert.Equal([]NaturalPersonNameID{
+		{
+			PrimaryIdentifier:   "Doe Smith",
+			SecondaryIdentifier: "John Fitzgerald",
+			NameIdentifierType:  LegalName,
+		},
+	}, np.Name.NameIdentifier)
+	assert.Equal([]Address{
+		{
+			AddressType:        Residential,
+			StreetName:         "Baker Street",
+			BuildingNumber:     "221B",
+			PostCode:           "NW1 6XE",
+			TownName:           "London",
+			CountrySubDivision: "England",
+			Country:            "GB",
+		},
+		{
+			AddressType: Geographic,
+			TownName:    "Paris",
+			AddressLine: []string{"12 Rue de Rivoli"},
+			Country:     "FR",
+		},
+	}, np.GeographicAddress)
+	assert.Equal(&NationalIdentification{
+		NationalIdentifier:     "P123456",
+		NationalIdentifierType: PassportNumber,
+		CountryOfIssue:         "GB",
+	}, np.NationalIdentification)
+	assert.Equal(&DateAndPlaceOfBirth{
+		DateOfBirth:  "1980-05-14",
+		PlaceOfBirth: "London",
+	}, np.DateAndPlaceOfBirth)
+	assert.Equal("GB", np.CountryOfResidence)
+
+	user = &common.UserData{
+		FullName: "John Doe",
+	}
+
+	person = PersonFromUserData(user)
+	if assert.NotNil(person.NaturalPerson) {
+		assert.Equal("John Doe", person.NaturalPerson.Name.NameIdentifier[0].PrimaryIdentifier)
+		assert.Empty(person.NaturalPerson.Name.NameIdentifier[0].SecondaryIdentifier)
+		assert.Nil(person.NaturalPerson.GeographicAddress)
+		assert.Nil(person.NaturalPerson.NationalIdentification)
+		assert.Nil(person.NaturalPerson.DateAndPlaceOfBirth)
+	}
+
+	user = &common.UserData{
+		CompanyName:   "Acme",
+		CountryAlpha2: "US",
+		Business: &common.Business{
+			Name:               "Acme Inc.",
+			RegistrationNumber: "REG-42",
+		},
+		CurrentAddress: common.Address{
+			CountryAlpha2:  "US",
+			Street:         "Main St",
+			BuildingNumber: "1",
+		},
+	}
+
+	person = PersonFromUserData(user)
+	assert.Nil(person.NaturalPerson)
+	if assert.NotNil(person.LegalPerson) {
+		lp := person.LegalPerson
+		assert.Equal([]LegalPersonNameID{
+			{
+				LegalPersonName:               "Acme Inc.",
+				LegalPersonNameIdentifierType: LegalEntityName,
+			},
+		}, lp.Name.NameIdentifier)
+		assert.Equal(Geographic, lp.GeographicAddress[0].AddressType)
+		assert.Equal(&NationalIdentification{
+			NationalIdentifier:     "REG-42",
+			NationalIdentifierType: RegistrationAuthorityID,
+			CountryOfIssue:         "US",
+		}, lp.NationalIdentification)
+		assert.Equal("US", lp.CountryOfRegistration)
+	}
+}
@@ -0,0 +1,66 @@
+package travelrule
+
+import (
+	"encoding/json"
+	stdhttp "net/http"
+
+	"modulus/kyc/http"
+)
+
+var (
+	_ Transport = HTTPTransport{}
+	_ Transport = LocalTransport{}
+	_ Transport = Mock{}
+)
+
+// HTTPTransport delivers messages to counterparty VASPs over HTTP.
+// Endpoints holds the URLs of the counterparty exchanges by VASP ids.
+type HTTPTransport struct {
+	Endpoints map[string]string
+}
+
+// Send implements Transport interface for the HTTPTransport.
+func (t HTTPTransport) Send(vaspID string, envelope Envelope) (reply Envelope, err error) {
+	endpoint, ok := t.Endpoints[vaspID]
+	if !ok {
+		err = ErrUnknownVASP
+		return
+	}
+
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		return
+	}
+
+	headers := http.Headers{
+		"Content-Type": "application/json",
+	}
+
+	code, resp, err := http.Post(endpoint, headers, body)
+	if err != nil {
+		return
+	}
+
+	if code != stdhttp.StatusOK {
+		err = errorFromResponse(resp)
+		return
+	}
+
+	err = json.Unmarshal(resp, &reply)
+
+	return
+}
+
+// LocalTransport delivers messages to exchanges within the same process.
+// It's useful for testing against a stand-in counterparty VASP.
+type LocalTransport map[string]*Exchange
+
+// Send implements Transport interface for the LocalTransport.
+func (t LocalTransport) Send(vaspID string, envelope Envelope) (Envelope, error) {
+	exchange, ok := t[vaspID]
+	if !ok {
+		return Envelope{}, ErrUnknownVASP
+	}
+
+	return exchange.Receive(envelope)
+}
@@ -0,0 +1,225 @@
+package travelrule
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// countryRe describes ISO 3166-1 alpha-2 country code.
+var countryRe = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// ValidationError represents violations of the travel rule requirements found in the transfer.
+type ValidationError struct {
+	Violations []string
+}
+
+// Error implements the error interface for the ValidationError.
+func (e ValidationError) Error() string {
+	return "invalid IVMS101 payload: " + strings.Join(e.Violations, "; ")
+}
+
+// Validate checks the transfer against the travel rule requirements.
+// Starting from the threshold fiat value the full originator information is required, it's required as well
+// if the fiat value of the transfer is unknown.
+func (t Transfer) Validate(threshold decimal.Decimal) error {
+	v := &validator{}
+
+	if len(t.Asset) == 0 {
+		v.fail("asset", "is required")
+	}
+	if !t.Amount.IsPositive() {
+		v.fail("amount", "must be positive")
+	}
+	if t.FiatAmount.IsNegative() {
+		v.fail("fiatAmount", "must not be negative")
+	}
+
+	t.Identity.validate(v, t.FiatAmount.IsZero() || t.FiatAmount.GreaterThanOrEqual(threshold))
+
+	return v.err()
+}
+
+// Validate checks the identity payload against the IVMS101 constraints.
+// If full is true the originator must be identified by an address, an identifier or the date and place of birth
+// in addition to the name and the account number.
+func (p IdentityPayload) Validate(full bool) error {
+	v := &validator{}
+	p.validate(v, full)
+
+	return v.err()
+}
+
+// validate collects violations of the identity payload.
+func (p IdentityPayload) validate(v *validator, full bool) {
+	if len(p.Originator.OriginatorPersons) == 0 {
+		v.fail("originator", "at least one person is required")
+	}
+	if len(p.Originator.AccountNumber) == 0 {
+		v.fail("originator.accountNumber", "is required")
+	}
+	for i, person := range p.Originator.OriginatorPersons {
+		person.validate(v, fmt.Sprintf("originator.originatorPersons[%d]", i), full)
+	}
+
+	if len(p.Beneficiary.BeneficiaryPersons) == 0 {
+		v.fail("beneficiary", "at least one person is required")
+	}
+	if len(p.Beneficiary.AccountNumber) == 0 {
+		v.fail("beneficiary.accountNumber", "is required")
+	}
+	for i, person := range p.Beneficiary.BeneficiaryPersons {
+		person.validate(v, fmt.Sprintf("beneficiary.beneficiaryPersons[%d]", i), false)
+	}
+
+	if p.OriginatingVASP != nil {
+		p.OriginatingVASP.OriginatingVASP.validate(v, "originatingVASP", false)
+	}
+	if p.BeneficiaryVASP != nil {
+		p.BeneficiaryVASP.BeneficiaryVASP.validate(v, "beneficiaryVASP", false)
+	}
+}
+
+// validate collects violations of the person.
+func (p Person) validate(v *validator, path string, identified bool) {
+	switch {
+	case p.NaturalPerson != nil && p.LegalPerson != nil:
+		v.fail(path, "must be either a natural or a legal person")
+	case p.NaturalPerson != nil:
+		p.NaturalPerson.validate(v, path+".naturalPerson", identified)
+	case p.LegalPerson != nil:
+		p.LegalPerson.validate(v, path+".legalPerson", identified)
+	default:
+		v.fail(path, "is empty")
+	}
+}
+
+// validate collects violations of the natural person.
+func (p NaturalPerson) validate(v *validator, path string, identified bool) {
+	hasLegalName := false
+	for i, id := range p.Name.NameIdentifier {
+		if len(id.PrimaryIdentifier) == 0 {
+			v.fail(fmt.Sprintf("%s.name.nameIdentifier[%d].primaryIdentifier", path, i), "is required")
+		}
+		if id.NameIdentifierType == LegalName {
+			hasLegalName = true
+		}
+	}
+	if !hasLegalName {
+		v.fail(path+".name", "legal name is required")
+	}
+
+	for i, a := range p.GeographicAddress {
+		a.validate(v, fmt.Sprintf("%s.geographicAddress[%d]", path, i))
+	}
+	if p.NationalIdentification != nil {
+		p.NationalIdentification.validate(v, path+".nationalIdentification")
+	}
+	if p.DateAndPlaceOfBirth != nil {
+		p.DateAndPlaceOfBirth.validate(v, path+".dateAndPlaceOfBirth")
+	}
+	if len(p.CountryOfResidence) > 0 && !countryRe.MatchString(p.CountryOfResidence) {
+		v.fail(path+".countryOfResidence", "must be ISO 3166-1 alpha-2 code")
+	}
+
+	if identified &&
+		len(p.GeographicAddress) == 0 &&
+		p.NationalIdentification == nil &&
+		len(p.CustomerIdentification) == 0 &&
+		p.DateAndPlaceOfBirth == nil {
+		v.fail(path, "address, national identification, customer identification or date and place of birth is required")
+	}
+}
+
+// validate collects violations of the legal person.
+func (p LegalPerson) validate(v *validator, path string, identified bool) {
+	hasLegalName := false
+	for i, id := range p.Name.NameIdentifier {
+		if len(id.LegalPersonName) == 0 {
+			v.fail(fmt.Sprintf("%s.name.nameIdentifier[%d].legalPersonName", path, i), "is required")
+		}
+		if id.LegalPersonNameIdentifierType == LegalEntityName {
+			hasLegalName = true
+		}
+	}
+	if !hasLegalName {
+		v.fail(path+".name", "legal name is required")
+	}
+
+	for i, a := range p.GeographicAddress {
+		a.validate(v, fmt.Sprintf("%s.geographicAddress[%d]", path, i))
+	}
+	if p.NationalIdentification != nil {
+		p.NationalIdentification.validate(v, path+".nationalIdentification")
+	}
+	if len(p.CountryOfRegistration) > 0 && !countryRe.MatchString(p.CountryOfRegistration) {
+		v.fail(path+".countryOfRegistration", "must be ISO 3166-1 alpha-2 code")
+	}
+
+	if identified &&
+		len(p.GeographicAddress) == 0 &&
+		p.NationalIdentification == nil &&
+		len(p.CustomerNumber) == 0 {
+		v.fail(path, "address, national identification or customer number is required")
+	}
+}
+
+// validate collects violations of the address.
+func (a Address) validate(v *validator, path string) {
+	if len(a.AddressType) == 0 {
+		v.fail(path+".addressType", "is required")
+	}
+	if !countryRe.MatchString(a.Country) {
+		v.fail(path+".country", "must be ISO 3166-1 alpha-2 code")
+	}
+	if len(a.AddressLine) == 0 && (len(a.StreetName) == 0 || len(a.BuildingName) == 0 && len(a.BuildingNumber) == 0) {
+		v.fail(path, "address line or street name with building name or number is required")
+	}
+}
+
+// validate collects violations of the national identification.
+func (n NationalIdentification) validate(v *validator, path string) {
+	if len(n.NationalIdentifier) == 0 {
+		v.fail(path+".nationalIdentifier", "is required")
+	}
+	if len(n.NationalIdentifierType) == 0 {
+		v.fail(path+".nationalIdentifierType", "is required")
+	}
+	if len(n.CountryOfIssue) > 0 && !countryRe.MatchString(n.CountryOfIssue) {
+		v.fail(path+".countryOfIssue", "must be ISO 3166-1 alpha-2 code")
+	}
+}
+
+// validate collects violations of the date and place of birth.
+func (d DateAndPlaceOfBirth) validate(v *validator, path string) {
+	dob, err := time.Parse("2006-01-02", d.DateOfBirth)
+	if err != nil {
+		v.fail(path+".dateOfBirth", "must be a date in YYYY-MM-DD format")
+	} else if !dob.Before(time.Now()) {
+		v.fail(path+".dateOfBirth", "must be in the past")
+	}
+	if len(d.PlaceOfBirth) == 0 {
+		v.fail(path+".placeOfBirth", "is required")
+	}
+}
+
+// validator accumulates violations.
+type validator struct {
+	violations []string
+}
+
+// fail records the violation of the field.
+func (v *validator) fail(path, message string) {
+	v.violations = append(v.violations, path+": "+message)
+}
+
+// err returns ValidationError if there are any violations.
+func (v *validator) err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+	return ValidationError{Violations: v.violations}
+}
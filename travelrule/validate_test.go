@@ -0,0 +1,119 @@
+package travelrule
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	transfer := Transfer{
+		Asset:      "BTC",
+		Amount:     decimal.NewFromFloat(0.5),
+		FiatAmount: decimal.NewFromInt(500),
+		Identity: IdentityPayload{
+			Originator: Originator{
+				OriginatorPersons: []Person{
+					{
+						NaturalPerson: &NaturalPerson{
+							Name: NaturalPersonName{
+								NameIdentifier: []NaturalPersonNameID{
+									{
+										PrimaryIdentifier:   "Doe",
+										SecondaryIdentifier: "John",
+										NameIdentifierType:  LegalName,
+									},
+								},
+							},
+						},
+					},
+				},
+				AccountNumber: []string{"bc1q9vza2e8x573nczrlzms0wvx3gsqjx7vavgkx0l"},
+			},
+			Beneficiary: Beneficiary{
+				BeneficiaryPersons: []Person{
+					{
+						LegalPerson: &LegalPerson{
+							Name: LegalPersonName{
+								NameIdentifier: []LegalPersonNameID{
+									{
+										LegalPersonName:               "Acme Inc.",
+										LegalPersonNameIdentifierType: LegalEntityName,
+									},
+								},
+							},
+						},
+					},
+				},
+				AccountNumber: []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+			},
+		},
+	}
+
+	assert.NoError(transfer.Validate(DefaultThreshold))
+
+	err := transfer.Validate(decimal.NewFromInt(500))
+	assert.EqualError(err, "invalid IVMS101 payload: originator.originatorPersons[0].naturalPerson: address, national identification, customer identification or date and place of birth is required")
+
+	transfer.Identity.Originator.OriginatorPersons[0].NaturalPerson.DateAndPlaceOfBirth = &DateAndPlaceOfBirth{
+		DateOfBirth:  "14.05.1980",
+		PlaceOfBirth: "London",
+	}
+
+	err = transfer.Validate(decimal.NewFromInt(500))
+	assert.EqualError(err, "invalid IVMS101 payload: originator.originatorPersons[0].naturalPerson.dateAndPlaceOfBirth.dateOfBirth: must be a date in YYYY-MM-DD format")
+
+	transfer.Identity.Originator.OriginatorPersons[0].NaturalPerson.DateAndPlaceOfBirth.DateOfBirth = "1980-05-14"
+
+	assert.NoError(transfer.Validate(decimal.NewFromInt(500)))
+
+	// The threshold applies to the fiat value rather than to the amount of the asset.
+	transfer.Identity.Originator.OriginatorPersons[0].NaturalPerson.DateAndPlaceOfBirth = nil
+	transfer.FiatAmount = decimal.NewFromInt(30000)
+
+	assert.Error(transfer.Validate(DefaultThreshold))
+
+	// The unknown fiat value requires the full information.
+	transfer.FiatAmount = decimal.Zero
+
+	assert.Error(transfer.Validate(DefaultThreshold))
+
+	transfer.Asset = ""
+	transfer.FiatAmount = decimal.NewFromInt(-1)
+	transfer.Amount = decimal.Zero
+	transfer.Identity.Beneficiary.AccountNumber = nil
+	transfer.Identity.Beneficiary.BeneficiaryPersons[0].NaturalPerson = &NaturalPerson{}
+	transfer.Identity.Originator.OriginatorPersons[0].NaturalPerson.GeographicAddress = []Address{
+		{
+			AddressType: Residential,
+			TownName:    "London",
+			Country:     "GBR",
+		},
+	}
+
+	err = transfer.Validate(DefaultThreshold)
+	if assert.IsType(ValidationError{}, err) {
+		assert.Equal([]string{
+			"asset: is required",
+			"amount: must be positive",
+			"fiatAmount: must not be negative",
+			"originator.originatorPersons[0].naturalPerson.geographicAddress[0].country: must be ISO 3166-1 alpha-2 code",
+			"originator.originatorPersons[0].naturalPerson.geographicAddress[0]: address line or street name with building name or number is required",
+			"beneficiary.accountNumber: is required",
+			"beneficiary.beneficiaryPersons[0]: must be either a natural or a legal person",
+		}, err.(ValidationError).Violations)
+	}
+
+	err = IdentityPayload{}.Validate(false)
+	if assert.IsType(ValidationError{}, err) {
+		assert.Equal([]string{
+			"originator: at least one person is required",
+			"originator.accountNumber: is required",
+			"beneficiary: at least one person is required",
+			"beneficiary.accountNumber: is required",
+		}, err.(ValidationError).Violations)
+	}
+}
@@ -0,0 +1,151 @@
+package ttlcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// errLoadFailed is returned to the callers waiting for the load which has panicked.
+var errLoadFailed = errors.New("loading of the cached value failed")
+
+// refreshAhead is the part of the lifetime of the value when it's refreshed in the background before it expires.
+const refreshAhead = 5
+
+// Loader loads the value to cache for the returned time.
+// The status is the HTTP status of the failed request to the provider if it's known.
+type Loader func() (value interface{}, ttl time.Duration, status *int, err error)
+
+// entry holds the cached value.
+type entry struct {
+	value      interface{}
+	expiresAt  time.Time
+	refreshAt  time.Time
+	refreshing bool
+}
+
+// call represents the loading in flight. The done channel is closed when it has completed.
+type call struct {
+	done   chan struct{}
+	value  interface{}
+	status *int
+	err    error
+}
+
+// Cache holds the auth tokens and the reference data of the providers until they expire.
+// The concurrent loads of the same key are coalesced, the values about to expire are refreshed in the background.
+// It's safe for concurrent use.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[string]*entry
+	calls   map[string]*call
+}
+
+// New constructs a new empty cache.
+func New() *Cache {
+	return &Cache{
+		entries: map[string]*entry{},
+		calls:   map[string]*call{},
+	}
+}
+
+// Key forms the cache key from the parts, e.g. the host and the credentials.
+// The parts are hashed so the secrets aren't kept in the cache as is.
+func Key(parts ...string) string {
+	hash := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(hash[:])
+}
+
+// Get returns the value cached by the key or loads it if it's missing or expired.
+// The value which has lived the most of its lifetime is returned at once and reloaded in the background.
+// The failed loads aren't cached.
+func (c *Cache) Get(key string, load Loader) (value interface{}, status *int, err error) {
+	c.mu.Lock()
+
+	now := time.Now()
+	if e, ok := c.entries[key]; ok && now.Before(e.expiresAt) {
+		if !now.Before(e.refreshAt) && !e.refreshing {
+			e.refreshing = true
+			go c.refresh(key, e, load)
+		}
+		c.mu.Unlock()
+
+		return e.value, nil, nil
+	}
+
+	if cl, ok := c.calls[key]; ok {
+		c.mu.Unlock()
+		<-cl.done
+
+		return cl.value, cl.status, cl.err
+	}
+
+	// The error stays if the load panics, so the waiters don't get the nil value.
+	cl := &call{done: make(chan struct{}), err: errLoadFailed}
+	c.calls[key] = cl
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		delete(c.calls, key)
+		close(cl.done)
+	}()
+
+	var ttl time.Duration
+	cl.value, ttl, cl.status, cl.err = load()
+	if cl.err == nil {
+		c.mu.Lock()
+		c.set(key, cl.value, ttl)
+		c.mu.Unlock()
+	}
+
+	return cl.value, cl.status, cl.err
+}
+
+// Invalidate drops the value cached by the key, e.g. the token rejected by the provider.
+func (c *Cache) Invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// set caches the value for the specified time. The caller must hold the lock.
+func (c *Cache) set(key string, value interface{}, ttl time.Duration) {
+	now := time.Now()
+
+	c.removeExpired(now)
+	c.entries[key] = &entry{
+		value:     value,
+		expiresAt: now.Add(ttl),
+		refreshAt: now.Add(ttl - ttl/refreshAhead),
+	}
+}
+
+// refresh reloads the value of the entry in the background.
+// The refreshed value is dropped if the entry has been invalidated or replaced meanwhile.
+func (c *Cache) refresh(key string, e *entry, load Loader) {
+	value, ttl, _, err := load()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e.refreshing = false
+	if err == nil && c.entries[key] == e {
+		c.set(key, value, ttl)
+	}
+}
+
+// removeExpired drops the expired values. The caller must hold the lock.
+func (c *Cache) removeExpired(now time.Time) {
+	for key, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package ttlcache
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// counter returns the loader of the numbers of its calls.
+func counter(calls *int32, ttl time.Duration) Loader {
+	return func() (interface{}, time.Duration, *int, error) {
+		return atomic.AddInt32(calls, 1), ttl, nil, nil
+	}
+}
+
+func TestCache(t *testing.T) {
+	assert := assert.New(t)
+
+	c := New()
+	calls := int32(0)
+
+	for i := 0; i < 3; i++ {
+		value, status, err := c.Get("key", counter(&calls, time.Hour))
+
+		assert.NoError(err)
+		assert.Nil(status)
+		assert.Equal(int32(1), value)
+	}
+
+	c.Invalidate("key")
+	value, _, _ := c.Get("key", counter(&calls, time.Hour))
+
+	assert.Equal(int32(2), value)
+
+	// The failed loads aren't cached.
+	code := http.StatusUnauthorized
+	_, status, err := c.Get("failing", func() (interface{}, time.Duration, *int, error) {
+		return nil, time.Hour, &code, errors.New("unauthorized")
+	})
+
+	assert.EqualError(err, "unauthorized")
+	assert.Equal(&code, status)
+
+	value, _, err = c.Get("failing", counter(&calls, time.Hour))
+
+	assert.NoError(err)
+	assert.Equal(int32(3), value)
+
+	// The expired values are loaded again.
+	c.Get("expiring", counter(&calls, 10*time.Millisecond))
+	time.Sleep(20 * time.Millisecond)
+	value, _, _ = c.Get("expiring", counter(&calls, 10*time.Millisecond))
+
+	assert.Equal(int32(5), value)
+}
+
+func TestCacheRefreshAhead(t *testing.T) {
+	assert := assert.New(t)
+
+	c := New()
+	calls := int32(0)
+
+	c.Get("key", counter(&calls, 100*time.Millisecond))
+	time.Sleep(90 * time.Millisecond)
+
+	// The value about to expire is returned at once and refreshed in the background.
+	value, _, _ := c.Get("key", counter(&calls, 100*time.Millisecond))
+
+	assert.Equal(int32(1), value)
+
+	deadline := time.Now().Add(time.Second)
+	for atomic.LoadInt32(&calls) < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	value, _, _ = c.Get("key", counter(&calls, 100*time.Millisecond))
+
+	assert.Equal(int32(2), value)
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	c := New()
+	calls := int32(0)
+	release := make(chan struct{})
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, _, err := c.Get("key", func() (interface{}, time.Duration, *int, error) {
+				<-release
+				return atomic.AddInt32(&calls, 1), time.Hour, nil, nil
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, int32(1), value)
+		}()
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestKey(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(Key("host", "user", "password"), Key("host", "user", "password"))
+	assert.NotEqual(Key("host", "user", "password"), Key("host", "userpassword", ""))
+	assert.NotContains(Key("host", "user", "password"), "password")
+}
@@ -0,0 +1,165 @@
+package upload
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Options represents the upload options of the KYC provider.
+type Options struct {
+	// Parallelism limits the number of the documents uploaded at once. The documents are uploaded one by one by default.
+	Parallelism int
+	// Rate limits the number of the uploads started per second. The rate is unlimited if it's zero.
+	Rate float64
+}
+
+// Task uploads a single document. The code is the HTTP status of the failed request to the provider if it's known.
+type Task func() (code *int, err error)
+
+// Executor uploads the documents of the customers with the bounded parallelism at the limited rate.
+// The rate is shared by all executors constructed with the same key, so the limit holds across the requests
+// of the same provider account.
+type Executor struct {
+	parallelism int
+	limiter     *limiter
+}
+
+// limiters holds the rate limiters shared by the executors.
+var limiters = struct {
+	sync.Mutex
+	byKey map[string]*limiter
+}{byKey: map[string]*limiter{}}
+
+// New constructs a new upload executor. The key identifies the provider account, e.g. its host and API key.
+// The key is hashed, so the credentials aren't kept in the limiters as is.
+func New(key string, opts Options) Executor {
+	e := Executor{parallelism: opts.Parallelism}
+	if e.parallelism <= 0 {
+		e.parallelism = 1
+	}
+
+	if opts.Rate > 0 {
+		hash := sha256.Sum256([]byte(key + "\x00" + strconv.FormatFloat(opts.Rate, 'g', -1, 64)))
+		key = hex.EncodeToString(hash[:])
+
+		limiters.Lock()
+		defer limiters.Unlock()
+
+		e.limiter = limiters.byKey[key]
+		if e.limiter == nil {
+			e.limiter = &limiter{interval: time.Duration(float64(time.Second) / opts.Rate)}
+			limiters.byKey[key] = e.limiter
+		}
+	}
+
+	return e
+}
+
+// Run runs the upload tasks and waits for their completion. The tasks not started yet are skipped after the failure.
+// It returns the index of the first failed task in the order given along with its code and error, or -1 if all succeeded.
+func (e Executor) Run(tasks []Task) (failed int, code *int, err error) {
+	failed = -1
+	if len(tasks) == 0 {
+		return
+	}
+
+	codes := make([]*int, len(tasks))
+	errs := make([]error, len(tasks))
+
+	if e.parallelism <= 1 || len(tasks) == 1 {
+		for i, task := range tasks {
+			e.wait()
+			if codes[i], errs[i] = task(); errs[i] != nil {
+				break
+			}
+		}
+	} else {
+		e.runParallel(tasks, codes, errs)
+	}
+
+	for i := range errs {
+		if errs[i] != nil {
+			failed, code, err = i, codes[i], errs[i]
+			return
+		}
+	}
+
+	return
+}
+
+// runParallel runs the tasks by the pool of workers until one of them fails.
+func (e Executor) runParallel(tasks []Task, codes []*int, errs []error) {
+	workers := e.parallelism
+	if workers > len(tasks) {
+		workers = len(tasks)
+	}
+
+	var mu sync.Mutex
+	next, stopped := 0, false
+	take := func() (i int, ok bool) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if stopped || next == len(tasks) {
+			return
+		}
+		i, ok = next, true
+		next++
+
+		return
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				i, ok := take()
+				if !ok {
+					return
+				}
+
+				e.wait()
+				codes[i], errs[i] = tasks[i]()
+				if errs[i] != nil {
+					mu.Lock()
+					stopped = true
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// wait blocks until the next upload may be started.
+func (e Executor) wait() {
+	if e.limiter != nil {
+		e.limiter.wait()
+	}
+}
+
+// limiter spaces the uploads evenly at the rate limited.
+type limiter struct {
+	interval time.Duration
+	mu       sync.Mutex
+	next     time.Time
+}
+
+// wait reserves the next slot and sleeps until it comes.
+func (l *limiter) wait() {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	slot := l.next
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	time.Sleep(slot.Sub(now))
+}
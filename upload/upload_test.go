@@ -0,0 +1,112 @@
+package upload
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// tracker returns the tasks recording the highest number of the uploads running at once.
+func tracker(n int, running, peak *int32, started *int32) []Task {
+	tasks := make([]Task, n)
+	for i := range tasks {
+		tasks[i] = func() (*int, error) {
+			atomic.AddInt32(started, 1)
+			current := atomic.AddInt32(running, 1)
+			defer atomic.AddInt32(running, -1)
+
+			for {
+				max := atomic.LoadInt32(peak)
+				if current <= max || atomic.CompareAndSwapInt32(peak, max, current) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+
+			return nil, nil
+		}
+	}
+
+	return tasks
+}
+
+func TestExecutorParallelism(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, parallelism := range []int{0, 1, 3} {
+		running, peak, started := int32(0), int32(0), int32(0)
+
+		failed, code, err := New("parallelism", Options{Parallelism: parallelism}).Run(tracker(6, &running, &peak, &started))
+
+		assert.NoError(err)
+		assert.Nil(code)
+		assert.Equal(-1, failed)
+		assert.Equal(int32(6), started)
+
+		expected := int32(parallelism)
+		if expected == 0 {
+			expected = 1
+		}
+		assert.Equal(expected, peak, "parallelism %d", parallelism)
+	}
+}
+
+func TestExecutorFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, parallelism := range []int{1, 2} {
+		started := int32(0)
+		tasks := make([]Task, 8)
+		for i := range tasks {
+			i := i
+			tasks[i] = func() (*int, error) {
+				atomic.AddInt32(&started, 1)
+				if i == 1 || i == 2 {
+					code := http.StatusTooManyRequests + i
+					return &code, errors.New("upload failed")
+				}
+				return nil, nil
+			}
+		}
+
+		failed, code, err := New("failure", Options{Parallelism: parallelism}).Run(tasks)
+
+		if assert.Error(err) && assert.NotNil(code) {
+			assert.Equal(1, failed)
+			assert.Equal(http.StatusTooManyRequests+1, *code)
+		}
+		// The tasks following the failure aren't started.
+		assert.True(atomic.LoadInt32(&started) < 8, "parallelism %d", parallelism)
+	}
+
+	failed, code, err := New("failure", Options{}).Run(nil)
+
+	assert.NoError(err)
+	assert.Nil(code)
+	assert.Equal(-1, failed)
+}
+
+func TestExecutorRate(t *testing.T) {
+	assert := assert.New(t)
+
+	noop := func() (*int, error) { return nil, nil }
+	tasks := []Task{noop, noop, noop}
+
+	start := time.Now()
+	New("rate", Options{Parallelism: 3, Rate: 20}).Run(tasks)
+
+	// The second run of the same account waits for the slots of the first one.
+	New("rate", Options{Parallelism: 3, Rate: 20}).Run(tasks)
+
+	assert.True(time.Since(start) >= 250*time.Millisecond, "elapsed %s", time.Since(start))
+
+	// The accounts have their own limits.
+	start = time.Now()
+	New("another rate", Options{Rate: 20}).Run(tasks[:1])
+
+	assert.True(time.Since(start) < 50*time.Millisecond, "elapsed %s", time.Since(start))
+}
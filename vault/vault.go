@@ -0,0 +1,266 @@
+package vault
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"modulus/kyc/common"
+)
+
+// KeySize is the size of the AES-256 key encrypting the documents.
+const KeySize = 32
+
+// purgeInterval is the minimal time between the purges of the expired documents started by the uploads.
+const purgeInterval = time.Minute
+
+// File name suffixes of the encrypted contents and metadata of the documents.
+const (
+	dataSuffix = ".data"
+	metaSuffix = ".meta"
+)
+
+// Errors of the vault.
+var (
+	// ErrNotFound means there is no document with the specified id or it has expired.
+	ErrNotFound = errors.New("document not found")
+	// ErrInvalidKey means the encryption key isn't the AES-256 one.
+	ErrInvalidKey = errors.New("document vault key must be 32 bytes long")
+)
+
+// Vault keeps the document files encrypted at rest in the directory. The documents are addressed by the SHA-256
+// of their contents, so the same file uploaded again is stored once and its retention is extended.
+// The contents and the metadata are sealed by AES-256-GCM bound to the document id.
+// It's safe for concurrent use.
+type Vault struct {
+	dir       string
+	aead      cipher.AEAD
+	mu        sync.Mutex
+	lastPurge time.Time
+}
+
+// New constructs a new vault storing the documents in the directory specified, the directory is created if it's missing.
+func New(dir string, key []byte) (v *Vault, err error) {
+	if len(key) != KeySize {
+		err = ErrInvalidKey
+		return
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return
+	}
+
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return
+	}
+
+	v = &Vault{
+		dir:  dir,
+		aead: aead,
+	}
+
+	return
+}
+
+// ID returns the id of the document with the content specified.
+func ID(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// Put stores the document file for the retention time specified. The created flag is false if the same content
+// has already been stored, its retention is extended then if the new one lasts longer.
+func (v *Vault) Put(file common.DocumentFile, retention time.Duration) (doc common.StoredDocument, created bool, err error) {
+	now := time.Now().UTC()
+	id := ID(file.Data)
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if now.Sub(v.lastPurge) >= purgeInterval {
+		v.lastPurge = now
+		v.purge(now)
+	}
+
+	doc, err = v.stat(id)
+	switch {
+	case err == ErrNotFound || (err == nil && !now.Before(doc.ExpiresAt)):
+		doc = common.StoredDocument{
+			ID:          id,
+			Filename:    file.Filename,
+			ContentType: file.ContentType,
+			Size:        int64(len(file.Data)),
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(retention),
+		}
+		if err = v.write(id+dataSuffix, file.Data); err != nil {
+			return
+		}
+		created = true
+	case err != nil:
+		return
+	case doc.ExpiresAt.After(now.Add(retention)):
+		return
+	default:
+		doc.ExpiresAt = now.Add(retention)
+	}
+
+	meta, err := json.Marshal(doc)
+	if err != nil {
+		return
+	}
+	err = v.write(id+metaSuffix, meta)
+
+	return
+}
+
+// Get returns the document with the id specified along with its content.
+func (v *Vault) Get(id string) (doc common.StoredDocument, data []byte, err error) {
+	if doc, err = v.Stat(id); err != nil {
+		return
+	}
+
+	if data, err = v.read(id + dataSuffix); os.IsNotExist(err) {
+		err = ErrNotFound
+	}
+
+	return
+}
+
+// Stat returns the document with the id specified.
+func (v *Vault) Stat(id string) (doc common.StoredDocument, err error) {
+	doc, err = v.stat(id)
+	if err == nil && !time.Now().Before(doc.ExpiresAt) {
+		doc, err = common.StoredDocument{}, ErrNotFound
+	}
+
+	return
+}
+
+// Check tells whether the directory of the vault is writable.
+func (v *Vault) Check() error {
+	tmp, err := ioutil.TempFile(v.dir, ".check-")
+	if err != nil {
+		return err
+	}
+	tmp.Close()
+
+	return os.Remove(tmp.Name())
+}
+
+// Purge removes the expired documents. It returns the number of the documents removed.
+func (v *Vault) Purge() (removed int, err error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.lastPurge = time.Now().UTC()
+	return v.purge(v.lastPurge)
+}
+
+// stat reads the metadata of the document including the expired one.
+func (v *Vault) stat(id string) (doc common.StoredDocument, err error) {
+	if !validID(id) {
+		err = ErrNotFound
+		return
+	}
+
+	meta, err := v.read(id + metaSuffix)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = ErrNotFound
+		}
+		return
+	}
+	err = json.Unmarshal(meta, &doc)
+
+	return
+}
+
+// purge removes the documents expired by now. The caller must hold the lock.
+func (v *Vault) purge(now time.Time) (removed int, err error) {
+	names, err := filepath.Glob(filepath.Join(v.dir, "*"+metaSuffix))
+	if err != nil {
+		return
+	}
+
+	for _, name := range names {
+		id := strings.TrimSuffix(filepath.Base(name), metaSuffix)
+		doc, err1 := v.stat(id)
+		if err1 != nil || now.Before(doc.ExpiresAt) {
+			continue
+		}
+
+		os.Remove(filepath.Join(v.dir, id+dataSuffix))
+		if err1 = os.Remove(name); err1 == nil {
+			removed++
+		}
+	}
+
+	return
+}
+
+// write encrypts the content and replaces the file with it atomically.
+func (v *Vault) write(name string, content []byte) (err error) {
+	nonce := make([]byte, v.aead.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return
+	}
+	sealed := v.aead.Seal(nonce, nonce, content, []byte(name))
+
+	tmp, err := ioutil.TempFile(v.dir, ".tmp-")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(sealed); err != nil {
+		tmp.Close()
+		return
+	}
+	if err = tmp.Close(); err != nil {
+		return
+	}
+
+	return os.Rename(tmp.Name(), filepath.Join(v.dir, name))
+}
+
+// read reads the file and decrypts its content.
+func (v *Vault) read(name string) (content []byte, err error) {
+	sealed, err := ioutil.ReadFile(filepath.Join(v.dir, name))
+	if err != nil {
+		return
+	}
+
+	size := v.aead.NonceSize()
+	if len(sealed) < size {
+		err = errors.New("corrupted document " + name)
+		return
+	}
+
+	return v.aead.Open(nil, sealed[:size], sealed[size:], []byte(name))
+}
+
+// validID tells whether the id is the hex-encoded SHA-256, so it's safe to use as the file name.
+func validID(id string) bool {
+	if len(id) != 2*sha256.Size || strings.ToLower(id) != id {
+		return false
+	}
+	_, err := hex.DecodeString(id)
+	return err == nil
+}
@@ -0,0 +1,124 @@
+package vault
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"modulus/kyc/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newVault constructs the vault in the temporary directory removed by the returned function.
+func newVault(t *testing.T) (v *Vault, dir string, cleanup func()) {
+	dir, err := ioutil.TempDir("", "vault-test-")
+	require.NoError(t, err)
+
+	v, err = New(dir, bytes.Repeat([]byte{7}, KeySize))
+	require.NoError(t, err)
+
+	return v, dir, func() { os.RemoveAll(dir) }
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(os.TempDir(), []byte("short"))
+
+	assert.Equal(t, ErrInvalidKey, err)
+}
+
+func TestVault(t *testing.T) {
+	assert := assert.New(t)
+
+	v, dir, cleanup := newVault(t)
+	defer cleanup()
+
+	file := common.DocumentFile{Filename: "passport.jpg", ContentType: "image/jpeg", Data: []byte("passport image")}
+
+	doc, created, err := v.Put(file, time.Hour)
+
+	if assert.NoError(err) {
+		assert.True(created)
+		assert.Equal(ID(file.Data), doc.ID)
+		assert.Equal("passport.jpg", doc.Filename)
+		assert.Equal("image/jpeg", doc.ContentType)
+		assert.Equal(int64(len(file.Data)), doc.Size)
+		assert.WithinDuration(time.Now().Add(time.Hour), doc.ExpiresAt, time.Minute)
+	}
+
+	// The documents are encrypted at rest.
+	stored, err := ioutil.ReadFile(filepath.Join(dir, doc.ID+dataSuffix))
+
+	if assert.NoError(err) {
+		assert.False(bytes.Contains(stored, file.Data))
+	}
+
+	got, data, err := v.Get(doc.ID)
+
+	if assert.NoError(err) {
+		assert.Equal(doc.ID, got.ID)
+		assert.Equal(file.Data, data)
+	}
+
+	// The same content is stored once, the longer retention extends the previous one.
+	again, created, err := v.Put(common.DocumentFile{Filename: "copy.jpg", Data: file.Data}, 2*time.Hour)
+
+	if assert.NoError(err) {
+		assert.False(created)
+		assert.Equal("passport.jpg", again.Filename)
+		assert.True(again.ExpiresAt.After(doc.ExpiresAt))
+	}
+
+	again, _, err = v.Put(file, time.Minute)
+
+	if assert.NoError(err) {
+		assert.True(again.ExpiresAt.After(doc.ExpiresAt))
+	}
+
+	for _, id := range []string{"missing", ID([]byte("missing")), "../" + doc.ID[3:]} {
+		_, _, err = v.Get(id)
+
+		assert.Equal(ErrNotFound, err)
+	}
+}
+
+func TestVaultPurge(t *testing.T) {
+	assert := assert.New(t)
+
+	v, dir, cleanup := newVault(t)
+	defer cleanup()
+
+	expired, _, err := v.Put(common.DocumentFile{Data: []byte("expired")}, -time.Second)
+	require.NoError(t, err)
+	kept, _, err := v.Put(common.DocumentFile{Data: []byte("kept")}, time.Hour)
+	require.NoError(t, err)
+
+	_, err = v.Stat(expired.ID)
+
+	assert.Equal(ErrNotFound, err)
+
+	removed, err := v.Purge()
+
+	assert.NoError(err)
+	assert.Equal(1, removed)
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*"))
+
+	assert.Len(files, 2)
+
+	_, err = v.Stat(kept.ID)
+
+	assert.NoError(err)
+
+	// The expired document is stored again when it's uploaded.
+	doc, created, err := v.Put(common.DocumentFile{Data: []byte("expired")}, time.Hour)
+
+	if assert.NoError(err) {
+		assert.True(created)
+		assert.Equal(expired.ID, doc.ID)
+	}
+}
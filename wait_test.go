@@ -0,0 +1,52 @@
+package kyc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"modulus/kyc/common"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitAbandonedCalls(t *testing.T) {
+	assert := assert.New(t)
+
+	release := make(chan struct{})
+	hang := func() { <-release }
+
+	for i := 0; i < MaxAbandonedCalls; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		err := wait(ctx, common.Jumio, "eu", hang)
+		cancel()
+
+		assert.Equal(context.DeadlineExceeded, err)
+	}
+
+	// The calls of the provider instance are rejected while the abandoned ones run.
+	err := wait(context.Background(), common.Jumio, "eu", func() {})
+
+	if assert.IsType(&Error{}, err) {
+		assert.Equal(common.ProblemProviderUnavailable, err.(*Error).Code)
+		assert.Equal("too many calls of Jumio instance 'eu' are still running after their deadlines", err.Error())
+	}
+
+	// The other instances aren't affected.
+	assert.NoError(wait(context.Background(), common.Jumio, "", func() {}))
+
+	close(release)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		abandoned.Lock()
+		left := len(abandoned.calls)
+		abandoned.Unlock()
+		if left == 0 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	assert.NoError(wait(context.Background(), common.Jumio, "eu", func() {}))
+}